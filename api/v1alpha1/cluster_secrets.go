@@ -0,0 +1,32 @@
+package v1alpha1
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// LoadSecrets populates the spec secret material from the referenced Secrets,
+// clusters without references keep using the values inlined in the spec
+func (in *Cluster) LoadSecrets(ctx context.Context, c client.Reader) error {
+	for _, ref := range []struct {
+		name string
+		load func(map[string][]byte)
+	}{
+		{in.Spec.CredentialsRef.Name, in.Spec.LoadCredentials},
+		{in.Spec.CertificatesRef.Name, in.Spec.LoadCertificates},
+		{in.Spec.KubeconfigRef.Name, in.Spec.LoadKubeconfigs},
+	} {
+		if ref.name == "" {
+			continue
+		}
+		secret := &corev1.Secret{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: in.Namespace, Name: ref.name}, secret); err != nil {
+			return err
+		}
+		ref.load(secret.Data)
+	}
+	return nil
+}
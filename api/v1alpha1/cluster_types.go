@@ -2,21 +2,85 @@ package v1alpha1
 
 import (
 	"github.com/awesomenix/azk/bootstrap"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// RotateCAAnnotation requests rotating the cluster CA, a rotation starts whenever its value changes.
+// Only the cluster CA is rotated, the front proxy and etcd CAs are kept.
+const RotateCAAnnotation = "engine.azk.io/rotate-ca"
+
+// CARotationPhase is a step of a cluster CA rotation
+type CARotationPhase string
+
+const (
+	// CARotationTrusting distributes a trust bundle of the current and the new CA to every node
+	CARotationTrusting CARotationPhase = "Trusting"
+	// CARotationReissuing switches the masters to the new CA and reissues their certificates
+	CARotationReissuing CARotationPhase = "Reissuing"
+	// CARotationRolling rejoins every worker node, issuing its kubelet certificates from the new CA
+	CARotationRolling CARotationPhase = "Rolling"
+	// CARotationRetiring removes the old CA from the trust bundle of every node
+	CARotationRetiring CARotationPhase = "Retiring"
+	// CARotationCompleted is reached once the old CA is no longer trusted
+	CARotationCompleted CARotationPhase = "Completed"
+)
+
+// CARotationStatus is the progress of a cluster CA rotation
+type CARotationStatus struct {
+	// Requested is the rotate-ca annotation value the rotation was started for
+	Requested string          `json:"requested"`
+	Phase     CARotationPhase `json:"phase"`
+	// Nodes are the machines that completed the current phase
+	Nodes []string `json:"nodes,omitempty"`
+	// WaitingNode is the machine the current phase ran on last, the phase continues once it is Ready
+	WaitingNode string `json:"waitingNode,omitempty"`
+	// WaitingSince is when the current phase ran on WaitingNode
+	WaitingSince   *metav1.Time `json:"waitingSince,omitempty"`
+	StartTime      *metav1.Time `json:"startTime,omitempty"`
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// Message explains why the current phase failed
+	Message string `json:"message,omitempty"`
+}
+
 // ClusterSpec defines the desired state of Cluster
 type ClusterSpec struct {
 	bootstrap.Spec `json:",inline"`
+	// CredentialsRef references the Secret holding azure credentials and cloud provider config
+	CredentialsRef corev1.LocalObjectReference `json:"credentialsRef,omitempty"`
+	// CertificatesRef references the Secret holding the cluster CA bundle
+	CertificatesRef corev1.LocalObjectReference `json:"certificatesRef,omitempty"`
+	// KubeconfigRef references the Secret holding the admin and customer kubeconfigs
+	KubeconfigRef corev1.LocalObjectReference `json:"kubeconfigRef,omitempty"`
 }
 
 // ClusterStatus defines the observed state of Cluster
 type ClusterStatus struct {
-	ProvisioningState string `json:"provisioningState,omitempty"`
+	ProvisioningState  string      `json:"provisioningState,omitempty"`
+	ObservedGeneration int64       `json:"observedGeneration,omitempty"`
+	Conditions         []Condition `json:"conditions,omitempty"`
+	// Resources is the observed state of each azure resource of the base infrastructure
+	Resources []ResourceStatus `json:"resources,omitempty"`
+	// CARotation is the progress of the last CA rotation
+	CARotation *CARotationStatus `json:"caRotation,omitempty"`
+}
+
+// ResourceStatus is the observed state of an azure resource backing the cluster
+type ResourceStatus struct {
+	Type  string `json:"type"`
+	Name  string `json:"name"`
+	Ready bool   `json:"ready"`
+	// Message describes why the resource is missing or drifted from the expected configuration
+	Message string `json:"message,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.provisioningState"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Infrastructure",type="string",JSONPath=".status.conditions[?(@.type==\"InfrastructureReady\")].status"
+// +kubebuilder:printcolumn:name="CA Rotation",type="string",JSONPath=".status.caRotation.phase"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // Cluster is the Schema for the clusters API
 type Cluster struct {
@@ -0,0 +1,93 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConditionType is the type of a status condition
+type ConditionType string
+
+const (
+	// ReadyCondition is true once the object reached its desired state
+	ReadyCondition ConditionType = "Ready"
+	// InfrastructureReadyCondition is true once the azure base infrastructure exists
+	InfrastructureReadyCondition ConditionType = "InfrastructureReady"
+	// BootstrapTokenReadyCondition is true once a kubeadm join token was created
+	BootstrapTokenReadyCondition ConditionType = "BootstrapTokenReady"
+	// VMSSReadyCondition is true once the backing virtual machine scale set is provisioned
+	VMSSReadyCondition ConditionType = "VMSSReady"
+	// NodesReadyCondition is true once all expected nodes joined the cluster and are Ready
+	NodesReadyCondition ConditionType = "NodesReady"
+	// UpgradeInProgressCondition is true while kubernetes version is being rolled out
+	UpgradeInProgressCondition ConditionType = "UpgradeInProgress"
+	// ScalingInProgressCondition is true while instances are being added or removed
+	ScalingInProgressCondition ConditionType = "ScalingInProgress"
+	// EtcdHealthyCondition is true while every etcd member of the control plane is healthy
+	EtcdHealthyCondition ConditionType = "EtcdHealthy"
+	// CertificatesExpiringCondition is true while a master certificate expires soon and was not renewed
+	CertificatesExpiringCondition ConditionType = "CertificatesExpiring"
+	// CARotationInProgressCondition is true while the cluster CA is being rotated
+	CARotationInProgressCondition ConditionType = "CARotationInProgress"
+	// RetentionAppliedCondition is true once the snapshots exceeding retention are deleted
+	RetentionAppliedCondition ConditionType = "RetentionApplied"
+)
+
+// Condition describes the state of an object at a certain point,
+// modelled after the upstream metav1.Condition
+type Condition struct {
+	// Type of condition in CamelCase
+	Type ConditionType `json:"type"`
+	// Status of the condition, one of True, False, Unknown
+	Status corev1.ConditionStatus `json:"status"`
+	// ObservedGeneration is the .metadata.generation the condition was set based upon
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// LastTransitionTime is the last time the condition transitioned from one status to another
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// Reason is a programmatic identifier indicating the reason for the last transition
+	Reason string `json:"reason,omitempty"`
+	// Message is a human readable message indicating details about the transition
+	Message string `json:"message,omitempty"`
+}
+
+// SetCondition adds or updates the condition of the same type in conditions,
+// LastTransitionTime is only bumped when the status changes
+func SetCondition(conditions *[]Condition, condition Condition) {
+	if conditions == nil {
+		return
+	}
+	for i := range *conditions {
+		existing := &(*conditions)[i]
+		if existing.Type != condition.Type {
+			continue
+		}
+		if existing.Status != condition.Status || existing.LastTransitionTime.IsZero() {
+			existing.LastTransitionTime = metav1.Now()
+		}
+		existing.Status = condition.Status
+		existing.Reason = condition.Reason
+		existing.Message = condition.Message
+		existing.ObservedGeneration = condition.ObservedGeneration
+		return
+	}
+	if condition.LastTransitionTime.IsZero() {
+		condition.LastTransitionTime = metav1.Now()
+	}
+	*conditions = append(*conditions, condition)
+}
+
+// FindCondition returns the condition of the given type, nil if not present
+func FindCondition(conditions []Condition, conditionType ConditionType) *Condition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+// IsConditionTrue returns true if the condition of the given type is present and True
+func IsConditionTrue(conditions []Condition, conditionType ConditionType) bool {
+	condition := FindCondition(conditions, conditionType)
+	return condition != nil && condition.Status == corev1.ConditionTrue
+}
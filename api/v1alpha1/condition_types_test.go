@@ -0,0 +1,85 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSetCondition(t *testing.T) {
+	transitioned := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	existing := Condition{
+		Type:               ReadyCondition,
+		Status:             corev1.ConditionFalse,
+		ObservedGeneration: 1,
+		LastTransitionTime: transitioned,
+		Reason:             "Updating",
+		Message:            "updating",
+	}
+
+	tests := []struct {
+		name           string
+		conditions     []Condition
+		condition      Condition
+		wantConditions int
+		wantTransition bool
+	}{
+		{
+			name:           "added",
+			condition:      Condition{Type: ReadyCondition, Status: corev1.ConditionTrue, ObservedGeneration: 2, Reason: "Succeeded"},
+			wantConditions: 1,
+			wantTransition: true,
+		},
+		{
+			name:           "status changed",
+			conditions:     []Condition{existing},
+			condition:      Condition{Type: ReadyCondition, Status: corev1.ConditionTrue, ObservedGeneration: 2, Reason: "Succeeded"},
+			wantConditions: 1,
+			wantTransition: true,
+		},
+		{
+			name:           "status unchanged",
+			conditions:     []Condition{existing},
+			condition:      Condition{Type: ReadyCondition, Status: corev1.ConditionFalse, ObservedGeneration: 2, Reason: "ScaleDownFailed", Message: "failed"},
+			wantConditions: 1,
+		},
+		{
+			name:           "other type",
+			conditions:     []Condition{existing},
+			condition:      Condition{Type: ScalingInProgressCondition, Status: corev1.ConditionTrue, ObservedGeneration: 2, Reason: "Scaling"},
+			wantConditions: 2,
+			wantTransition: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conditions := append([]Condition(nil), tt.conditions...)
+			SetCondition(&conditions, tt.condition)
+			if len(conditions) != tt.wantConditions {
+				t.Fatalf("Expected %d conditions, got %v", tt.wantConditions, conditions)
+			}
+
+			condition := FindCondition(conditions, tt.condition.Type)
+			if condition == nil {
+				t.Fatalf("Expected condition %s to be found in %v", tt.condition.Type, conditions)
+			}
+			if condition.Status != tt.condition.Status || condition.Reason != tt.condition.Reason ||
+				condition.Message != tt.condition.Message || condition.ObservedGeneration != tt.condition.ObservedGeneration {
+				t.Errorf("Expected condition %+v, got %+v", tt.condition, condition)
+			}
+			if transition := !condition.LastTransitionTime.Equal(&transitioned); transition != tt.wantTransition {
+				t.Errorf("Expected transition %v, got LastTransitionTime %v", tt.wantTransition, condition.LastTransitionTime)
+			}
+			if condition.LastTransitionTime.IsZero() {
+				t.Errorf("Expected LastTransitionTime to be set")
+			}
+		})
+	}
+
+	if condition := FindCondition([]Condition{existing}, EtcdHealthyCondition); condition != nil {
+		t.Errorf("Expected no condition of a missing type, got %+v", condition)
+	}
+	SetCondition(nil, existing)
+}
@@ -1,24 +1,104 @@
 package v1alpha1
 
 import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	// DefaultControlPlaneReplicas is the number of control plane nodes when replicas is unset
+	DefaultControlPlaneReplicas = 3
+	// RenewCertificatesAnnotation requests renewing the certificates of every master, the value
+	// is copied to the CertificatesRenewal status once all masters renewed their certificates
+	RenewCertificatesAnnotation = "engine.azk.io/renew-certificates"
+)
+
 // ControlPlaneSpec defines the desired state of ControlPlane
 type ControlPlaneSpec struct {
 	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
 	VMSKUType         string `json:"vmSKUType,omitempty"`
+	// Replicas is the number of control plane nodes, an odd count keeps etcd quorum, defaults to 3
+	// +kubebuilder:validation:Enum=1;3;5
+	Replicas *int32 `json:"replicas,omitempty"`
+	// ClusterRef is the Cluster in the same namespace this control plane belongs to
+	ClusterRef corev1.LocalObjectReference `json:"clusterRef,omitempty"`
+}
+
+// DesiredReplicas returns the number of control plane nodes, defaulting to DefaultControlPlaneReplicas
+func (s *ControlPlaneSpec) DesiredReplicas() int32 {
+	if s.Replicas == nil {
+		return DefaultControlPlaneReplicas
+	}
+	return *s.Replicas
+}
+
+// ValidateControlPlaneReplicas returns an error unless replicas is 1, 3 or 5
+func ValidateControlPlaneReplicas(replicas int32) error {
+	switch replicas {
+	case 1, 3, 5:
+		return nil
+	}
+	return fmt.Errorf("invalid control plane replicas %d, expected 1, 3 or 5", replicas)
+}
+
+// EtcdMemberStatus is the observed state of an etcd member of the control plane
+type EtcdMemberStatus struct {
+	// Name is the name of the master running the member, empty until the member started
+	Name string `json:"name,omitempty"`
+	// ID is the hexadecimal etcd member id
+	ID      string `json:"id"`
+	Healthy bool   `json:"healthy"`
+	Leader  bool   `json:"leader,omitempty"`
+	// Message explains why the member is unhealthy
+	Message string `json:"message,omitempty"`
+}
+
+// CertificateStatus is the expiry of a certificate on a master
+type CertificateStatus struct {
+	// Name is the path of the certificate or kubeconfig relative to /etc/kubernetes
+	Name     string      `json:"name"`
+	NotAfter metav1.Time `json:"notAfter"`
+}
+
+// MasterCertificatesStatus are the certificates observed on a master
+type MasterCertificatesStatus struct {
+	// Name is the computer name of the master
+	Name         string              `json:"name"`
+	Certificates []CertificateStatus `json:"certificates,omitempty"`
 }
 
 // ControlPlaneStatus defines the observed state of ControlPlane
 type ControlPlaneStatus struct {
 	KubernetesVersion string     `json:"kubernetesVersion,omitempty"`
+	Replicas          int32      `json:"replicas,omitempty"`
 	ProvisioningState string     `json:"provisioningState,omitempty"`
 	NodeStatus        []VMStatus `json:"nodeStatus,omitempty"`
+	// EtcdMembers are the etcd members observed at the last reconcile
+	EtcdMembers []EtcdMemberStatus `json:"etcdMembers,omitempty"`
+	// Certificates are the leaf certificates of every master observed at the last check
+	Certificates []MasterCertificatesStatus `json:"certificates,omitempty"`
+	// CertificatesCheckedTime is when the certificates of the masters were last read
+	CertificatesCheckedTime *metav1.Time `json:"certificatesCheckedTime,omitempty"`
+	// CertificatesNotAfter is the earliest expiry of any master certificate
+	CertificatesNotAfter *metav1.Time `json:"certificatesNotAfter,omitempty"`
+	// CertificatesRenewal is the renew-certificates annotation value handled last
+	CertificatesRenewal string      `json:"certificatesRenewal,omitempty"`
+	ObservedGeneration  int64       `json:"observedGeneration,omitempty"`
+	Conditions          []Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".status.replicas"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.kubernetesVersion"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.provisioningState"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Upgrading",type="string",JSONPath=".status.conditions[?(@.type==\"UpgradeInProgress\")].status"
+// +kubebuilder:printcolumn:name="Etcd",type="string",JSONPath=".status.conditions[?(@.type==\"EtcdHealthy\")].status"
+// +kubebuilder:printcolumn:name="Certs Expire",type="date",JSONPath=".status.certificatesNotAfter"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // ControlPlane is the Schema for the controlplanes API
 type ControlPlane struct {
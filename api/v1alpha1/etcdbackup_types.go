@@ -0,0 +1,107 @@
+package v1alpha1
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BlobBackupStorage stores snapshots in a container of an existing azure storage account
+type BlobBackupStorage struct {
+	// ResourceGroup of the storage account, defaults to the cluster resource group
+	ResourceGroup  string `json:"resourceGroup,omitempty"`
+	StorageAccount string `json:"storageAccount"`
+	// Container is created private when missing
+	Container string `json:"container"`
+}
+
+// LocalBackupStorage stores snapshots in a directory of the controller manager, meant for testing
+type LocalBackupStorage struct {
+	Path string `json:"path"`
+}
+
+// EtcdBackupStorage is where snapshots are stored, exactly one backend is set
+type EtcdBackupStorage struct {
+	Blob  *BlobBackupStorage  `json:"blob,omitempty"`
+	Local *LocalBackupStorage `json:"local,omitempty"`
+}
+
+// Validate returns an error unless exactly one complete backend is set
+func (s *EtcdBackupStorage) Validate() error {
+	switch {
+	case s.Blob != nil && s.Local != nil:
+		return fmt.Errorf("only one of blob or local storage can be set")
+	case s.Blob != nil:
+		if s.Blob.StorageAccount == "" || s.Blob.Container == "" {
+			return fmt.Errorf("blob storage needs storageAccount and container")
+		}
+	case s.Local != nil:
+		if s.Local.Path == "" {
+			return fmt.Errorf("local storage needs path")
+		}
+	default:
+		return fmt.Errorf("no backup storage set, expected blob or local")
+	}
+	return nil
+}
+
+// EtcdSnapshot describes a stored etcd snapshot
+type EtcdSnapshot struct {
+	// Name of the snapshot in the storage
+	Name string `json:"name"`
+	// Location is the blob url or file path of the snapshot
+	Location string `json:"location"`
+	// Member is the master the snapshot was taken from
+	Member string `json:"member,omitempty"`
+	// Size of the snapshot in bytes
+	Size int64 `json:"size"`
+	// SHA256 is the hexadecimal checksum of the snapshot
+	SHA256  string      `json:"sha256,omitempty"`
+	TakenAt metav1.Time `json:"takenAt"`
+}
+
+// EtcdBackupSpec defines the desired state of EtcdBackup
+type EtcdBackupSpec struct {
+	// ClusterRef is the Cluster in the same namespace whose control plane etcd is backed up
+	ClusterRef corev1.LocalObjectReference `json:"clusterRef,omitempty"`
+	Storage    EtcdBackupStorage           `json:"storage"`
+}
+
+// EtcdBackupStatus defines the observed state of EtcdBackup
+type EtcdBackupStatus struct {
+	// Snapshot is set once the snapshot was stored
+	Snapshot           *EtcdSnapshot `json:"snapshot,omitempty"`
+	ObservedGeneration int64         `json:"observedGeneration,omitempty"`
+	Conditions         []Condition   `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Member",type="string",JSONPath=".status.snapshot.member"
+// +kubebuilder:printcolumn:name="Size",type="integer",JSONPath=".status.snapshot.size"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// EtcdBackup is the Schema for the etcdbackups API, it takes a single snapshot of the control
+// plane etcd. Deleting the EtcdBackup keeps the stored snapshot.
+type EtcdBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EtcdBackupSpec   `json:"spec,omitempty"`
+	Status EtcdBackupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EtcdBackupList contains a list of EtcdBackup
+type EtcdBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EtcdBackup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&EtcdBackup{}, &EtcdBackupList{})
+}
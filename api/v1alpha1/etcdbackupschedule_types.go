@@ -0,0 +1,78 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DefaultEtcdBackupRetention is the number of snapshots kept when retention is unset
+	DefaultEtcdBackupRetention = 7
+	// EtcdBackupScheduleLabel is set on the EtcdBackups created by a schedule, holding its name
+	EtcdBackupScheduleLabel = "engine.azk.io/etcd-backup-schedule"
+)
+
+// EtcdBackupScheduleSpec defines the desired state of EtcdBackupSchedule
+type EtcdBackupScheduleSpec struct {
+	// ClusterRef is the Cluster in the same namespace whose control plane etcd is backed up
+	ClusterRef corev1.LocalObjectReference `json:"clusterRef,omitempty"`
+	// Schedule is a cron expression like "0 */6 * * *" or @hourly, @daily, @weekly, evaluated in UTC
+	Schedule string `json:"schedule"`
+	// Retention is the number of successful snapshots kept, older snapshots are deleted, defaults to 7
+	// +kubebuilder:validation:Minimum=1
+	Retention *int32            `json:"retention,omitempty"`
+	Storage   EtcdBackupStorage `json:"storage"`
+	// Suspend stops creating new backups, existing backups are kept
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+// DesiredRetention returns the number of snapshots to keep, defaulting to DefaultEtcdBackupRetention
+func (s *EtcdBackupScheduleSpec) DesiredRetention() int {
+	if s.Retention == nil || *s.Retention < 1 {
+		return DefaultEtcdBackupRetention
+	}
+	return int(*s.Retention)
+}
+
+// EtcdBackupScheduleStatus defines the observed state of EtcdBackupSchedule
+type EtcdBackupScheduleStatus struct {
+	// LastScheduleTime is the last time a backup was created
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+	// LastSuccessfulTime is the time the latest retained snapshot was taken
+	LastSuccessfulTime *metav1.Time `json:"lastSuccessfulTime,omitempty"`
+	// Snapshots are the retained snapshots, newest first
+	Snapshots          []EtcdSnapshot `json:"snapshots,omitempty"`
+	ObservedGeneration int64          `json:"observedGeneration,omitempty"`
+	Conditions         []Condition    `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Schedule",type="string",JSONPath=".spec.schedule"
+// +kubebuilder:printcolumn:name="Suspend",type="boolean",JSONPath=".spec.suspend"
+// +kubebuilder:printcolumn:name="Last Success",type="date",JSONPath=".status.lastSuccessfulTime"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// EtcdBackupSchedule is the Schema for the etcdbackupschedules API, it creates EtcdBackups on a
+// cron schedule and deletes the snapshots exceeding retention
+type EtcdBackupSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EtcdBackupScheduleSpec   `json:"spec,omitempty"`
+	Status EtcdBackupScheduleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EtcdBackupScheduleList contains a list of EtcdBackupSchedule
+type EtcdBackupScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EtcdBackupSchedule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&EtcdBackupSchedule{}, &EtcdBackupScheduleList{})
+}
@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -9,15 +10,18 @@ type NodeSetSpec struct {
 	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
 	Replicas          *int32 `json:"replicas,omitempty"`
 	VMSKUType         string `json:"vmSKUType,omitempty"`
+	// ClusterRef is the Cluster in the same namespace these nodes join
+	ClusterRef corev1.LocalObjectReference `json:"clusterRef,omitempty"`
 }
 
 // NodeSetStatus defines the observed state of NodeSet
 type NodeSetStatus struct {
-	Replicas          int32      `json:"replicas,omitempty"`
-	KubernetesVersion string     `json:"kubernetesVersion,omitempty"`
-	ProvisioningState string     `json:"provisioningState,omitempty"`
-	Kubeconfig        string     `json:"kubeConfig,omitempty"`
-	NodeStatus        []VMStatus `json:"nodeStatus,omitempty"`
+	Replicas           int32       `json:"replicas,omitempty"`
+	KubernetesVersion  string      `json:"kubernetesVersion,omitempty"`
+	ProvisioningState  string      `json:"provisioningState,omitempty"`
+	NodeStatus         []VMStatus  `json:"nodeStatus,omitempty"`
+	ObservedGeneration int64       `json:"observedGeneration,omitempty"`
+	Conditions         []Condition `json:"conditions,omitempty"`
 }
 
 type VMStatus struct {
@@ -27,6 +31,11 @@ type VMStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".status.replicas"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.kubernetesVersion"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.provisioningState"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // NodeSet is the Schema for the nodesets API
 type NodeSet struct {
@@ -57,38 +57,52 @@ var Assets = func() http.FileSystem {
 		},
 		"/crd/bases/engine.azk.io_clusters.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "engine.azk.io_clusters.yaml",
-			modTime:          time.Date(2019, 9, 28, 14, 35, 16, 329806052, time.UTC),
-			uncompressedSize: 3228,
+			modTime:          time.Date(2026, 10, 17, 4, 1, 48, 207998143, time.UTC),
+			uncompressedSize: 22728,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x57\x4d\x6f\x23\x37\x0c\xbd\xfb\x57\x10\xdb\xc3\x5e\x6a\x1b\x41\x2f\xc5\xdc\x0c\xa7\x40\x83\x74\x03\x63\x9d\xe6\x52\xf4\xa0\x91\x68\x5b\xcd\x0c\xa5\x92\x94\x11\xa7\xe8\x7f\x2f\xa4\xf1\x67\x12\x77\x3d\xc6\xe6\x94\xa1\xf8\x48\xce\xe3\x1b\x91\x1e\x0c\x87\xc3\x81\x89\xfe\x09\x59\x7c\xa0\x0a\x4c\xf4\xf8\xa2\x48\xf9\x49\x46\xcf\x3f\xcb\xc8\x87\xf1\xfa\xa6\x46\x35\x37\x83\x67\x4f\xae\x82\x69\x12\x0d\xed\x57\x94\x90\xd8\xe2\x2d\x2e\x3c\x79\xf5\x81\x06\x2d\xaa\x71\x46\x4d\x35\x00\xb0\x8c\x26\x1b\x1f\x7d\x8b\xa2\xa6\x8d\x15\x50\x6a\x9a\x01\x00\x99\x16\x2b\xb0\x4d\x12\x45\x96\x11\xd2\xd2\x13\x8e\xcc\xeb\xf3\xc8\x87\x81\x44\xb4\x19\xbe\xe4\x90\x62\x05\xa7\x87\x1d\x56\xf2\x39\xc0\xb6\x96\x2e\x4c\xb1\xc4\x26\xb1\x69\x0e\xa1\x07\x00\x62\x43\xc4\x0a\x3e\x7d\xca\xff\xa7\x9a\xb7\x35\x6f\x43\x88\x1a\x4d\x52\xc1\x3f\xff\x0e\x00\xd6\xa6\xf1\xae\x94\xdc\x1d\x86\x88\x34\x99\xdd\x3d\xfd\x34\xb7\x2b\x6c\x4d\x67\x04\x70\x28\x96\x7d\x2c\x7e\xbb\xec\xe0\x05\x74\x85\xd0\x79\xc2\x22\x70\x79\xdc\xd5\x01\x93\xd9\xdd\x16\x1d\x39\x44\x64\xf5\xbb\x0a\xf2\xdf\x11\xfb\x7b\xdb\x9b\x3c\x9f\x73\x21\x9d\x0f\xb8\xcc\x37\x76\x09\xd7\x9d\x0d\x1d\x48\x97\x3a\x2c\x40\x57\x5e\x80\x31\x32\x0a\x92\x96\x17\x3a\x0a\x0b\xd9\xc5\x10\x84\xfa\x2f\xb4\x3a\x82\x39\x72\x0e\x02\xb2\x0a\xa9\x71\x60\x03\xad\x91\x15\x18\x6d\x58\x92\x7f\xdd\x47\x16\xd0\x50\x52\x36\x46\x51\xf4\x24\xa2\x27\x45\x26\xd3\x64\x0a\x13\xfe\x08\x86\x1c\xb4\x66\x03\x8c\x39\x07\x24\x3a\x8a\x56\x5c\x64\x04\x5f\x02\x23\x78\x5a\x84\x0a\x56\xaa\x51\xaa\xf1\x78\xe9\x75\xa7\x37\x1b\xda\x36\x91\xd7\xcd\xd8\x06\x52\xf6\x75\xd2\xc0\x32\x76\xb8\xc6\x66\x6c\xa2\x1f\x96\x3a\x49\x8b\x46\x5b\xf7\xc3\xbe\xaf\x9f\x8f\x0a\xd3\x4d\x6e\xbd\x28\x7b\x5a\xee\xcd\x45\x35\x67\x69\xbe\xf7\xe4\x72\x33\xcd\x16\xd6\x95\x7b\x60\x33\x9b\x32\x09\x5f\x7f\x99\x3f\xc2\x2e\x69\x61\xfc\x94\xe2\x42\xee\x01\x26\x07\x9e\x33\x2f\x9e\x16\xc8\x5d\x9f\x16\x1c\xda\x12\x11\xc9\xc5\xe0\x49\xb7\xc2\xf1\x48\xa7\x1c\x4b\xaa\x5b\xaf\xb9\xb1\x7f\x27\x14\xcd\xed\x18\xc1\xd4\x10\x05\x85\x1a\x21\x45\x67\x14\xdd\x08\xee\x08\xa6\xa6\xc5\x66\x6a\x04\xbf\x37\xcb\x99\x50\x19\x66\x06\xbf\xcd\xf3\xf1\x55\x70\xea\xd8\x91\xb3\x37\xef\x3e\xf8\x0f\x1b\xb2\xfd\xbe\xe6\x11\xed\x89\xee\x1d\x8a\xe7\xac\x4d\x35\x8a\x59\xd1\xc7\xd7\xc0\xf9\x2f\xad\x7c\x6d\xae\xf5\x74\x9f\x6a\x9c\x06\x5a\xf8\xe5\xe9\xe1\x99\x97\x29\xb8\xd7\xc4\x38\x6d\x42\x72\x33\x0e\x6b\xef\x90\x7b\x06\xa8\x43\x50\x51\x36\x31\x27\x67\x42\x45\xf9\xe0\xb3\xbf\x2c\xc4\xd3\x97\xf9\xfd\xef\x8f\xd9\xed\x52\xa8\x35\xd3\xcc\xc6\xc2\x5b\xa3\x57\xa2\xee\x71\x73\x39\xb0\x28\xf8\xee\xb6\x27\x60\x8e\x96\x51\x7b\x80\x42\x72\x3d\xbc\x8b\x46\x1e\xf2\xf8\xb9\x18\x53\x46\x1d\xf2\x15\x82\x71\x5e\x6c\x58\x23\x6f\x7e\x35\xb2\x7a\xab\x42\x00\xaf\xd8\xbe\x33\xfe\x4f\xb8\xdd\x91\x61\x36\x9b\xd3\x44\x24\x33\xc6\x85\x7f\xb9\xb8\x34\x54\xeb\xa6\x93\x6b\x04\xf1\x0e\xd9\x47\x14\x0b\x0e\xa4\x33\x0e\x2f\x9b\xeb\x92\x9f\xc1\xf7\x29\xa1\xac\x15\xbf\x05\x7b\x34\xe7\x2f\x45\xf5\xd2\xcd\x6e\x24\xde\x3e\xcc\x7b\xe1\x62\xaa\x1b\x6f\xaf\x43\xdd\xcd\x26\xce\x31\xca\x3b\x55\x9d\xc5\x09\xf2\xda\x5b\x9c\x58\x1b\x12\x69\x1f\x1e\x4f\x91\xb3\x54\x5f\x8e\x4c\xf5\xfe\x82\xef\x71\x3f\x28\x92\xe9\x75\xa1\x24\x41\x9e\x2c\x91\x2e\xbd\x4d\x3e\x1e\x4e\xdd\x82\xf8\xad\xf1\x54\xbc\x4e\x06\x54\xa8\x33\x43\xd7\x4d\xa8\x98\xe7\x4b\x9e\x0b\x9e\x96\x39\xf4\xa5\x3a\xf8\xe0\x0d\xde\x98\xd6\xbb\x25\x7f\x7d\x63\x9a\xb8\x32\x37\x07\x5b\xa9\x61\xb8\xdd\xcd\x8f\x8e\xbb\x56\xa3\xab\x40\x39\xe1\x76\x6b\x0e\x6c\x96\xb8\xb5\x1c\x28\x32\xd6\x62\x54\x74\x0f\x6f\x77\xf4\xb2\x7d\x1f\xd6\xf3\xf2\x68\x03\xb9\xf2\xa3\x41\x2a\xf8\xe3\xcf\x41\x17\x15\xdd\xd3\xae\x9a\x6c\xfc\x2f\x00\x00\xff\xff\x6c\xb9\x94\x1e\x9c\x0c\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7c\x5f\x93\x23\x37\x6e\xf8\xfb\x7c\x0a\xd4\xde\x83\x7f\xbf\x94\xa4\x99\xb5\x1d\x5f\xa2\xaa\xab\x8b\xa2\xb1\xcf\xca\x7a\x67\x55\xa3\xf1\xe6\xe1\xea\x1e\x28\x12\x92\x78\xd3\x4d\xb6\x09\xb6\x66\xe5\x54\xbe\x7b\x8a\x64\xb3\xd5\x2d\xf5\x3f\xcd\x6c\x92\x4a\x95\xf6\xc1\x1e\xb1\x01\x10\x04\x40\x00\x04\xff\xdc\x8c\xc7\xe3\x1b\x96\xc9\xcf\x68\x48\x6a\x35\x05\x96\x49\xfc\x62\x51\xb9\x5f\x34\x79\xfe\x27\x9a\x48\x7d\xbb\x7f\xbf\x46\xcb\xde\xdf\x3c\x4b\x25\xa6\x30\xcf\xc9\xea\xf4\x11\x49\xe7\x86\xe3\x3d\x6e\xa4\x92\x56\x6a\x75\x93\xa2\x65\x82\x59\x36\xbd\x01\xe0\x06\x99\x6b\x7c\x92\x29\x92\x65\x69\x36\x05\x95\x27\xc9\x0d\x80\x62\x29\x4e\x81\x27\x39\x59\x34\x34\x41\xb5\x95\x0a\x27\xec\xf7\xe7\x89\xd4\x37\x94\x21\x77\xe8\x4c\x08\x4f\x93\x25\x4b\x23\x95\x45\x33\xd7\x49\x9e\x2a\x72\xdf\xc6\xf0\x6f\xab\x4f\x0f\x4b\x66\x77\x53\x98\x90\x65\x36\xa7\x49\x66\xf4\x5e\x3a\x9e\xa5\xda\xae\x2c\xb3\x78\x03\x10\xbb\x3a\xfe\xb6\x87\x0c\xa7\x40\xd6\x48\xb5\x6d\x21\xc4\xb5\x0a\x3d\xd3\x5f\xff\xfc\xff\xfe\x65\xe2\x30\xfe\xf4\xa7\x77\x8f\xc8\xc4\xe1\xdd\xff\xff\x5b\x01\x55\x21\xee\xbf\xbc\x8d\xf8\x42\x6d\x0c\x23\x6b\x72\x6e\x73\x83\xed\x5d\xd5\xe1\x06\xf7\xc9\x1e\xb5\xf5\xaa\x98\x64\x3b\x46\x55\xc1\xcc\x67\x10\xbf\xf5\x52\x8b\xba\x9d\x9c\x29\xb6\x42\x70\xb6\xad\xb2\x25\x82\xd8\xb7\x46\xe7\xd9\x14\xea\x7a\x0e\x18\x5e\x9d\x00\x85\x59\x05\x8b\xb8\x01\x00\xc8\x92\xdc\xb0\xe4\x68\x25\x37\x00\xc4\xb5\x23\xfa\xee\x9d\xfb\x3b\x5f\x9b\xc2\xfc\x0a\x12\x61\xb0\x53\xf8\x8f\xff\xbc\x01\xd8\xb3\x44\x0a\xcf\x64\xf8\xa8\x33\x54\xb3\xe5\xe2\xf3\x77\x2b\xbe\xc3\x94\x85\x46\x00\x81\xc4\x8d\xcc\x3c\x5c\xec\x1d\x24\x81\xdd\x21\x04\x48\xd8\x68\xe3\x7f\x46\x3e\x60\xb6\x5c\x14\xd8\x99\xd1\x19\x1a\x2b\x23\x07\x00\x00\x95\x89\x54\xb6\x9d\xf4\xf3\x8d\x63\x24\xc0\x80\x70\x53\x07\x43\x87\xfb\xd0\x86\x02\x28\x74\xad\x37\x60\x77\x92\xc0\x60\x66\x90\x50\x55\xf4\x14\xff\xe9\x0d\x30\x05\x7a\xfd\x77\xe4\x76\x02\x2b\x34\x8e\x08\xd0\x4e\xe7\x89\x00\xae\xd5\x1e\x8d\x05\x83\x5c\x6f\x95\xfc\xbd\xa4\x4c\x60\xb5\xef\x32\x61\x16\xc9\xd6\x28\xfa\xa9\xa6\x58\xe2\x44\x98\xe3\x08\x98\x12\x90\xb2\x03\x18\x74\x7d\x40\xae\x2a\xd4\x3c\x08\x4d\xe0\xa3\x36\x08\x52\x6d\xf4\x14\x76\xd6\x66\x34\xbd\xbd\xdd\x4a\x1b\x5d\x07\xd7\x69\x9a\x2b\x69\x0f\xb7\x5c\x2b\x6b\xe4\x3a\xb7\xda\xd0\xad\xc0\x3d\x26\xb7\x2c\x93\x63\xcf\xa7\xf2\x93\x62\x92\x8a\x3f\x94\x7a\xfd\xa6\xc2\xd8\x89\x61\x02\x94\x56\xd3\x2a\xe6\x0f\x52\x09\x90\x04\xac\x40\x0b\xec\x1e\xa5\xe9\x9a\x9c\x10\x1e\x7f\x5c\x3d\x41\xec\xd4\x4b\xbc\x2e\x62\x2f\xdc\x23\x1a\x1d\xe5\xec\xe4\x22\xd5\x06\x8d\xc7\x82\x8d\xd1\xa9\xa7\x88\x4a\x64\x5a\x2a\x5b\x18\x8e\x44\x55\x97\x31\xe5\xeb\x54\x5a\xa7\xd8\xdf\x72\x24\xeb\xd4\x31\x81\x39\x53\x4a\x5b\x58\x23\xe4\x99\x9b\x37\x62\x02\x0b\x05\x73\x96\x62\x32\x67\x84\x5f\x5b\xca\x4e\xa0\x34\x76\x12\xec\x97\x73\xd5\xab\xd7\x01\x83\x70\xca\xe6\xe8\xbb\x01\xda\xe7\xd7\x2a\x43\x5e\xb3\x7b\x81\x24\x8d\xb3\x4d\xe7\xa4\x41\x6f\x6a\x6e\xa0\x7d\xa6\x01\x00\x30\x6e\xe5\x1e\xef\xa5\x41\x6e\xb5\x39\xfc\x58\xc8\xbd\x0e\x74\xc2\xc6\xac\x19\x07\xf4\x1e\x8d\x91\xa2\x60\x2a\x50\x06\x11\xc1\x4e\x28\x42\x45\xc7\xfa\x19\x15\x01\x33\x18\xf5\x89\xc2\x9b\xc2\x09\x4a\xa3\x64\x01\x00\x98\x48\xa5\xfa\x90\xaf\x71\xae\xd5\x46\x6e\xa7\x83\xf1\x7e\xcf\x0d\xce\x13\x9d\x8b\xa5\x0b\x7d\x02\xcd\x85\x04\xd6\x5a\x5b\xb2\x86\x65\xae\x73\xa3\xd0\x22\x35\xf8\xae\x61\x24\x3e\x7f\x5c\x7d\xf8\xf5\xc9\x81\x0d\x45\xe5\x6c\xee\x54\xba\x91\x9c\xd9\x57\x62\x7d\xc0\xc3\x70\xc4\x23\x1a\x3d\xe2\xa6\xd3\x44\xe6\x75\x58\x30\xb8\x41\x83\x8a\x17\xb6\xb1\x42\x6e\xd0\xc2\x4e\x27\x22\xfa\x10\x7e\x66\xb2\x00\x00\xe0\xe2\xeb\x3a\x57\x22\xc1\x93\x2f\x6d\x06\x5d\x06\xd2\xb3\xd6\x53\xef\xf6\xc0\x52\x0c\x11\x02\x23\x7f\xb6\xd1\x47\x3c\x97\xca\x75\x6e\x42\x68\x4e\xce\x43\x70\xcc\x2c\xdd\x3a\x9b\xdf\x4b\x7c\xb9\x7d\xd1\xe6\x59\xaa\xed\xf8\x45\xda\xdd\x38\x4c\x6a\xba\xf5\xf1\xf9\xf6\x0f\xfe\x7f\x0d\xfc\x00\x3c\x7d\xba\xff\x34\x85\x99\x10\xa0\xed\x0e\x0d\xe4\x84\x9b\x3c\x81\x8d\xc4\x44\xd0\xa4\x12\x0b\x47\xde\x55\x8f\x20\x97\xe2\xcf\xdf\x34\x90\x6a\xd5\x5a\x8b\x9f\x01\x80\xc2\xaf\x76\xd8\xd0\x89\xf3\x39\x81\x8e\x61\x7e\xcd\x08\x7f\xf8\x1e\x50\x71\x2d\x50\x40\xf6\xcc\xe9\xfd\xb7\x55\x6b\x39\x63\xb7\x10\x3a\x39\xd1\x71\x84\xcc\x48\xc5\x65\xc6\x92\x91\x1b\xbf\x00\xa9\xc8\x22\x13\xc1\x91\xb9\x4e\x83\xb9\x0c\xb6\xd3\x53\x46\x97\x8c\xe8\x45\x1b\x31\xbd\x8c\xc2\xe2\xfe\x42\x84\xc0\xe6\x05\x48\x3a\x17\x97\x41\xff\xa8\xf6\xd2\x68\x95\x62\x8f\x87\x9e\x9f\x00\x47\x55\xfd\x9d\xb4\x02\xac\xb4\xbb\xec\x07\xb8\x5f\x8b\x84\x1e\x4e\xa8\x02\x24\xf2\x19\x61\xe6\x1c\xa5\x5b\x03\xf0\xe7\xe1\xfc\xfa\xf9\xfc\xd0\x30\x15\xdb\x71\x0c\x0a\x17\x60\x59\xd2\xef\x60\x6a\xa0\x3d\xfe\xc5\xfb\xf9\x2a\xf5\xb3\x51\x32\x25\xc2\xf8\x21\x2b\x22\x01\x70\x1f\x0a\xae\x5e\xe7\xab\x7b\x1d\x6f\x6d\x68\x5e\x11\xaf\x85\x24\xee\x86\x7e\xf8\x99\xd1\xee\x5c\x05\xd2\x62\x7a\xd6\x38\x80\x49\x66\x0c\xab\xa7\x27\x42\xd1\xd2\xe0\x46\x7e\x19\xcc\x1a\x5a\x2e\xe6\xb3\xd7\xc4\xe3\x33\xcc\x4b\x62\xf2\x06\x05\x1a\x97\xea\x3e\xb9\x1c\xea\x27\x99\x74\xfb\xf0\x9f\xce\xc0\x43\x7a\xbf\x71\x7f\x95\xb3\x05\x9c\x55\x25\x9a\x09\x90\x7e\xc2\xd8\xf3\xdc\xcd\xa7\x6c\x80\x5f\xf8\x8e\xa9\xad\xcb\xd6\xb4\x01\xa6\x80\x71\x8e\x44\xc5\xd7\xd2\x7d\x2f\xee\x07\x0f\xc7\x68\x65\x97\x46\x7f\x39\xbc\x4e\x96\x2d\xf8\x97\x48\xd4\xaf\xb4\x7f\xd1\xbc\xb2\xf4\x1d\x8a\x75\x91\xaf\x8b\xab\xc4\xfb\x87\xd5\x45\x78\xce\x2d\x30\x91\x2e\x99\xe5\x0d\x53\xa0\xa6\xed\x0f\x35\x50\x60\x06\x21\x45\xb3\xf5\x11\xb6\x58\xbe\x6e\x51\x05\x83\x88\x74\x0b\xb7\x97\x9b\xf3\x95\x72\xb7\xf7\x63\x99\x0c\x8b\xba\x5e\x17\x38\x77\xab\x2b\x9d\x2c\x13\xa6\x70\xae\xd3\x4c\x2b\x54\xd6\x33\x09\x4c\x08\x82\x4d\xc2\xb6\xe4\x3d\xf2\x4e\x93\x85\x8c\xd9\x5d\x03\x49\x80\xbd\xab\x66\x21\x81\xd5\xc0\x80\x07\xa2\x90\x39\xaa\xc0\x23\xd9\x06\xc4\xae\x41\x00\x00\xe0\x17\x6b\xd8\xcc\x6c\x5b\x3e\xd7\xcb\x6a\xdd\xa4\x3a\x15\x39\xc0\x55\xd6\x58\xfa\x1c\x86\xdb\xd6\x53\x8b\xf3\x6b\xd4\xc0\xcf\x9a\x9c\xc0\x77\x1f\x75\xae\x2c\xa4\xee\xbf\x04\xcc\x4b\x3a\x86\xa6\x94\x85\x4a\x8e\xb2\xba\x95\x26\x78\x48\xb7\xe8\x94\x1c\x32\x2d\x8a\x6c\x62\xb0\x26\x86\xe9\x03\x00\x00\x60\x57\xb0\xdc\x05\x33\x48\xd8\x00\x10\x46\xfc\xd5\xa8\xb5\xc5\xfd\x8b\x09\x39\xf9\x37\x2d\x00\x3b\x34\xb9\x2c\x50\x82\x1b\x8f\x7a\xf5\x2d\x94\xbb\x09\x45\x50\xae\xd3\x3f\x99\xb9\xc1\xa6\x74\xbc\xfe\x4f\x1b\x70\x81\x61\x04\xb9\xe2\x3b\xe4\xcf\x28\xe0\x65\x87\x0a\x30\xcd\x1a\x02\xc1\x2b\x86\x69\x90\x89\x4f\x2a\x39\x0c\x91\xd9\x5a\xeb\x04\x99\xba\x69\xa7\xf5\x5b\xee\x4a\x1f\xed\xb4\xc6\x5e\x3f\x1d\x9f\xa3\x65\x75\x80\x94\xf6\xd2\x33\xcb\x3b\xa6\x70\x7b\xae\x31\x80\x40\x31\xa5\x12\x34\x1f\x99\x62\xdb\xab\x8f\xbd\xfa\xd8\xab\x8f\xbd\xfa\xd8\xab\x8f\xfd\x8a\x3e\xd6\xe5\xbf\x09\xda\x1f\xbb\x3c\xd3\x70\xaf\xd4\xa3\xa6\xb3\x34\xbd\xda\x33\x30\x83\x90\x31\x22\x14\x71\x97\xa9\x60\x0e\xf4\x06\xd0\xad\x7f\x1b\xfb\x2c\xdc\x89\x73\xea\x4a\x0b\xbc\x54\x00\x6e\x63\x4b\xe4\xc9\x35\xb8\x5c\x83\xcb\x35\xb8\x5c\x83\xcb\x35\xb8\x7c\xb5\xe0\xd2\xfa\xc9\xb9\xf5\x50\x6f\xe9\x2b\x77\x7f\xa8\x42\x0e\xd8\x4d\xf3\x3b\xa2\xce\x05\x9f\xaf\x25\x8a\xf2\x6b\xa5\x73\xba\x16\xb9\xbf\x76\x91\x5b\xa1\x75\x5c\xaf\x90\xe7\x46\xda\x43\xa7\x6e\x1f\xea\xb0\xc0\x0c\x16\xdb\x63\x45\x83\xc9\x13\xa4\x13\xbf\xde\xa4\x5a\xb6\x45\x65\xdd\xd1\x08\x15\x52\x05\xf6\xfb\xf3\x78\xaf\xd0\x5e\xa0\x5e\x4f\xe2\x31\x4f\x9a\xbe\x75\x86\xa8\xda\x88\xe2\x50\x1c\xa1\xe0\xd8\x82\xd5\xf9\x91\x78\xce\x22\x97\x71\x8c\x8d\x34\x8b\xb2\xea\xcd\xeb\x82\x4e\xa8\x45\x0f\x0c\xa8\x33\x0f\x0c\x92\x60\x96\x24\xfa\x05\xb4\x81\x7b\x54\x87\x11\x08\xdc\xb0\x3c\xb1\x04\x56\x87\x4f\x6f\xc9\x13\x04\x92\x95\xca\x57\x56\x97\xda\xd8\x47\x57\x3b\x1f\xc8\xe0\x7d\x03\x6a\x10\x6d\xa6\x8d\x1d\x01\x03\xe3\x9b\x5c\xd0\x68\xa5\x08\xc0\x08\xbe\xbb\xbb\xbb\xbb\x1b\x7f\xf7\xed\x1f\x7f\xf8\xe3\x08\xb4\x81\x7f\x78\xd3\x88\x7c\x6c\x6a\xa8\x94\xb7\x0d\x23\xc2\x83\x24\x58\xa8\xb5\xce\x95\x00\x6d\xe0\x53\x6e\xfd\xdf\x35\x81\x77\x8c\xa3\x40\x7d\x0b\xeb\xdd\x51\xbf\x3e\x43\x9d\x3b\x93\x04\xb9\x92\xbf\xe5\x08\xce\x0f\x49\x55\x9f\xa3\xed\x96\x3a\x90\x9d\xcc\x48\xdd\xe4\x2a\xda\xf2\x86\x02\x1c\x24\xc1\x1a\xed\x0b\xa2\x82\xf7\x77\x77\xce\x2f\xc0\xf7\x77\xff\xfc\xc3\x28\x4c\xe5\xe0\x3b\xba\x32\xa9\x9c\x2c\xac\x11\xd6\xe8\xcc\xfe\x1f\xef\xee\x5a\x61\x37\xda\xa4\xcc\x4e\x41\x2a\xfb\xdd\xb7\x3d\x23\x95\xca\xe2\xb6\xe1\x30\x47\x39\x77\xad\xe6\x3a\x19\x3c\xd4\x00\x0e\x92\xe0\x89\x67\x23\xf8\x55\x64\xde\x70\xeb\xb3\xf3\x89\xbf\x49\xfe\xe1\xf0\xda\x4c\x08\x83\x54\x6c\xfe\xe1\x50\xef\xb1\x6a\xc2\x05\x66\x10\xe6\x8b\xfb\x47\x02\x6d\xca\xb3\x0e\x96\x6d\x69\xd4\x35\x41\xd5\xa1\x60\x65\x48\xd2\xd6\xb3\x66\x18\x34\xf0\xbe\xc4\xa6\x2f\x47\xeb\xc8\xcf\xc6\xa5\x55\xb7\x7c\x6e\x72\x88\x37\xaf\x48\xcf\xba\x46\x50\x6e\x4c\xcd\x72\xbb\xd3\xc6\x1d\xbb\x5c\x2c\x7d\x4f\xd4\x9b\xc8\xcc\x96\x8b\x36\xdc\x63\x98\xf6\xda\x22\x60\x2e\x3a\x60\xb3\x4b\xb2\x1a\x0c\x32\xbe\xf3\x08\x2c\x93\xde\x1c\xd0\x8c\x06\xab\xbb\x43\xd1\x3d\x2a\xee\x12\x8d\x90\xc4\xd6\x09\xae\x56\x3f\xf7\x4a\xe2\xbe\x04\x05\x83\xa9\xde\x17\xa9\x27\xd1\xce\xbb\x98\x51\x91\x97\x10\x90\xf5\x47\x5d\x19\xdf\x39\xf8\x46\x8e\xfd\x29\xcf\x8a\x13\x6d\xc8\x51\xa0\x77\x01\x11\xfa\x6b\x4d\x55\x6a\xcc\x7f\x3c\xc2\x7a\x07\x39\x2b\xb3\x1c\xaf\xc5\x63\x3d\xa0\x4c\xb5\x9a\x25\x5d\x4b\xbf\x6a\xe9\xd6\x35\x59\xba\x26\x4b\xd7\x64\xe9\x9a\x2c\x5d\x93\xa5\x6b\xb2\xf4\x7f\x39\x59\x22\xda\xbd\x22\x4d\x5a\xad\x7e\x1e\x9e\x20\x81\xd5\xae\x9b\x46\xce\x5d\xb5\x3a\x66\x12\xff\xbb\xc9\x51\x5f\x65\x47\xaa\xed\x90\xa2\x8e\x54\xdb\x78\xf6\xb6\x40\x04\xab\x33\x9d\xe8\xed\x21\x16\x74\x9a\x0f\xbe\xf7\x17\x69\x74\x6e\xf1\xc9\xe5\x58\xe7\xe7\x94\x7b\x47\xee\x29\xc4\xc4\xe3\x2f\xce\x1b\x37\x13\xa9\x87\xfd\x06\xa4\x4a\x3a\x55\xe1\x27\x64\x55\x44\x9a\x4b\x66\x5b\x52\x62\x17\x17\xfc\xf8\xf1\x8b\x24\x7f\x93\xa8\x9a\x4f\x8d\xfc\xa7\xe2\xdc\x6e\xfd\x5c\x6e\xea\x8f\x40\x50\x6b\x86\x66\xca\x34\xcf\x38\x96\x5c\x36\x17\x1d\x0a\x41\x88\x44\xe9\xeb\xe4\xe5\x39\x73\x4e\x6a\xfa\x06\xfc\x57\x68\x4b\x28\xba\xd7\x29\x93\xaa\x3f\x4b\x7f\x58\x05\xc8\x68\x74\xc7\xfa\x6a\xb4\x33\x10\x1e\xe0\x52\x1e\xe2\x71\xc5\x5f\x34\x13\xff\xca\x12\xa6\x38\x9a\xc5\xb2\x97\xa1\x45\x23\x5a\xe4\xae\xd8\x79\xf2\xa7\x36\x51\x09\x60\x21\x28\x34\x10\x2d\xef\x0c\x44\x3e\x2a\xab\xa8\xf2\x22\xd1\x28\x26\x1b\x21\xdf\x3f\xea\xeb\xd2\xc1\xa6\x27\xf8\x03\x17\x18\x47\x84\xe3\xb4\xa8\xb6\x45\x7f\xe8\x9b\x5a\x47\x59\xb8\xbf\x72\x1f\x97\xe2\xa8\x3e\x3f\xbc\x7d\x34\x8b\xfb\x8b\xc6\x52\x9d\xe0\xc7\x16\x53\x99\xb4\x5d\x83\xa9\x78\x37\x90\x04\x02\xb3\x44\x1f\xe2\xe9\xd3\xca\x25\x8f\x58\xa7\x1e\xc1\x5a\xdb\x5d\xcc\xb2\x12\xdd\x12\x85\x8b\x3d\x71\x62\x69\x58\x3a\xc2\xcb\x4e\xf2\x9d\xbf\x4a\xb8\x46\xf0\xdb\x1e\xa1\xe2\x5f\xde\x48\x6c\xcb\x37\x3b\xc5\x56\xf8\xeb\x65\x92\x6f\x07\xcc\xbc\x87\x2a\x74\xb4\xef\xf9\xc3\x02\xb2\xd0\x92\x69\x11\x22\x62\x41\xb6\xc3\x2b\x8e\x40\x2b\xbf\xf0\xe3\x4c\xb9\x3b\x31\x9c\x25\x92\xeb\x91\xdb\x56\x57\x0a\x93\x91\x9f\xd4\x63\xef\xdc\xcc\x28\xf8\xc8\x31\x57\x12\x74\x73\x0a\xa9\xb4\xc2\x4b\xc7\xae\x8b\x15\x46\xdb\x66\x66\x6d\xe8\x9f\x2a\xc0\x20\x09\x76\xfa\xa5\xc1\x88\x8f\xf5\x8f\x30\x85\xd1\x8e\x5a\xd6\xaa\x72\xcf\x6c\xe5\xb6\x70\x91\xb6\x82\xd5\xc0\x40\x31\x0b\x5b\x66\xf1\xc5\xdf\x1b\x2d\x2d\xc7\xf7\x63\x35\xe4\xd4\x92\x47\x87\xdb\x92\x21\x24\xf8\x98\xec\xac\xe4\x68\xc5\x9d\x15\x88\x16\x19\x65\x5a\x0c\xf2\x0d\xcb\x00\xe7\x59\x5c\x85\x38\x54\x73\x07\x15\x17\x9d\x69\xd1\xb8\xb1\x03\x00\x31\x84\x45\xfb\xa1\x91\x53\x12\x49\x51\xee\xb3\x15\x33\x11\x72\x95\x20\x51\x30\x38\xcb\x9e\x31\x7a\x55\xec\xf4\xab\x11\xdb\x19\xe0\xd1\xa6\x2e\x96\x49\x50\x5e\x71\xfd\xb4\x5f\x34\x35\xf0\xf0\xdc\x01\x12\x28\x0d\x59\xbe\x4e\x24\x07\x7f\x09\x61\x5d\xc4\x0d\x9f\x13\x34\x8e\xa1\x12\x0b\x24\x81\x56\x49\xa5\x02\x05\x76\x67\x74\xbe\xdd\xd5\xa3\x47\x8d\xf0\xc5\xb5\x27\x3a\xaa\xf1\xe2\x60\xbe\x1f\x1a\x53\xa2\xbb\x8f\xce\xa4\x50\x23\x50\xc6\x38\x76\x6c\xef\xc1\xab\xb7\x2c\xbf\xd8\xd7\xdd\xba\x38\xc3\xbc\xe4\xbe\x45\x66\x70\x2f\x75\x4e\xaf\xeb\x3a\xd8\xc9\xa5\x77\x28\x02\xd6\x62\x59\x2c\x42\x07\xe3\xc5\x80\x52\x9c\xc2\x1d\x74\x59\xfa\xb1\x19\xe7\xe4\xb2\x74\xa4\x5c\x64\xb7\xa6\xfd\xb2\x74\xb9\x6a\xd0\xb9\x18\x01\x4e\xb6\x13\x60\x90\x68\xce\x12\x20\xcb\x94\x18\x4b\x35\x74\x38\x85\x19\xcf\x38\x77\xc7\x23\x2e\x51\x5a\x1d\x73\x99\xaf\x87\x63\xe6\xeb\x52\x38\x17\x5c\xb0\xb4\xa8\xd8\x45\x37\x32\x73\x2a\x24\x2e\x16\xc5\x1d\xa6\x4e\x2d\xfd\x7a\x06\x0e\x2c\xb7\x3b\xf7\xa7\xbf\xc3\x0c\x8c\x8a\xf2\xaf\x07\x6a\xbf\x18\x55\xa8\x67\x9f\x8e\x1c\x4d\x33\x23\x92\x5b\x75\xa4\xba\xb8\x07\xc2\x04\xb9\x25\x60\x3e\x5a\x01\x2b\x20\x4a\x92\xe7\x61\xd1\x67\x33\x2f\x92\x8a\xf4\xf1\x40\x16\xd3\x73\x3c\x90\xe4\x28\x8a\x9b\xa1\x9e\xcc\x75\xef\x33\xbb\x4b\x84\xda\x30\xa2\x37\xa1\xc7\xe9\xb1\xb8\xef\xd3\x4f\x07\x6a\x74\x91\xcd\x02\x3d\x9f\x4b\xee\x08\x25\x10\x67\x09\x02\xa1\x75\xd8\x21\xf4\x08\x1f\xfe\x86\x0d\xa7\xf9\xf5\x86\xf0\x82\x4a\xdf\xfb\x0d\x1e\xaa\xf6\x82\x83\x5e\xfb\xf8\xf5\xaa\x27\x1c\x8e\x8f\xd3\x74\xdf\x98\x9d\x45\xb0\x28\xae\xcc\xe8\xad\x0f\x29\x85\xd1\x26\x8c\x2c\xcc\x67\x60\xb4\xbd\xf4\xf6\x97\x3b\x25\x98\x60\x7c\xd2\x66\x7a\xd3\x5e\x11\x15\xcc\xe2\xd8\xca\xf4\xe2\x9c\x34\x45\x22\xb6\xed\x4f\x47\x3f\x06\x38\xc0\x2f\x59\xc2\xa4\x22\x78\xd9\x1d\x82\xcb\xcc\x8d\x41\x65\xc1\xbf\xe0\x03\x1b\x26\x13\x14\x97\x32\xe1\x73\xd9\xfe\xc5\x80\x83\x2a\x13\xbc\x94\xf1\x5d\xa1\x68\x66\xa3\xa4\x50\x9c\xf3\xf4\x3f\xb6\xef\xe7\x7b\xeb\x3f\xe6\x5b\x1a\xcc\xd2\xc1\xc7\x87\x60\x30\x2b\x8e\x89\x06\x03\xed\x30\x98\x5e\x2e\xcb\xd7\x3e\x7a\x79\x79\x8c\x90\xd1\x76\x7d\x97\x38\xe6\x0c\xfc\xa3\x2f\xbe\xfb\xe2\x79\x9a\xf2\x73\x33\x47\x00\x2f\xcc\x6f\x53\x1a\x1b\x2e\xae\x5e\xca\xb5\x47\xfd\x6f\x33\xf3\x17\x26\xdd\x92\xc4\x99\x50\xaf\x54\xfe\xfd\x08\x1b\xe5\x52\x18\xdb\xb9\x71\x81\x61\x0a\x5a\x04\xe2\x26\xfe\x28\xb8\x04\x0f\xea\x4e\x00\x4b\x95\x23\x81\x3b\x8b\x07\xd2\x82\xa4\xca\x23\x5d\x97\x8f\x66\x25\x15\x1f\x3c\x1c\x0f\x0c\x92\x42\xd1\xb7\x6d\x20\xd5\xc1\x7f\x7d\x3d\xb4\x55\xf1\xc7\x47\x9b\x3d\xfb\xd2\x34\x87\xdb\x6f\xc1\x97\xaf\x98\x0d\xbc\xc5\x7e\x7a\x02\x3f\x60\x17\xcd\x6b\x3c\x16\xd4\xb0\xf6\xa4\x15\x30\x0b\x0c\x38\x1a\xdb\x5c\xf4\x2b\x0a\x67\xa9\x16\x98\x24\x28\x80\x6d\x2c\x86\xe7\xba\xf2\x8c\xac\x41\x96\xfa\xb7\x8b\xf6\xef\x27\x65\x9f\x0d\xab\xbe\xf6\xc0\x10\x8c\xeb\xc9\x30\x45\xb2\x2b\x3c\x9c\x0c\xf0\x97\x33\xa4\x68\xe0\x8e\x1c\x38\x85\xfa\x5f\xbc\x95\x29\x00\x00\x00\x5b\xd2\x28\x9e\x14\x02\xad\xb0\x08\xd3\x60\x75\x2c\x15\x35\x62\x0f\x31\xa1\x5e\x37\xdc\x11\xb5\x5a\xe2\x96\x77\xb3\xbb\x3c\x65\xca\x1f\xb4\xf6\x6b\xd8\x34\x7e\x53\xc2\xe5\xa3\x52\x6d\x41\xa0\x65\x32\x69\xdb\xad\x64\x6b\x9d\x87\xc7\xb3\x8e\x12\x78\x0d\xfb\x31\x2f\xf9\x4b\xb8\x27\xde\xba\x77\x5d\x2f\x08\x9d\x21\x45\xe5\x1d\x5f\xc0\xdb\x1e\xbf\xb5\xad\xea\x2b\x93\x24\xb8\x6c\xb4\xfe\xad\x19\x01\x79\xa6\x55\xa7\xca\xa4\xb2\x3f\x7c\xdf\x31\xde\xf6\x2d\x57\x83\x8c\x06\x0d\xf2\xd1\x03\x16\xa7\x0a\x5c\x22\xc5\xd2\xd4\x57\xb3\x43\xda\xb9\x91\x68\xaa\xea\x6a\x1f\x64\xe8\xb1\x7c\x25\x2f\xd8\xf7\x9b\x94\x76\x9e\x85\xb6\x8c\xa1\x48\x44\xe3\x92\x32\x4a\xbb\x2c\x40\x3e\x99\x1c\x47\xf0\x13\x4b\x08\x47\xf0\xab\x7a\x56\xfa\x45\xbd\x3a\x17\xe9\x67\xc7\x17\x10\xf5\xe6\xc8\x08\xc8\xca\xf3\x6d\x97\x77\xdc\xe6\xc4\x01\xc6\x1e\xb1\xa1\xb9\xf2\x5e\xe4\x20\x37\xde\x9e\x67\xf5\xcf\x9c\x2e\x63\x6d\x37\xd3\xb3\x47\x3a\x2f\xae\x5e\xd0\xa0\x7a\x05\xc5\x39\x7b\xbe\x34\xf1\x95\xdc\xb0\x37\x17\x69\xb6\xad\x83\xd7\x3e\x71\x3c\x7f\x73\xf3\xb2\x70\x17\x79\x2a\xec\xb5\x95\x31\xa6\xfa\xd8\x02\x58\x33\xfe\xdc\xf7\xe6\x58\x77\x40\xbb\xdc\xa5\x1f\x83\x74\x5c\x8b\x44\x06\x41\x12\xa4\x92\xc8\x71\xd4\x52\xbf\x07\x10\x46\x6e\xe2\x93\x78\xc5\x96\x69\x86\xdc\xb5\x74\x3d\xd6\x31\x68\x66\xb6\x9f\xeb\xe9\x41\x74\xa1\xe9\xd0\x85\xd9\x7e\xf1\xa7\xdd\x1b\x7c\xed\xd9\xdc\x78\xd6\x62\x1c\x78\x7f\xfb\x1c\x6f\x40\x38\x69\xda\xc7\x07\x81\xf7\xef\x59\x92\xed\xd8\xfb\x63\x5b\xf1\x08\xaf\x97\x7f\xf5\x73\xa8\xad\xa1\x98\x82\x35\x79\x60\x9e\xac\x36\xce\xde\x42\xcb\xd1\xb9\xbb\x33\x7b\x99\x45\xf1\x70\xfa\x08\xec\xbb\x77\xb5\xf7\x5f\xfd\xcf\x4a\xbe\x09\x7f\xfd\xdb\x4d\xa0\x8a\xe2\x73\xe4\xc6\x35\xfe\xd7\x00\xf2\xcb\x16\x02\xc8\x58\x00\x00"),
 		},
 		"/crd/bases/engine.azk.io_controlplanes.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "engine.azk.io_controlplanes.yaml",
-			modTime:          time.Date(2019, 9, 28, 14, 35, 16, 331084945, time.UTC),
-			uncompressedSize: 2137,
+			modTime:          time.Date(2026, 10, 17, 2, 51, 18, 559746720, time.UTC),
+			uncompressedSize: 8114,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x55\x4d\x8f\xdb\x46\x0c\xbd\xeb\x57\x10\xe9\x21\x97\x5a\xc6\xa2\x97\x42\xb7\xc0\xe9\xc1\x08\x1a\x2c\xe2\xed\x5e\x8a\x1e\x68\x0d\x6d\xb3\x2b\x71\xa6\x43\x8e\xd0\x4d\xd1\xff\x5e\xcc\x48\xfe\x90\xe3\x6d\x17\x6d\x81\xf8\x64\x71\xa8\xc7\xc7\xc7\xa7\x61\xb5\x58\x2c\x2a\x0c\xfc\x48\x51\xd9\x4b\x03\x18\x98\x7e\x37\x92\xfc\xa4\xf5\xd3\xf7\x5a\xb3\x5f\x0e\x77\x5b\x32\xbc\xab\x9e\x58\x5c\x03\xab\xa4\xe6\xfb\x4f\xa4\x3e\xc5\x96\xde\xd3\x8e\x85\x8d\xbd\x54\x3d\x19\x3a\x34\x6c\x2a\x80\x36\x12\xe6\xe0\x03\xf7\xa4\x86\x7d\x68\x40\x52\xd7\x55\x00\x82\x3d\x35\xd0\x7a\xb1\xe8\xbb\xd0\xa1\x90\xd6\x24\x7b\x16\xaa\xf1\xf3\x53\xcd\xbe\xd2\x40\x6d\xc6\xd8\x47\x9f\x42\x03\xf3\xc3\x11\x40\xf3\x39\xc0\x44\x68\xc4\xba\xcf\x58\x25\x1c\xba\x14\xb1\xbb\x2a\x52\x01\x68\xeb\x03\x35\xf0\xe6\x4d\xfe\x9f\xb6\x71\x6a\x61\x02\x53\x43\x4b\xda\xc0\x1f\x7f\x56\x00\x03\x76\xec\x4a\x07\xe3\xa1\x0f\x24\xef\xee\xd7\x8f\xdf\x6d\xda\x03\xf5\x38\x06\x01\x1c\x69\x1b\x39\x94\xbc\x19\x0f\x60\x05\x3b\x10\x8c\xe9\xb0\xf3\xb1\x3c\xce\x18\xc1\xbb\xfb\xf5\x84\x13\xa2\x0f\x14\x8d\x8f\x5c\xf2\xef\x62\x2c\xa7\xd8\x55\xc5\xb7\x99\xd2\x98\x03\x2e\x0f\x82\xc6\xaa\xc3\x18\x23\x07\x3a\xd6\xf7\x3b\xb0\x03\x2b\x44\x0a\x91\x94\xc4\x4a\x6b\x17\xb0\x90\x53\x50\xc0\x6f\x7f\xa5\xd6\x6a\xd8\x50\xcc\x20\xa0\x07\x9f\x3a\x97\x79\x0f\x14\x0d\x22\xb5\x7e\x2f\xfc\xf9\x84\xac\x60\xbe\x94\xec\xd0\x48\x6d\x86\xc8\x62\x14\x05\xbb\x2c\x66\xa2\x6f\x01\xc5\x41\x8f\xcf\x10\x29\xd7\x80\x24\x17\x68\x25\x45\x6b\xf8\xd1\x47\x02\x96\x9d\x6f\xe0\x60\x16\xb4\x59\x2e\xf7\x6c\x47\x23\xb6\xbe\xef\x93\xb0\x3d\x2f\x8b\x90\xbc\x4d\xe6\xa3\x2e\x1d\x0d\xd4\x2d\x31\xf0\xa2\xf0\x14\x2b\xe6\xed\xdd\x37\xa7\x09\xbf\xbd\x20\x66\xcf\xd9\x04\x6a\x91\x65\x7f\x0a\x17\x27\xbd\x28\xf3\x07\x16\x97\x27\x8a\xd3\x6b\x23\xdd\xb3\x9a\x39\x94\x45\xf8\xf4\xc3\xe6\x01\x8e\x45\x8b\xe2\x73\x89\x8b\xb8\xe7\xd7\xf4\xac\x73\xd6\x85\x65\x47\x71\x9c\xd3\x2e\xfa\xbe\x20\x92\xb8\xe0\x59\x6c\x74\x4f\xc7\x24\x73\x8d\x35\x6d\x7b\xb6\x3c\xd8\xdf\x12\xa9\xe5\x71\xd4\xb0\x42\x11\x6f\xb0\x25\x48\xc1\xa1\x91\xab\x61\x2d\xb0\xc2\x9e\xba\x15\x2a\xfd\xdf\x2a\x67\x41\x75\x91\x15\xfc\x67\x9d\x2f\xef\x88\x79\xe2\x28\xce\x29\x7c\xbc\x04\x6e\x0e\xe4\xf2\x4b\xdb\x04\x6a\x67\xe6\x77\xa4\x1c\xb3\x41\x0d\x8d\xb2\xad\xbf\xb8\x1f\x5e\xfe\xe6\x8a\x15\xd2\x96\xa2\x90\x91\xde\xf8\xfc\x5e\x6c\x2c\xff\x86\x7e\xf3\xe1\xa7\x87\x7c\xfa\xba\x37\x6e\x37\x3e\x5e\x43\xaf\x6a\xbd\xa4\xce\x9a\xf7\x5b\xcd\x8e\xfa\x1a\xdd\x8b\x77\x13\xa3\xeb\x57\xd8\xa8\xff\x22\xf8\x32\x85\xa3\x98\x2b\xdf\x87\x64\x14\x3f\xe6\x8d\x71\x23\xe3\x6f\xb8\x1c\x21\xd6\xa2\x86\xd2\xd2\xfa\xfd\xbf\x00\xb8\x39\x9e\xcb\x23\x8c\x11\x9f\xab\xab\x96\x06\xce\xaa\xb1\xec\xb3\x16\xff\xc1\x09\x57\xa1\xe1\xb8\xa1\x87\x3b\xec\xc2\x01\xef\xce\xb1\x22\xdf\x62\x5a\xac\x17\xc7\x00\xa3\x17\x1a\xb0\x98\x68\xda\x71\x3e\xe2\x9e\xa6\xc8\xd9\x6a\xd8\xb6\x14\x8c\xdc\xc7\xeb\xdd\x5a\x76\xe5\x79\xa3\x96\xc7\xd6\x8b\x2b\x1b\x5f\x1b\xf8\xf9\x97\x6a\x44\x25\xf7\x78\x64\x93\x83\x7f\x05\x00\x00\xff\xff\xaa\x6a\x09\x3e\x59\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x59\x5f\x6f\xe3\xb8\x11\x7f\xf7\xa7\xf8\x61\xef\x61\x5b\x20\xb6\x91\x6e\x5b\x14\x06\x0e\xd7\xc0\xbb\x6d\xd3\xeb\x66\x83\x24\xbb\x2f\x87\x7b\x18\x8b\x23\x9b\x0d\x45\xea\x48\xca\x59\x6f\xd1\xef\x7e\x20\x29\xd9\xb2\x2d\x29\x8e\xb3\x7e\x49\x34\x22\x87\x33\xbf\xf9\x4f\x8d\xc6\xe3\xf1\x88\x4a\xf9\x85\xad\x93\x46\xcf\x40\xa5\xe4\xaf\x9e\x75\x78\x72\x93\xc7\xbf\xb9\x89\x34\xd3\xf5\xe5\x82\x3d\x5d\x8e\x1e\xa5\x16\x33\xcc\x2b\xe7\x4d\x71\xc7\xce\x54\x36\xe3\xf7\x9c\x4b\x2d\xbd\x34\x7a\x54\xb0\x27\x41\x9e\x66\x23\x20\xb3\x4c\x81\xf8\x20\x0b\x76\x9e\x8a\x72\x06\x5d\x29\x35\x02\x34\x15\x3c\x43\x66\xb4\xb7\x46\x95\x8a\x34\xbb\x09\xeb\xa5\xd4\x3c\xa1\x6f\x8f\x13\x69\x46\xae\xe4\x2c\xf0\x20\x21\x22\x63\x52\xb7\x56\x6a\xcf\x76\x6e\x54\x55\x68\x17\xde\x8d\xf1\xef\xfb\x4f\x37\xb7\xe4\x57\x33\x4c\x9c\x27\x5f\xb9\x89\xe5\x52\xc9\x8c\xdc\x08\x68\x8e\xb9\x6b\x93\xfc\xa6\xe4\x19\x02\xa7\x25\xdb\x1e\x1e\x8f\xd5\x82\xad\x66\xcf\xae\x86\xa4\xc5\xac\x4d\x49\xbc\x9c\xb7\x52\x2f\x7b\x58\x95\xd6\xac\x65\xd8\x20\xf5\xf2\xde\x93\xe7\x16\xab\xdd\xf3\x09\x8c\x32\xa3\x13\x10\xee\x97\x9f\xfe\xf0\xf7\x49\xd8\xf1\xe3\x8f\x6f\xee\x98\xc4\xe6\xcd\x1f\x7f\xad\x57\xed\x29\x4d\x62\xf3\x3a\xe6\x9f\xcb\xa5\x25\xc1\xd7\xfa\xd6\x9a\xa5\x65\xe7\x3a\x0f\x4a\xab\x12\xef\x57\x1c\xf6\xc1\x67\xe2\x5f\x4c\xca\xaf\xba\xf5\x09\xef\x4f\x3e\x81\xad\x97\xb9\xcc\xc8\xb3\xbb\x31\xfe\x2a\xf7\x6c\x5b\xac\xe6\x6c\xbd\xc3\x87\xaf\xa5\xb4\x6d\xf8\x45\xb2\xc6\x1e\xc3\xc6\x9b\x27\x47\xae\xdc\xe2\x77\xb5\xec\x60\xb3\xb4\xa6\x2a\x67\xd8\x77\xea\xb4\x23\xfa\x2e\x50\x07\x52\x8a\x81\xdb\x10\x03\x23\x00\x28\x55\x65\x49\x1d\x04\xc7\x08\x70\x99\x09\xec\xdf\xbc\x09\xff\x57\x0b\x5b\x87\x5e\xcd\x2c\x69\x3e\xc3\xff\xfe\x3f\x02\xd6\xa4\xa4\x88\xe2\xa6\x97\xa6\x64\x7d\x75\x7b\xfd\xe5\xdd\x7d\xb6\xe2\x82\x12\x11\x10\xec\x32\x2b\xcb\xb8\x6e\x4f\x0e\x48\x07\xbf\x62\xa4\xe5\xc8\x8d\x8d\x8f\x7b\x12\xe1\xea\xf6\x7a\x04\x00\x40\x69\x4d\x19\x10\x6f\x64\x01\x80\x56\x3a\xd9\xd2\x0e\x4e\x7c\x1b\x44\x4a\x6b\x20\x42\x02\xe1\x74\xea\x3a\xd1\x58\xc0\xa5\xf3\x4d\x0e\xbf\x92\x0e\x96\x4b\xcb\x8e\xb5\x8f\xaa\xb5\xd8\x22\x2c\x21\x0d\xb3\xf8\x2f\x67\x7e\x82\x7b\xb6\x81\x09\xdc\xca\x54\x4a\x04\xb9\xd7\x6c\x3d\x2c\x67\x66\xa9\xe5\xb7\x2d\x67\x07\x6f\xe2\x91\x2a\x78\x8a\xdf\xe3\x18\x73\x8d\x26\x15\xc0\xac\xf8\x02\xa4\x05\x0a\xda\xc0\x72\x38\x03\x95\x6e\x71\x8b\x4b\xdc\x04\x1f\x8d\x65\x48\x9d\x9b\x19\x56\xde\x97\x6e\x36\x9d\x2e\xa5\x6f\x12\x68\x66\x8a\xa2\xd2\xd2\x6f\xa6\x11\x48\xb9\xa8\xbc\xb1\x6e\x2a\x78\xcd\x6a\x4a\xa5\x1c\x47\x39\x75\x8c\x8c\x49\x21\x7e\xd8\x5a\xf8\x6d\x4b\xb0\x03\xef\x07\xb6\x9e\xd4\x0b\xf3\xcf\x52\x0b\x48\x07\xaa\xb7\x25\x71\x77\x68\x06\x52\x00\xe1\xee\xc3\xfd\x03\x9a\x43\x23\xe2\xfb\x10\x47\x70\x77\xdb\xdc\x0e\xe7\x80\x8b\xd4\x39\xdb\xb8\x0b\xb9\x35\x45\xe4\xc8\x5a\x94\x46\x6a\x1f\x1f\x32\x25\x59\xef\x63\xec\xaa\x45\x21\x7d\x30\xec\x6f\x15\x3b\x1f\xcc\x31\xc1\x9c\xb4\x36\x1e\x0b\x46\x55\x86\x58\x12\x13\x5c\x6b\xcc\xa9\x60\x35\x27\xc7\xdf\x1b\xe5\x00\xa8\x1b\x07\x04\x9f\xc7\xb9\x5d\xdb\xf6\x17\x26\x70\xb6\xe4\xa6\x78\x01\xcf\x44\xda\x7d\xc9\xd9\x9e\xf3\x0b\x76\xd2\x06\x07\x0d\xb5\x01\x26\x3f\xce\x0f\xfd\x31\x07\x00\x99\xaa\x9c\x67\x7b\xc7\xf9\x3e\xfd\x50\x86\xed\xb2\x26\xd6\x6b\x0a\xa4\x8e\x8f\x8e\x0a\x4e\xc9\xaa\xa4\xda\x1b\x9a\xf8\x3f\xe0\x0b\xc4\x84\x80\x05\x2b\xa3\x97\xc1\x86\x07\x0b\xfa\x44\xdd\x26\xd0\x23\xea\xa1\x07\xdf\x04\x61\x62\x16\x60\x58\xce\xd9\xb2\xf6\x9d\x7e\xb0\x2b\xdc\xc1\x15\x84\xc9\x5c\xf0\x82\x8c\x4b\xef\xa6\x66\xcd\x76\x2d\xf9\x69\xfa\x64\xec\xa3\xd4\xcb\xf1\x93\xf4\xab\x71\x32\x9c\x9b\x46\x55\xa7\x3f\xc4\x3f\x1d\xf2\x00\x0f\x9f\xde\x7f\x9a\xe1\x4a\x08\x18\xbf\x62\x8b\xca\x71\x5e\x29\xe4\x92\x95\x70\x93\x56\xbe\xbb\x88\xe1\x78\x81\x4a\x8a\x9f\xde\x76\xb0\xea\xf4\xac\x01\x5f\x02\x80\xa3\x86\x64\x36\x3a\x91\x69\xd3\x0e\x0d\x7a\x43\xd3\x20\x35\xbe\xa0\xab\x62\xc1\x16\x26\x6f\x4c\x5e\x9b\x58\x1b\xc1\xee\x22\x66\x5a\x11\xd2\x6a\x75\x10\xcf\x00\xf0\xc8\x5c\x3a\xb0\xcf\x04\x7e\xab\x8c\xad\x8a\x8b\xe0\xdf\x54\xa9\x18\xde\x78\x77\xb0\x81\x75\x55\x1c\xca\x36\xc6\xe5\x11\xe5\xdd\x11\xe5\x2f\x07\x94\xdc\xd8\x82\x7c\x6c\xed\xde\xfd\xa9\x13\x9e\x5d\xd3\xb7\xfb\xad\x8b\xfb\x9f\x3f\x3f\x84\xd7\xa7\x21\xda\x1d\xee\xa9\xf8\x9e\x14\xf0\x71\xe9\x5e\xc8\x9b\x85\x0b\x79\xf4\x15\x31\xdf\x6a\x77\x86\xa3\xbe\xb5\x10\x64\x39\xd5\x3e\xa6\x7c\x8f\x45\x90\x80\xd7\x6c\x37\x28\xc8\xf9\x03\xbc\x52\x25\xa8\x05\x26\x5f\x57\x4f\xe7\x91\xad\x38\x7b\x3c\x58\x2a\x3d\x17\x47\x12\x1d\xc8\xf4\x31\x9e\xd1\x96\xac\x86\xa8\x91\x6f\x5f\xb4\xfa\xe8\x23\x9e\x80\xd1\xa0\x3e\x89\x87\x52\xd0\x30\x7e\x83\x8a\x0c\x43\x5c\xeb\x51\xc7\x14\x87\x76\x73\x13\x1b\x95\xf6\x81\x3d\x3c\x87\xd5\x39\x45\xa9\xe1\xfc\xda\x29\x7b\x4c\xb3\xb5\xb8\x25\xf9\x55\x93\x72\x5b\xe2\xc2\xd8\x98\x8c\x32\xa3\x73\xb9\x1c\x60\x0c\x58\x56\xe4\xe5\x9a\xe1\x0d\xa6\xec\xb3\x56\x72\x1e\xd8\x37\x90\x1d\x77\x3f\x5d\xf7\xf4\x43\x9a\x35\xe9\x20\xb4\x10\x63\x2f\x0b\x7e\xdd\xa1\xa1\x45\x09\x85\xb9\xef\xc8\x71\x84\xba\xff\x65\x7b\x0a\xe9\x13\xa0\x23\xf1\xef\x2f\x20\x6b\x69\x33\x7a\x89\x95\x7b\xed\x9b\x99\xa2\xac\x3c\xdb\xb8\xb7\x31\xf4\x80\xbb\x0d\x42\xd4\x0f\x4e\x0f\x2c\x03\xfa\xf6\x69\xda\x0e\xd2\x79\xc8\x35\x2c\xc2\x2c\x76\x72\xbe\x6b\xed\x81\x74\x78\x5a\xb1\xee\x48\x2f\x11\x87\x23\x71\x13\x2e\x0e\x4f\x6c\xeb\x6c\x67\x99\xc4\xe8\x65\x0e\xd7\x8b\x60\xd7\xb4\x7a\xb2\x5a\xcd\x86\x6d\x9a\x21\xab\x24\x3b\xdf\xce\x37\xba\x37\x93\xf7\x27\xa2\xef\xa2\xcd\x1d\x6b\x7e\x22\x75\xb2\x32\xf5\xfa\x46\x17\x1b\x1e\xc7\xd9\x5e\xd1\x0a\xb3\xc1\xf1\xf8\x07\xa0\x9e\x6a\x56\xa4\x85\x62\x11\xad\x74\xb2\xc8\xdb\x0b\x89\xd9\x19\x05\x6c\xde\xec\xae\xc9\x8b\xba\xac\x6f\xab\xf9\x76\x30\x05\xf9\x3a\xf9\x93\x3c\x96\x1f\x88\xb3\xd2\x05\x0a\x23\x58\x05\x1d\x28\x1a\x36\xf0\xaa\x4a\xe7\x2d\x53\x11\x27\x90\xf5\xe5\x64\x7b\xe6\x0b\x2b\x5d\x40\xe5\xc1\x92\x76\xb2\xb9\xcb\x38\x21\x6d\xfc\xe7\x68\x53\x63\xa0\xc0\x0e\xc1\x39\x9a\xeb\x81\x1e\xa1\x00\x00\xf0\x5b\x1e\x2c\xd2\x8c\x68\x34\xd7\xbd\x13\xbc\x01\xe9\xd8\x59\x8f\xce\xcf\xe6\xcf\xe4\xf1\x82\x9d\xa3\xe5\x29\x2a\x7f\x4c\x2b\xd3\xd4\xbc\xaa\x0a\xd2\x31\xe2\x69\xa1\xb8\xe1\x02\xa9\x45\x70\xca\x30\x3d\x0b\xf6\x24\x55\x5f\x59\xa3\x85\xa9\x52\xa3\xb4\x43\xe0\x1c\xf1\x9b\xde\xe7\x9f\xac\xd9\xb6\x6e\x77\x06\x35\xf9\x74\xb4\xa9\x31\xde\xee\x6e\x6b\xb9\x7b\xd7\x95\xfd\x0e\x82\x04\x4f\xe4\xe0\xd8\x63\x41\x8e\x05\xaa\xd2\xe8\x41\x93\x49\xed\xff\xfa\xe7\x01\x7d\xbb\xba\x72\x00\x40\x80\xdc\x9d\xa4\xe4\x5d\x5c\x98\xac\x55\x86\xbb\x4a\x2a\x0a\xf2\x32\x83\x14\x61\xd2\xcf\x25\xdb\xb6\xb9\xfa\x95\x4c\x27\x6e\x2f\xbc\x92\x7f\xbf\xca\x68\xc7\xa3\x41\x8f\x0e\x75\xb7\xd8\xb4\x5c\x0d\xda\x17\x31\x48\x4c\x8e\x07\x1b\x6e\xa1\xfe\x41\xca\xf1\x05\x3e\xeb\x47\x6d\x9e\xce\x12\xc8\x77\xcc\x3a\x1d\xe2\x84\x91\xa8\x1e\x00\x93\x20\x90\xad\x4b\x98\xef\xdb\x22\x84\x8d\x1d\xe4\xd6\x15\xf0\x2b\x7b\x87\x30\x8d\x7e\xe4\x30\xd3\x0e\xcf\x47\x1f\x76\xeb\xb6\xe3\x47\xd8\x8b\xa2\x26\x76\x4e\x3e\xe1\x22\x50\x67\x52\xf1\x19\xc5\x63\x77\xe2\xfe\xb4\x70\x3c\x13\x92\x6e\x8b\xd2\x95\x1e\xf2\xf6\x25\x6d\x9a\xd8\x5f\x58\x1e\x56\xe9\xfe\x7d\x36\x60\xde\x85\x31\x8a\xa9\xcb\xf3\xa4\x38\xc1\xad\xae\xdf\x37\x1a\xae\xf8\x2b\x09\xce\x64\x41\xaa\xad\x18\xa4\x38\xc7\xab\x15\x93\xe8\x9b\x07\x9e\x13\xfc\xe5\x65\x81\xbf\x96\x8a\xa4\x0e\x5d\xe4\x26\x35\xce\xb5\xec\x0e\x95\xae\x41\x3c\x47\x8b\x33\xfa\xf8\xe3\xf6\x1d\xb6\xd2\xba\x4e\x73\xb5\x60\x17\x9d\x3c\x01\x2e\x4a\xbf\x41\xa5\xbd\x54\xad\xd5\x70\x9e\xac\x67\xf1\x7d\x83\xbc\xc3\xae\x63\xf4\x61\x75\x46\x8c\x9f\x7f\x45\x16\x6e\xb5\xee\x3b\xd3\x74\x4f\x04\x0f\x07\xd1\xba\x98\xd7\xb3\xd5\x4d\xaf\x39\x9f\xf1\x83\x75\x71\xad\x9d\x27\x9d\xf1\xf5\xfb\x33\x18\x9c\x81\xde\xf3\xbd\xc5\x50\x39\xef\x2f\xe4\x47\x9f\x3f\x5f\x7d\x71\x79\xce\x35\x5f\x07\x20\x07\xa4\xfa\x9b\xd3\x0c\xeb\x4b\x52\xe5\x8a\x2e\x77\xb4\xfa\x4b\x73\x8c\xcd\xf6\x6b\x20\x41\x36\x83\xb7\x15\x27\x82\x37\x36\x64\x92\x44\xd9\x95\x7e\xca\xc2\x15\x34\x8b\x9b\xc3\x8f\x7f\xf1\x63\xde\xee\x93\x5f\x7c\x6c\x4d\x23\xf8\xe5\xd7\x51\xe2\xca\xe2\x4b\x23\x4d\x20\xfe\x3e\x00\x40\xce\x89\x0c\xb2\x1f\x00\x00"),
+		},
+		"/crd/bases/engine.azk.io_etcdbackups.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "engine.azk.io_etcdbackups.yaml",
+			modTime:          time.Date(2026, 10, 17, 2, 40, 49, 998704073, time.UTC),
+			uncompressedSize: 6508,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x59\x5f\x6f\xe3\xb8\x11\x7f\xd7\xa7\xf8\x61\xef\x61\x5b\xc0\x96\x91\x6b\x6f\x51\x18\x38\x5c\x73\xd9\xeb\x75\xdb\xee\x1f\x24\xe9\xbe\x1c\xee\x61\x24\x8e\x6d\xd6\x14\xa9\x92\x94\xb3\x49\xd1\xef\x5e\x90\x94\x64\xd9\x96\xed\x38\xdb\xdb\x97\x8d\x86\xc3\x99\x1f\xe7\x3f\xe9\x6c\x3a\x9d\x66\x54\xcb\xcf\x6c\x9d\x34\x7a\x0e\xaa\x25\x7f\xf1\xac\xc3\x97\xcb\xd7\x7f\x72\xb9\x34\xb3\xcd\x55\xc1\x9e\xae\xb2\xb5\xd4\x62\x8e\x9b\xc6\x79\x53\xdd\xb2\x33\x8d\x2d\xf9\x2d\x2f\xa4\x96\x5e\x1a\x9d\x55\xec\x49\x90\xa7\x79\x06\x94\x96\x29\x10\xef\x65\xc5\xce\x53\x55\xcf\xa1\x1b\xa5\x32\x40\x53\xc5\x73\xb0\x2f\x45\x41\xe5\xba\xa9\x5d\xce\x7a\x29\x35\xe7\xf4\xb4\xce\xa5\xc9\x5c\xcd\x65\x90\x40\x42\x44\xb1\xa4\x3e\x59\xa9\x3d\xdb\x1b\xa3\x9a\x4a\xbb\xb0\x36\xc5\xdf\xee\x3e\x7e\xf8\x44\x7e\x35\x47\xee\x3c\xf9\xc6\xe5\xa5\xd1\x69\x83\xfb\xe5\x87\xdf\xfd\x39\xf7\x8f\x35\x7f\xff\xfd\xab\x5b\x26\xf1\xf8\xea\xf7\xbf\xb6\x5c\x19\xd0\x21\x88\x2b\x19\x00\x04\xd6\x39\x9c\xb7\x52\x2f\x8f\x08\x77\x9a\x6a\xb7\x32\x3e\xaf\xb8\x2a\xd8\x0e\xc4\xbc\xdf\x12\x2e\x91\xe3\xe4\x13\x0f\xa4\xdc\x75\x9f\x49\x46\x38\xef\x92\xed\xbe\x90\xce\xc0\xf9\x81\x75\x07\xa2\xae\x97\x43\x49\x82\x7c\xf8\x5c\x5a\xd3\xd4\x73\xec\x5a\x3a\xed\x88\x06\x05\x92\x6f\x7f\xf2\xa5\xf8\x31\xba\x25\x03\x80\x5a\x35\x96\xd4\x8e\xb7\x32\xc0\x95\x26\x88\x7e\xf5\x2a\xfc\xdd\x14\xb6\x8d\x84\x56\x50\x3a\xe9\x1c\xff\xf9\x6f\x06\x6c\x48\x49\x11\xa1\xa6\x45\x53\xb3\xbe\xfe\xf4\xee\xf3\x1f\xee\xca\x15\x57\x94\x88\x80\x60\x57\x5a\x59\x47\xbe\x01\x06\x48\x07\xbf\x62\x24\x66\x2c\x8c\x8d\x9f\x03\x34\xb8\xfe\xf4\x6e\x02\xe9\xe1\x69\xcd\x0e\x04\x27\xf5\x52\x71\x06\x00\x00\xd0\xd9\x1b\x66\x11\xb7\x96\x46\x7b\x6b\x14\x6a\x45\x3a\x09\xca\xf1\x96\x15\x7b\xa9\x97\x91\x61\xa0\x7c\xcd\x5c\x27\xfd\xce\x1b\xcb\xe2\x40\x68\xde\x52\x6a\x6b\x6a\xb6\x5e\x76\x16\x00\x80\x41\x4e\xf5\xb4\xbd\x73\xbe\x0e\x86\x48\x3c\x10\x21\x8b\x38\x69\xdb\x24\x1a\x0b\xb8\x74\xee\x88\x5d\x3a\x58\xae\x2d\x3b\xd6\x3e\x1a\x74\x20\x16\x81\x85\x34\x4c\xf1\x2f\x2e\x7d\x8e\x3b\xb6\x41\x08\xdc\xca\x34\x4a\x84\x43\x6f\xd8\x7a\x58\x2e\xcd\x52\xcb\xa7\x5e\xb2\x83\x37\x51\xa5\x22\xcf\xce\xef\x48\x8c\x29\xa7\x49\x05\x17\x36\x3c\x01\x69\x81\x8a\x1e\x61\x39\xe8\x40\xa3\x07\xd2\x22\x8b\xcb\xf1\xde\x58\x86\xd4\x0b\x33\xc7\xca\xfb\xda\xcd\x67\xb3\xa5\xf4\x5d\x15\x29\x4d\x55\x35\x5a\xfa\xc7\x59\xf4\x82\x2c\x1a\x6f\xac\x9b\x09\xde\xb0\x9a\x51\x2d\xa7\x11\xa7\x8e\x29\x9c\x57\xe2\x9b\x3e\xae\x5e\x0f\x80\xed\xe5\x18\xd0\xc7\xee\x51\x33\xff\x5d\x6a\x01\x19\x83\x23\x6e\x4b\x70\xb7\xd6\xec\x5c\x7f\xfb\xd3\xdd\x3d\x3a\xa5\xd1\xe2\xbb\x26\x8e\xc6\xdd\x6e\x73\x5b\x3b\x07\xbb\x48\xbd\x60\x1b\x77\x61\x61\x4d\x95\x02\x55\x8b\xda\x48\xed\xe3\x47\xa9\x24\xeb\x5d\x1b\xbb\xa6\xa8\xa4\x0f\x8e\xfd\x77\xc3\xce\x07\x77\xe4\xb8\x21\xad\x8d\x47\xc1\x68\xea\x90\xbd\x22\xc7\x3b\x8d\x1b\xaa\x58\xdd\x90\xe3\xff\xb7\x95\x83\x41\xdd\x34\x58\xf0\xbc\x9d\x87\x05\x7e\x97\x31\x19\xa7\x27\x77\x35\x7c\xd4\x21\xdb\x14\xbb\xab\xb9\xdc\x09\x7d\xc1\x4e\xda\x10\x9e\x9e\x3c\xc3\x2c\xf6\xeb\xd1\xf1\x7c\x03\x80\x52\x35\xce\xb3\xbd\xe5\xc5\x2e\x7d\x4f\xff\x4d\xcf\xd6\xd5\x97\x96\x02\xa9\xe3\xa7\xa3\x8a\x53\x69\xac\xa9\x64\x3c\xac\x8c\xeb\x2b\xc7\x9e\x60\x0c\x2a\x09\xa4\x43\x28\x4b\x2c\xb0\x03\xf7\x14\xe4\xbe\x6c\x1f\x50\xf7\xa3\xf8\x43\x00\xd5\x56\x31\xcb\x0b\xb6\xac\xfd\x68\x2c\xac\x9b\x82\xad\x66\xcf\x31\x1c\x84\x29\x5d\x88\x84\x92\x6b\xef\x66\x66\xc3\x76\x23\xf9\x61\xf6\x60\xec\x5a\xea\xe5\xf4\x41\xfa\xd5\x34\x39\xcf\xcd\xe2\x91\x67\xdf\xc4\xff\x46\xf0\x00\xf7\x1f\xdf\x7e\x9c\xe3\x5a\x08\x18\xbf\x62\x8b\xc6\xf1\xa2\x51\x58\x48\x56\xc2\xe5\x83\x9a\x37\x89\x29\x39\x41\x23\xc5\x0f\xaf\x47\x44\x8d\x46\xd7\x89\x78\x02\x10\x8b\x30\x2d\xf9\xa4\x6b\x07\xa1\x95\xb8\x21\x1d\x1e\x56\x6c\xb9\x2f\xda\x0e\x64\xbb\x8a\x3e\x01\x7f\xa1\xd2\xab\xc7\x03\x88\x46\x73\xf2\x65\x2a\x1d\x8e\xfd\x05\x0e\x2d\x94\x29\xce\x3a\xf4\x47\x65\x8a\x5d\xa8\x11\x93\x1b\x00\x95\x1a\x14\xe3\x8e\xa4\x66\x0b\xb3\x18\x91\x09\x90\x06\x7f\x91\x2e\x96\x31\x7a\x6a\xda\xc3\x05\x81\x54\x96\xa6\xd1\x7e\x64\xd7\x29\xf4\x00\xb6\x4a\xc7\x97\xf7\x13\xaa\xe3\x86\x74\x69\xf0\x63\x81\xda\xca\x0d\xf9\x90\x3d\xac\x51\x49\xe7\x0e\x5d\xfd\x8c\x68\x00\x00\xf4\x45\xf9\xe7\x38\xc5\x3c\x07\xd2\xed\x70\x47\x97\x36\x7b\x76\x99\x84\xd2\x43\x8d\xf2\xee\x88\x44\x74\xed\xb1\x2d\x2c\x3d\x8e\x34\x4e\xbd\xf4\x34\x2d\x8c\xeb\x84\x62\xfe\x32\x31\xa1\x65\x84\x52\x39\xb6\x7d\xba\xa7\x62\x94\xa5\x77\xf1\xd1\xf4\x1c\xc9\x41\x00\x50\xa6\x24\x75\x36\xbc\xff\x11\xb8\x9e\x13\xdf\x42\x5a\x2e\xbd\xb1\x8f\x23\x12\xb1\x37\xb6\x29\xb6\xa8\x48\xd3\x92\xed\x04\x15\x93\xf6\x69\x26\xe4\x18\xfd\x2f\x88\xf3\x3a\xcc\xd5\xbf\x85\x03\x82\xe0\xcb\x0c\x7b\x64\x69\x4c\x4d\xef\xdf\xb3\x6d\x38\x8d\xe2\xcf\x68\xc4\x91\x71\xa7\x15\x9b\xc2\x85\xe9\xe6\xc5\xbd\xb8\xbf\x8d\xed\xd2\x01\xe9\xb9\x3a\x20\x1e\x56\x94\xb4\xbb\x25\x17\xdc\x0d\xe2\x2d\x96\x7e\xd8\x05\x79\x10\x4a\xb6\x21\x98\xc7\x22\x20\xcc\x5f\x13\x54\x46\xb0\x52\x2c\x40\x0b\xcf\xe9\x16\xd1\xd4\xce\x5b\xa6\x2a\x4e\x35\x9b\xab\xbc\xd7\x99\x5d\x16\x45\x8a\x9c\xbf\xb7\xa4\x9d\xec\x6e\x64\x63\x5c\xfb\xe9\x71\xb0\xa9\x9b\x45\x82\x38\xf8\x40\x68\x03\xff\x08\x28\x00\x00\x7c\x2f\x83\x45\x9a\x3b\x8d\xe6\xd6\xef\xf0\x06\xa4\x63\xa7\x1e\xdd\xbd\x30\xb6\x22\x9f\xae\x88\xd3\xa0\x31\x7b\x41\x1a\x54\xec\xdc\x48\x57\x1e\x39\xf2\xfb\xc4\x99\x26\xf1\x55\x53\x91\x86\x65\x12\x54\x28\xee\xa4\x40\x6a\x21\x4b\x0a\xc9\x0c\xc1\x9e\xa4\x3a\x56\x9a\xa9\x30\x4d\x1a\xab\xb7\x16\x78\x09\xfc\x2e\xca\x7f\x66\xcd\x76\x70\x4f\x3d\x79\x92\x8f\x07\x9b\x3a\xe7\x6d\x6f\xe8\xcb\xed\x9a\x5f\xf1\x91\x53\xf4\xde\xc5\x03\xc5\x21\x03\x05\xb9\x38\x3e\x1a\x7d\xd2\x65\x52\xfb\x37\x7f\x3c\x71\xde\xed\xfb\xc1\xfe\x3f\xcb\xe4\x9e\x75\xc8\xdb\xc8\x98\xbc\x55\x5b\xb3\xb4\x54\x55\xe4\x65\x09\x29\xc2\xed\x61\x21\xd9\x0e\xdd\x75\xfc\x90\x49\x63\x7f\x79\x4f\xf1\xfd\x55\x4e\x3b\x2c\x6b\x47\xce\xd0\x96\xb5\x6d\x13\x49\xd6\x9e\xc4\x24\x31\x0b\xdc\xdb\x70\xb3\xfd\x0b\x29\xc7\x13\xfc\x53\xaf\xb5\x79\x78\x11\xa0\xb8\x7c\x1e\xce\xfd\x63\x1d\xd5\xf6\x40\x20\x07\x17\xbb\xcb\x15\x1f\x6f\x41\xd3\xb8\x31\x1b\x1b\x0c\xfa\x57\xb0\x8b\xfa\x12\x59\x4b\x8f\xd9\x65\x99\x73\x2a\x58\x8f\x87\x69\x37\x1e\x9c\x9c\xf3\xef\x5a\xa6\x76\x36\x47\xb8\xdf\xa4\x06\xd1\x2d\xc4\x84\xda\x7d\xb6\x39\x5f\xcc\xc3\x68\x73\xac\x06\x1c\x4c\x37\xc3\xbc\x0f\x13\x3f\x1a\xab\x60\x2c\x16\x52\x71\xec\xff\xfd\xd8\xd9\x62\xca\x2e\x74\x6f\x7a\x6a\x3c\x8b\x25\x3d\x40\x76\x48\x2a\x72\x5d\x83\xdb\xb1\x45\x78\x1f\xd3\xb1\x43\x5c\x0a\xe3\x59\xd7\xd3\xe1\xed\xb4\xd7\xdb\x5d\xa7\x0f\x86\x95\x67\xe9\x75\x2b\xfa\xf6\xbb\x37\x67\x35\xdf\xfd\xf5\xfa\xdb\xef\xde\x74\xc7\x5f\xf1\x17\x12\x5c\xca\x8a\x14\xca\x15\x97\x6b\xd7\x54\x5f\xeb\x87\xf0\x54\x7b\x1e\x86\x7c\x1a\x35\x40\xf1\xe8\x47\x2f\xd3\xe7\x2a\xf9\xe9\x3a\x1e\xbd\x79\xed\xe7\x27\x04\x9f\xea\xea\x27\x8e\x7b\xac\xa6\x4c\x63\x1c\x1c\x10\xbb\x8c\x39\x58\xe8\xdf\xb7\x87\xc4\x16\xf6\xf3\x26\xdf\x11\xf2\x1e\x69\xd3\xfd\x68\xb1\xb9\x22\x55\xaf\xe8\x6a\x4b\x6b\x7f\x25\x88\xb1\x3b\x5c\x06\x52\xd5\x9a\xc3\xdb\x26\x01\xec\xde\x15\x12\x65\xdb\x5b\xa8\x0c\x6f\x26\x2c\x3e\xec\xbf\x91\xc7\x77\xef\xed\xdb\x78\xfc\x1c\x8c\xbb\xf8\xe5\xd7\x0c\x6d\xed\xf9\xdc\xa1\x09\xc4\xff\x0d\x00\xea\x48\x4b\xf4\x6c\x19\x00\x00"),
+		},
+		"/crd/bases/engine.azk.io_etcdbackupschedules.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "engine.azk.io_etcdbackupschedules.yaml",
+			modTime:          time.Date(2026, 10, 17, 2, 40, 50, 1927549, time.UTC),
+			uncompressedSize: 7792,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x59\xdd\x73\x1b\xb7\x11\x7f\xe7\x5f\xf1\x1b\xe5\xc1\x6d\x86\x1f\x95\xd3\xb8\x1d\xce\x64\x62\x45\x49\x53\xb7\x8e\xed\x91\x14\xbf\x64\xf2\xb0\x3c\x2c\x49\x94\x38\xe0\x0a\xe0\x28\x51\x9d\xfe\xef\x1d\x00\x77\xc7\x23\xef\xf8\x21\xc9\x1d\x3f\x58\x5c\xec\x2e\x16\xfb\xf1\xdb\x05\x6e\x30\x1a\x8d\x06\x54\xc8\xcf\x6c\x9d\x34\x7a\x0a\x2a\x24\x3f\x78\xd6\xe1\x97\x1b\xaf\xfe\xea\xc6\xd2\x4c\xd6\x97\x33\xf6\x74\x39\x58\x49\x2d\xa6\xb8\x2e\x9d\x37\xf9\x0d\x3b\x53\xda\x8c\x7f\xe4\xb9\xd4\xd2\x4b\xa3\x07\x39\x7b\x12\xe4\x69\x3a\x00\x32\xcb\x14\x88\x77\x32\x67\xe7\x29\x2f\xa6\xd0\xa5\x52\x03\x40\x53\xce\x53\xb0\xcf\xc4\x8c\xb2\x55\x59\xb8\x6c\xc9\xa2\x54\xec\xc6\xac\x17\x52\xf3\x98\x1e\x57\x63\x69\x06\xae\xe0\x2c\x68\x22\x21\xa2\x7a\x52\x9f\xac\xd4\x9e\xed\xb5\x51\x65\xae\x5d\x58\x1b\xe1\x1f\xb7\x1f\x3f\x7c\x22\xbf\x9c\x62\x1c\x04\xc6\xb5\xb6\x01\x50\x6f\x75\xdb\x26\xf9\x4d\xc1\x53\x38\x6f\xa5\x5e\xf4\x2b\x28\x5d\xc1\x5a\xb4\xe5\x5b\x94\x24\x3e\x33\x46\x31\xe9\x8e\xbc\x27\x5f\xba\xb1\x22\xe7\x6f\xcb\x2c\x63\xe7\xe6\xa5\x0a\x0e\x68\x29\x7b\x4f\xce\xa3\x5a\x6d\x69\x14\xe4\xf9\x80\xba\xcc\xe8\xe4\x00\xf7\xdb\xf7\x7f\x78\x3b\x0e\xfc\xdf\x7d\x77\x71\xc3\x24\x36\x17\x7f\xfc\xbd\xe2\x6a\xed\x10\x57\x4e\x9e\xb5\x8e\xd5\xb8\x13\xa8\x96\xaa\xab\x05\x77\x6d\x5c\x58\x53\x16\x53\xec\x06\x2b\x49\xc4\x98\x00\x29\x4d\x7e\xf2\x99\xf8\x21\x46\x78\x27\x00\x85\x2a\x2d\xa9\xde\x04\x18\x00\x2e\x33\x61\xab\x8b\x8b\xf0\x77\x39\xb3\x55\x92\x55\x8a\xd3\x59\xa7\xf8\xcf\x7f\x07\xc0\x9a\x94\x14\xd1\xf4\xb4\x68\x0a\xd6\x57\x9f\xde\x7d\xfe\x26\x6c\x97\x53\x22\x02\x82\x5d\x66\x65\x11\xf9\x7a\x6c\x82\x74\xf0\x4b\x46\x12\xc2\xdc\xd8\xf8\xb3\xc7\x3a\x5c\x7d\x7a\x37\x1c\x00\x00\x00\x48\x9f\x52\x9c\x5d\x4b\xa9\x83\xd1\x20\x64\xd6\x68\xd4\x72\x20\x2d\x20\x58\x71\x60\x0d\xaa\x9d\xa6\xc2\x2d\x8d\x77\xe0\x87\x8c\x59\xa4\xe0\x00\x00\x60\xd9\xb3\x8e\xb5\x04\x00\x40\x61\x4d\xc1\xd6\xcb\xda\x01\x00\xd0\xaa\xd6\x86\xb6\x77\xcc\x57\xc1\x0f\x89\x07\x22\xd4\x67\xb5\xf5\x3a\xd1\x58\x44\xeb\x72\x82\x99\xc3\x2f\xa5\x83\xe5\xc2\xb2\x63\xed\xa9\xb5\x39\x00\x20\xb0\x90\x86\x99\xfd\x8b\x33\x3f\xc6\x2d\xdb\xa0\x04\x6e\x69\x4a\x25\x90\x19\xbd\x66\xeb\x61\x39\x33\x0b\x2d\x1f\x1b\xcd\x0e\xde\xc4\x2d\x55\x70\x91\xdf\xd1\x18\x8b\x58\x93\x0a\x11\x2c\x79\x18\x1d\x94\xd3\x06\x96\xc3\x1e\x28\x75\x4b\x5b\x64\x71\x63\xfc\x62\x2c\x43\xea\xb9\x99\x62\xe9\x7d\xe1\xa6\x93\xc9\x42\xfa\x1a\x9f\x32\x93\xe7\xa5\x96\x7e\x33\xc9\x8c\xf6\x56\xce\x4a\x6f\xac\x9b\x08\x5e\xb3\x9a\x50\x21\x47\xd1\xce\xe8\x58\x37\xce\xc5\x57\x4d\x5a\xbd\x6a\x19\xb6\x57\x2d\x40\x93\xca\x07\xdd\xfc\x4f\xa9\x05\xa4\x03\x55\x62\xc9\xdc\xad\x37\x03\x29\x38\xe1\xe6\xa7\xdb\x3b\xd4\x9b\x46\x8f\xef\xba\x38\x3a\x77\x2b\xe6\xb6\x7e\x0e\x7e\x91\x7a\xce\x36\x4a\x61\x6e\x4d\x9e\xf2\x53\x8b\xc2\x48\xed\xe3\x8f\x4c\x49\xd6\xbb\x3e\x76\xe5\x2c\x97\x3e\x04\xf6\xdf\x25\x3b\x1f\xc2\x31\xc6\x35\x69\x6d\x3c\x66\x8c\xb2\x08\xc5\x2c\xc6\x78\xa7\x71\x4d\x39\xab\x6b\x72\xfc\xa5\xbd\x1c\x1c\xea\x46\xc1\x83\xa7\xfd\xdc\x6e\x1d\xbb\x8c\xc9\x39\x0d\xb9\xee\x0a\xbd\x01\xe9\x96\xf7\x6d\xc1\xd9\x4e\x09\x08\x76\xd2\x86\x34\xf5\xe4\x19\x66\x7e\x08\xa6\x0e\xd7\x1f\x00\x64\xaa\x74\x9e\xed\x0d\xcf\x77\xe9\x7b\xf6\x5c\x37\x6c\x35\xcc\x54\x14\x48\x1d\x7f\x3a\xca\x39\x21\x67\x41\x19\xe3\x7e\x69\x1c\x23\x7a\xd7\xa8\x3d\xc5\x40\xa1\x48\x27\x64\x82\x74\x08\xe8\xc4\x02\x65\xb1\xc7\x77\xc8\xe4\x06\xd5\x3b\xd4\xfd\xac\xfe\x10\x8c\x8a\xc8\xc0\xb0\x3c\x67\xcb\xda\xf7\xe6\xc6\xaa\x9c\xb1\xd5\x01\xd8\x42\x7a\x08\x93\xb9\x90\x19\x19\x17\xde\x4d\xcc\x9a\xed\x5a\xf2\xfd\xe4\xde\xd8\x95\xd4\x8b\xd1\xbd\xf4\xcb\x51\x0a\xa6\x9b\xc4\x23\x4f\xbe\x8a\xff\xf5\xd8\x03\xdc\x7d\xfc\xf1\xe3\x14\x57\x42\xc0\xf8\x25\x5b\x94\x8e\xe7\xa5\xc2\x5c\xb2\x12\x6e\xdc\xc2\xc0\x61\x2c\xd1\x21\x4a\x29\xbe\x7f\xd5\xa3\xaa\x37\xdb\x8e\xe4\xd7\x0e\x0a\x1f\x0d\xee\x4d\xcd\x55\xc7\x56\x97\xf9\x8c\x6d\xf0\x9c\x6b\xba\x7f\x0b\xed\x57\x5c\xf8\x21\x8c\x12\x6c\x3b\x76\x6e\xb9\xc8\x72\xd5\x2d\xc4\x30\x24\x2e\x95\x2a\x56\x2f\xfe\xb2\x27\x34\x37\x36\x27\x3f\x85\xd4\xfe\x9b\xd7\x7b\x6b\xb9\xd4\x32\x2f\xf3\x29\x2e\x7b\x8f\x1c\x00\x78\xb1\x67\x45\xdd\xae\x8e\x1e\xb9\xdd\x34\xab\x36\xc7\x0f\x01\xb4\x42\x24\xa0\xe4\x8a\x71\xf1\x27\x7c\x3d\x79\x83\xaf\xc3\xbf\x0b\x18\x8b\xb7\x4b\x53\x5a\xb5\x19\x76\xce\xfc\x56\x90\x54\x9b\x21\xde\xde\x33\xaf\xc2\x1f\x1c\xd0\x33\xc0\x12\xa4\xc6\xaf\x77\xd7\x83\x33\x23\xe9\xbc\xb1\xb4\x38\x6e\x79\xab\xca\x13\x37\xa4\xc3\xfd\x92\x2d\xef\xf9\x3e\x28\x0b\xae\xe7\x07\xca\xbc\xda\x74\xac\x36\x9a\x53\xe9\x25\xe4\x77\xec\x9f\x50\x7f\x33\x65\x66\x27\xeb\xef\x07\x65\x66\xbb\xa6\x46\x9b\x5c\xcb\x50\x19\xa7\x0c\xa3\x3d\x49\x1d\x33\xae\x47\x27\x40\x21\x3a\xd2\xc5\x2e\x44\x8f\x65\x75\xb8\xa0\x90\xb2\xcc\x94\xda\xf7\x48\x1d\xb3\x1e\xc0\x76\xd3\xfe\xe5\x7d\xfc\xab\xb9\x21\x5d\x35\x2e\x09\x14\x56\xae\xc9\x07\xb0\x63\x8d\x5c\x3a\xd7\xad\x4c\x9c\x2e\x5e\x00\x40\xd3\x53\x7f\x8e\x33\xe9\x39\x26\xdd\xb4\x25\x6a\x94\xdb\xf3\xcb\xb6\xf0\x0e\x68\x44\x3d\xdd\x54\x7d\xa0\xb1\x23\x0d\xc7\xcf\x3d\x4d\x65\xc6\x55\xb2\x62\xfa\x3c\x35\xa1\xe3\x87\x0e\xd7\x27\x3e\xda\xdb\xa2\x97\xa5\x09\xf1\x41\x34\xed\x81\x4c\x00\x50\x26\x23\x75\x32\xbd\xdf\x07\xae\x73\xf2\x5b\x48\xcb\x99\x37\x76\xd3\xa3\x11\x75\xe4\xaa\x5e\xa9\xd8\x22\x27\x4d\x0b\xb6\x43\xe4\x4c\xda\xa7\x49\x9e\x63\xf6\x3f\x23\xcf\x8b\x70\x4b\xfa\x7f\x04\x20\x28\x7e\x9a\x63\x0f\x2e\x55\x37\xd5\xe3\x88\x9d\x78\x82\x8b\x8b\xaa\x04\x03\x1c\x68\xbe\x47\x75\xbd\x19\x6e\x41\xa2\xa2\x80\x2c\x77\x4c\x0c\xdd\xab\xd7\xb0\xed\x5d\xf8\x98\x03\x46\x70\xdd\xe9\xaa\x49\xc7\x93\x43\x5f\xba\xf7\x3d\x61\xec\x8b\x02\x3b\x83\x9f\x99\xb9\x30\x53\xbf\x78\xf2\x6b\x6e\xe3\xbb\x74\x40\x7a\xce\x3b\xc4\x2e\x20\x26\xe9\x8a\x3c\xab\xef\x84\xb5\x4d\xcd\x55\x0b\xe4\x41\xc8\xd8\x86\x5a\xec\x4b\xe0\x30\xfd\x0f\x91\x1b\xc1\x4a\xb1\x00\xcd\x3d\xa7\xab\x6b\x59\x38\x6f\x99\xf2\x38\x53\xaf\x2f\xc7\xcd\x9e\x83\xa7\x15\x41\x78\xc6\xb8\xb3\xa4\x9d\xac\x9f\x07\xfa\xb8\xf6\xab\xbb\x23\x54\x4f\x47\x41\x1d\x7c\x20\x54\x75\x7b\xc0\x28\x00\x00\x7c\xa3\x83\x45\xba\xf5\x18\xcd\x55\x1e\xc0\x1b\x90\x8e\x73\x61\xaf\x74\x3d\x19\x85\x2b\xce\xc8\xd7\xcf\x2f\x4f\xac\xe2\x9c\x9d\xeb\x19\x2a\x7a\x8e\xfc\x4b\xe2\x4c\x33\xd1\xb2\xcc\x49\xc3\x32\x09\x9a\x29\xae\xb5\x40\x6a\x21\xb3\x54\x7a\x82\x3d\x49\x75\xa8\xb3\xd0\xcc\x94\xe9\x52\xb7\xf5\xc0\x73\xcc\xaf\xb3\xfd\x67\xd6\x6c\xa9\x6f\x96\xed\x39\xc9\xc7\x8e\x50\x1d\xbc\xed\x73\xd1\x62\xbb\xe6\x97\x7c\xe0\x14\x4d\x74\x71\x4f\x71\x46\xc2\x8c\x5c\xbc\xac\x18\x7d\x34\x64\x52\xfb\x37\x7f\x3e\x72\xde\xbe\xc9\x15\x00\x10\x5c\xee\xce\x3a\xe4\x4d\x64\x4c\xd1\x2a\xac\x59\x58\xca\x73\xf2\x32\x83\x14\x61\x9c\x9f\x4b\xb6\xed\x70\x1d\x3e\x64\xda\xb1\x79\x31\x4a\xf9\xfd\xa2\xa0\x75\x61\xee\x10\xaa\x47\xc6\x56\x0f\x4c\xde\x1e\xc6\x22\x31\x73\xdc\xd9\xf0\xae\xf2\x37\x52\x8e\x87\xf8\x55\xaf\xb4\xb9\x7f\x96\x41\x71\xf9\xb4\x39\x77\x9b\x22\x6e\xdb\x18\x02\xd9\x7a\x56\x78\xfa\xc6\x87\x3b\xe8\x28\x0a\x0e\xfa\xe6\x9a\xe6\x15\xf4\x49\x6d\x95\xac\xa5\xdd\x01\x23\xbe\xde\x56\xfd\xa0\x0f\xf4\x3a\x70\xd7\x66\xee\x82\x1d\x55\x5d\x35\x56\x42\x35\x02\x0f\x9e\x86\x57\x07\x7d\xd5\x7d\x68\x3e\x6d\xec\x0e\x7b\x6d\x6e\x03\xcb\xe9\xb5\x0e\x96\xe3\x04\x28\x9a\x91\x6c\x4f\x2d\xe2\x69\x3c\xad\x58\x7f\xa9\xb3\x9c\x06\xac\x63\x18\x71\xe4\x5e\x5b\x0f\x95\xc7\xc7\xa4\x9d\x3b\x60\x7a\xf8\xd8\x73\x81\x1b\x86\xa9\x89\x9d\xc7\x5c\x5a\xe7\x9f\xd1\xfc\xc3\xbc\x51\x6f\xd4\xea\xff\x54\xdd\x39\xd3\xcb\xce\x41\x8f\x9f\x68\xd6\x26\x3b\x17\xe5\xdf\x57\xac\x75\xf0\xc3\xa5\x14\xa5\x55\x30\x16\x73\xa9\x38\x8e\xa8\xcd\xcd\xe8\x90\x39\x67\xb5\xd0\xf0\x1e\x72\x56\x07\x0d\x8c\xb5\x3d\x39\xb9\x7a\x94\xa9\x77\xdf\xa6\x5b\x9c\x05\x9e\x63\xcc\xa1\xa7\xaf\x3d\x53\xda\x6f\x5f\xcd\xee\xf5\x63\x5d\x67\x58\x3d\x1f\xd9\x97\xf4\xfa\xdb\x37\xe7\x20\xfb\xdf\xaf\x5e\x7f\xfb\xa6\x76\xc5\x92\x1f\x48\x70\x26\x73\x52\xc8\x96\x9c\xad\x5c\x99\x7f\x89\xc8\x38\xf9\x78\x8e\x33\x6e\xe5\x63\xaf\x33\x66\x1b\x7f\xe0\xd9\xee\xe5\x7d\x3c\x46\xf9\xca\x4f\x8f\xaa\x7f\xc1\x64\x77\xac\xb7\x84\x1c\xe9\x21\xd7\xb5\xd5\xb3\x14\x1c\xd9\x43\xae\x0e\xf1\xf2\x76\xd4\x23\xb0\x47\x5a\xd7\x1f\x6e\xd7\x97\xa4\x8a\x25\x5d\x6e\x69\xd5\x17\xd2\x98\xf9\xed\x65\x20\x41\xed\x14\xde\x96\xc9\xfc\xfa\x09\x2d\x51\xb6\x73\x08\x65\xe1\x35\x97\xc5\x87\xfd\x8f\x7b\xf1\x03\xdd\xf6\x63\x5e\xfc\xd9\xba\x1a\xe1\xb7\xdf\x07\xa8\x70\xed\x73\x6d\x4d\x20\xfe\x6f\x00\x30\x0d\x4a\xf4\x70\x1e\x00\x00"),
 		},
 		"/crd/bases/engine.azk.io_nodepools.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "engine.azk.io_nodepools.yaml",
-			modTime:          time.Date(2019, 9, 28, 14, 35, 16, 330454471, time.UTC),
-			uncompressedSize: 2533,
+			modTime:          time.Date(2026, 10, 17, 2, 2, 13, 547571659, time.UTC),
+			uncompressedSize: 5049,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\xc1\x6e\x23\x37\x0c\xbd\xcf\x57\x10\xdb\xc3\x5e\xea\x31\xd2\xbd\x14\x73\x5b\x78\x7b\x30\x16\x0d\x82\x38\xcd\xa5\xe8\x81\x96\x68\x5b\xcd\x0c\xa9\x4a\xd4\xa0\xd9\xa2\xff\x5e\x48\xe3\xb1\x3d\x8e\xdd\x0d\x82\xcc\xc9\x7a\xa2\x1e\xa9\x47\x52\x66\x35\x9b\xcd\x2a\xf4\xee\x91\x42\x74\xc2\x0d\xa0\x77\xf4\xb7\x12\xe7\x55\xac\x9f\x7e\x8e\xb5\x93\x79\x7f\xb3\x26\xc5\x9b\xea\xc9\xb1\x6d\x60\x91\xa2\x4a\x77\x4f\x51\x52\x30\xf4\x85\x36\x8e\x9d\x3a\xe1\xaa\x23\x45\x8b\x8a\x4d\x05\x60\x02\x61\x06\x1f\x5c\x47\x51\xb1\xf3\x0d\x70\x6a\xdb\x0a\x80\xb1\xa3\x06\x58\x2c\x79\x91\x36\xd6\xc4\x5b\xc7\x54\xe3\xb7\xa7\xda\x49\x15\x3d\x99\x7c\x7e\x1b\x24\xf9\x06\xa6\x9b\xc3\xe1\x98\xf7\x01\x86\x60\x6e\xc5\xd2\x9d\x48\x5b\x20\xdf\xa6\x80\xed\x09\x79\x05\x10\x8d\x78\x6a\xe0\xc3\x87\xfc\x3b\xad\xc3\x3e\xec\x3d\x49\x34\xd8\xd2\xf0\x13\x20\xfb\xbe\x27\xdf\x3a\x83\xf1\x0e\x75\xd7\x40\x9d\xa1\x3a\xec\xb1\xd1\x4c\x51\x53\x3c\x37\x2c\xe0\xd4\x74\xc0\x1a\xf8\xe7\xdf\x0a\xa0\xc7\xd6\xd9\x22\xc9\xe0\x4e\x3c\xf1\xe7\xbb\xe5\xe3\xa7\x95\xd9\x51\x87\x63\x0c\x96\xa2\x09\xce\x17\xbb\xc3\xe5\xc0\x45\xd0\x1d\xc1\x60\x0a\x1b\x09\x65\x79\xb8\x26\x7c\xbe\x5b\xee\xcf\xfb\x20\x9e\x82\xba\xf1\x82\xf9\x3b\xc9\xef\x01\x3b\xf3\xf4\x31\x87\x32\xd8\x80\xcd\x19\xa5\xc1\x63\x3f\x60\x64\x21\x0e\xbe\x65\x03\xba\x73\x11\x02\xf9\x40\x91\x58\xcb\x95\x4e\x68\x21\x9b\x20\x83\xac\xff\x24\xa3\x35\xac\x28\x64\x12\x88\x3b\x49\xad\x05\x23\xdc\x53\x50\x08\x64\x64\xcb\xee\xdb\x81\x39\x82\x4a\x71\xd9\xa2\x52\xd4\x09\xa3\x63\xa5\xc0\xd8\x66\x11\x13\xfd\x08\xc8\x16\x3a\x7c\x86\x40\xd9\x07\x24\x3e\x61\x2b\x26\xb1\x86\x5f\x25\x10\x38\xde\x48\x03\x3b\x55\x1f\x9b\xf9\x7c\xeb\x74\xac\x68\x23\x5d\x97\xd8\xe9\xf3\xdc\x08\x6b\x70\xeb\xa4\x12\xe2\xdc\x52\x4f\xed\x1c\xbd\x9b\x95\x38\x59\x4b\x17\x74\xf6\x87\x43\xd9\x7c\x3c\x09\x4c\x9f\x73\x65\x45\x0d\x8e\xb7\x07\xb8\x94\xe5\x55\x99\xbf\x3a\xb6\x39\x9b\xb8\x3f\x36\x84\x7b\x54\x33\x43\x59\x84\xfb\x5f\x56\x0f\x30\x3a\x2d\x8a\x4f\x25\x2e\xe2\x1e\x8f\xc5\xa3\xce\x59\x17\xc7\x1b\x0a\x43\x9e\x36\x41\xba\xc2\x48\x6c\xbd\x38\xd6\xb2\x30\xad\x23\x9e\x6a\x1c\xd3\xba\x73\x9a\x13\xfb\x57\xa2\xa8\x39\x1d\x35\x2c\x90\x59\x14\xd6\x04\xc9\x5b\x54\xb2\x35\x2c\x19\x16\xd8\x51\xbb\xc0\x48\xef\xad\x72\x16\x34\xce\xb2\x82\xdf\xd7\xf9\xf4\xb1\x99\x1a\x0e\xe2\x1c\xe0\xf1\x45\xb9\x98\x90\xb1\xc3\x56\x9e\xcc\xa4\xf0\x2d\x45\x17\x72\x71\x2a\x2a\xe5\x92\x9e\x3c\x34\xd7\x7b\xad\x94\x40\x5a\x53\x60\x52\x8a\x17\xda\xee\xea\x85\xf2\x37\x3e\x1f\xe7\x07\x36\x12\x3a\xd4\x26\x37\xc2\xa7\x9f\x2e\x92\xe5\x16\xd9\x52\x98\xec\xf5\xdd\xea\xeb\x6f\x0f\x79\xfb\x75\xfe\x2f\xcb\x37\x3c\x62\xdf\x15\xb0\x98\x4d\x24\x94\x75\xcc\x35\xf9\x76\x0d\x17\xc2\x1b\xb7\x7d\xb5\x78\x6f\x97\x3d\x3f\xa4\xab\x17\xf7\xcc\x9f\x53\xea\x5e\x80\xd7\xa3\x1e\x75\x5f\x48\xe7\x93\x52\xb8\xcd\xff\x75\x17\x2c\xfe\x27\x96\x91\x62\xc9\x51\x91\x0d\x2d\xbf\xbc\x81\xe0\x62\x26\x4f\xb7\x30\x04\x7c\x7e\xa1\x41\x24\xbd\x14\xf1\x55\x57\x3e\x48\xef\xb2\xd2\x8e\xb7\x59\xbf\xd7\x9f\x7c\xef\x42\x7f\x4f\xbe\x0b\xe2\x9d\x41\xfd\x38\x2d\xf5\x37\xd8\xfa\x1d\xde\x1c\xb1\x12\xc1\x6c\x3f\xe4\x9c\x6c\x03\x0c\xcd\xd0\x80\x86\x44\xfb\xf1\x40\x02\x6e\x69\x8f\x1c\xfb\x0c\x8d\x21\xaf\x64\x6f\xcf\x67\x9d\x32\xc3\x1c\xa7\x9c\xb2\x34\xc2\xb6\x4c\x5f\xb1\x81\xdf\xff\xa8\x06\x56\xb2\x8f\x63\x34\x19\xfc\x2f\x00\x00\xff\xff\x24\xb4\x0f\x71\xe5\x09\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x58\x5f\x6f\xdb\x46\x12\x7f\xd7\xa7\x18\x38\x0f\xb9\x03\x2c\x0a\xbe\x1c\x0e\x07\x02\x41\x6a\x28\x6d\xe1\xa6\xb1\x0d\xcb\xc9\x4b\x90\x87\x11\x77\x28\x6d\x4c\xee\x6e\x77\x86\x72\x9d\xa2\xdf\xbd\xd8\x5d\x91\xa2\x24\x4a\x76\x9d\x00\xe5\x8b\xbd\xc3\xd9\xdf\xfc\xff\x23\x8e\xc6\xe3\xf1\x08\x9d\xfe\x48\x9e\xb5\x35\x39\xa0\xd3\xf4\xbb\x90\x09\x27\xce\xee\xfe\xcf\x99\xb6\x93\xd5\xd9\x9c\x04\xcf\x46\x77\xda\xa8\x1c\xa6\x0d\x8b\xad\x6f\x88\x6d\xe3\x0b\x7a\x4b\xa5\x36\x5a\xb4\x35\xa3\x9a\x04\x15\x0a\xe6\x23\x80\xc2\x13\x06\xe2\xad\xae\x89\x05\x6b\x97\x83\x69\xaa\x6a\x04\x60\xb0\xa6\x1c\x8c\x55\xe4\xac\xad\x38\x23\xb3\xd0\x86\x32\xfc\x7a\x97\x69\x3b\x62\x47\x45\xb8\x8f\x4a\x45\x50\xac\xae\xbd\x36\x42\x7e\x6a\xab\xa6\x36\x1c\xde\x8d\xe1\x97\xd9\xd5\xe5\x35\xca\x32\x87\x8c\x05\xa5\xe1\xcc\x93\xab\x74\x81\x3c\x02\x68\x45\xdc\xf4\x49\xf2\xe0\x28\x87\x80\xb4\x20\x7f\x00\xe3\xae\x99\x93\x37\x24\xc4\x6b\x77\xf4\xc0\xfa\x94\x84\xc5\xe2\xb5\x59\x1c\x80\x72\xde\xae\x74\xb8\xa0\xcd\x62\x26\x28\xd4\x83\xda\x9c\x9f\x00\x54\x58\x93\x1c\xc1\x9f\xde\xfc\xeb\x87\x2c\xdc\x78\xfd\xfa\xe4\x86\x50\x3d\x9c\xfc\xfb\xf3\x9a\x6b\xcb\x68\x54\x0f\xdf\x06\xfe\xc1\x2d\x3c\x2a\xba\x30\xd7\xde\x2e\x3c\x31\x0f\x0a\x4a\x5c\x09\xfb\xb8\xb0\x36\x2d\xb2\xbd\x9c\xe8\xc1\x9d\x2f\xfa\x2e\x51\xc9\x43\x0b\x6f\x1b\x97\xc3\x76\x86\xa4\x1b\x31\x11\x00\x52\x46\x5e\x5a\x45\xd7\xd6\x56\x91\xe4\xaa\xc6\x63\xd5\xcb\xb0\x11\x00\x17\x36\xc0\x9e\x9c\x84\xff\x9b\xb9\x5f\xe7\xee\x1a\x84\x0b\xac\x28\xfd\x0b\x10\x12\xb0\xcd\x9c\xd6\x5b\x8e\x8a\xed\x04\x03\x48\xfe\xd8\x65\x1c\xc8\xc5\x44\xcb\xe1\x8f\x3f\x47\x00\x2b\xac\xb4\x8a\x3e\x48\xe2\xac\x23\x73\x7e\x7d\xf1\xf1\xd5\xac\x58\x52\x8d\xad\x0e\x8a\xb8\xf0\xda\x45\xbe\xce\x38\xd0\x0c\xb2\x24\x48\xac\x50\x5a\x1f\x8f\x9d\x99\x70\x7e\x7d\xb1\xbe\xef\xbc\x75\xe4\x45\xb7\x06\x86\xa7\x57\xe4\x1d\x6d\x47\xd2\xcb\xa0\x4a\xe2\x01\x15\xca\x9a\x92\xc4\x55\xa2\x91\x02\x4e\xb2\x6d\x09\xb2\xd4\x0c\x9e\x9c\x27\x26\x23\xd1\xa4\x1e\x2c\x04\x16\x34\x60\xe7\x5f\xa8\x90\x0c\x66\xe4\x03\x08\xf0\xd2\x36\x95\x82\xc2\x9a\x15\x79\x01\x4f\x85\x5d\x18\xfd\xb5\x43\x66\x10\x1b\x45\x56\x28\xc4\xb2\x85\x18\xbb\x80\xc1\x2a\x38\xb1\xa1\x53\x40\xa3\xa0\xc6\x07\xf0\x14\x64\x40\x63\x7a\x68\x91\x85\x33\x78\x6f\x3d\x81\x36\xa5\xcd\x61\x29\xe2\x38\x9f\x4c\x16\x5a\xda\xb6\x56\xd8\xba\x6e\x8c\x96\x87\x49\x61\x8d\x78\x3d\x6f\xc4\x7a\x9e\x28\x5a\x51\x35\x41\xa7\xc7\x51\x4f\x13\x0b\x24\xab\xd5\x8b\x2e\x6d\x5e\xf6\x14\xdb\xc9\x7c\x80\x2e\x2d\x0f\xba\xf9\x9d\x36\x0a\x34\x03\xae\xaf\x25\x75\x37\xde\x0c\xa4\xe0\x84\x9b\x1f\x67\xb7\xd0\x0a\x8d\x1e\xdf\x76\x71\x74\xee\xe6\x1a\x6f\xfc\x1c\xfc\xa2\x4d\x49\x3e\xde\x82\xd2\xdb\x3a\x22\x92\x51\xce\x6a\x23\xf1\x50\x54\x9a\xcc\xb6\x8f\xb9\x99\xd7\x5a\x42\x60\x7f\x6b\x88\x85\x41\x6c\x06\x53\x34\xc6\x0a\xcc\x09\x1a\x17\x0a\x53\x65\x70\x61\x60\x8a\x35\x55\x53\x64\xfa\xde\x5e\x0e\x0e\xe5\x71\xf0\xe0\xe3\x7e\xee\x4f\x9c\x6d\xc6\xe4\x9c\x8e\xdc\x8e\x15\x80\x23\x15\x36\x73\x54\x6c\x25\xbe\x22\xd6\x3e\x24\x67\xe8\xd8\x60\xcb\xed\x46\x73\xb8\xd6\xc2\x53\x54\x0d\x0b\xf9\x1b\x2a\xb7\xe9\x3b\xb2\xa7\x1d\x5b\x5b\xdf\x6b\x0a\x68\x13\x8f\x8c\x35\xa5\x8e\xe7\x30\x66\x01\x71\x2a\x7a\xde\x81\x05\xf8\x62\xb5\xd9\x21\x1e\xd2\xae\x6b\xbc\x7b\xd4\xdd\x64\xbd\x0c\xf2\x63\xc1\x13\x78\x2a\xc9\x93\x91\xc1\x90\x6f\xa6\x67\x88\xba\xb2\x05\x87\x80\x17\xe4\x84\x27\x76\x45\x7e\xa5\xe9\x7e\x72\x6f\xfd\x9d\x36\x8b\xf1\xbd\x96\xe5\x38\xc5\x88\x27\xd1\xba\xc9\x8b\xf8\x67\x40\x1f\x80\xdb\xab\xb7\x57\x39\x9c\x2b\x05\x56\x96\xe4\xa1\x61\x2a\x9b\x0a\x4a\x4d\x95\xe2\xac\xd7\xda\x4e\x63\xe5\x9d\x42\xa3\xd5\x9b\x97\x03\x50\x83\x49\x74\x24\x6d\xc2\xb3\xb7\x15\xe4\xa3\x27\x82\xb6\x73\x60\xf7\x42\x69\x7d\x8d\x12\xb7\x91\x57\xff\x19\x04\xdb\xec\x29\x9b\x67\x55\xcf\xde\x7d\xb8\x0d\xaf\x9f\x26\x7f\xb8\x0e\xd2\x34\x7a\xb4\x12\x22\xdb\x56\x2d\xd8\x39\x87\xe6\xf2\xcc\x62\xe8\x16\x8d\x5d\xe5\xb5\x50\xbd\x47\xdc\xad\x91\xf6\xf6\x9a\x3c\x5f\xab\xd4\x69\xd2\x4d\x1a\x40\x01\x84\x82\xbc\xe0\x5e\x29\x84\x27\x36\xbf\x53\xa8\xad\xa2\xaa\x22\x05\x58\x0a\xa5\x31\xda\x38\x16\x4f\x58\xc7\x96\xb2\x3a\xcb\x3a\x99\x7b\x28\xc7\x4a\x0a\xa0\x42\x96\x5b\x8f\x86\x75\xbb\xe9\x0c\x71\xed\x18\xf8\xeb\xde\xa5\xb6\x19\x04\x38\x90\x40\x08\xa7\xe2\xa0\x52\xe9\x91\x0e\x83\x54\x6a\xfa\xd6\xd0\x3a\xe6\x20\x16\xd0\xc4\xfa\x19\xbc\xdd\x26\x65\xe8\xf0\xe3\x20\x71\x90\xeb\x48\x01\xa5\x7e\xcc\x8c\x8b\xa7\x98\xfc\x3e\x71\xa6\x31\xb8\x6c\x6a\x34\xe0\x09\x15\xce\x2b\x6a\x51\x40\x1b\xa5\x0b\x8c\xe3\x50\x91\xa0\xae\xf8\x80\xdd\x38\xb7\x4d\x9a\x69\x1b\x0f\x3c\x47\xfd\x36\xc7\x7f\x26\x43\xbe\xb7\xa6\x1d\xb5\xe4\x6a\xef\x52\x1b\xbc\xcd\xe6\xbb\xd8\xbc\x93\x25\x1d\xb0\xa2\x8b\x2e\xdc\x23\x03\x93\xc0\x1c\x99\x14\x34\xce\x9a\xa3\x21\xd3\x46\xfe\xf7\xdf\x23\xf6\x0e\x75\x93\xf4\x78\x42\x7e\x92\x91\x37\x91\x31\x45\xcb\x85\x9f\x05\x58\xd7\x28\xba\x00\xad\xc8\x88\x2e\x35\xf9\x7e\xb8\x0e\x1b\x99\x24\x76\xdb\x6b\xca\xef\x6f\x0a\xda\x7e\x4b\x3b\x60\xc3\xba\xa9\xad\x67\x59\xe7\xed\xd3\x58\x24\xb6\x84\x5b\x1f\xd6\xca\x9f\xb0\x62\x3a\x85\x0f\xe6\xce\xd8\xfb\x67\x29\x24\x03\x3d\x7a\x40\x9d\xd0\xca\x83\xd8\x4d\xd8\x75\x6f\xab\xfa\xfb\x82\xc3\xce\x16\xb6\x95\x7d\xd1\xe3\x78\x71\x80\xdc\xfb\x51\xf7\xa4\x51\xd8\xbe\x42\xef\xf1\xe1\x3b\x0d\xc9\xb0\xca\xcc\x06\x43\x78\x60\x34\x1c\xef\xbf\xab\x7a\x6a\x6b\xd7\x08\xf9\x4b\x3c\xd4\x7b\x1f\x89\xdf\xaa\xbe\x30\x2c\x68\x0a\xba\x78\xfb\x0c\x80\x67\x78\x2f\xae\x73\x24\x43\x1a\x1f\x14\xf5\x78\xaf\x3a\xd6\x1e\x0e\x37\x86\xbd\x2f\x17\xff\xd8\xba\xf3\x3d\xf1\x06\x82\xb2\x43\x5a\xff\xc8\xcd\x61\x75\x86\x95\x5b\xe2\xd9\x86\xb6\xfe\xe8\x94\x3e\x54\xf4\x5e\x03\xa4\x10\xe4\x20\xbe\x49\xf5\xc5\x62\x7d\x18\x80\x89\xb2\x69\x4d\x58\x84\x45\x98\xd4\xe5\xee\xa7\x8b\x93\x93\xad\x8f\x16\xf1\xd8\xdb\x96\xe0\xd3\xe7\x51\x42\x25\xf5\xb1\xd5\x26\x10\xff\x1a\x00\x34\x38\x23\x97\xb9\x13\x00\x00"),
 		},
 		"/crd/bases/engine.azk.io_nodesets.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "engine.azk.io_nodesets.yaml",
-			modTime:          time.Date(2019, 9, 28, 14, 35, 16, 328868889, time.UTC),
-			uncompressedSize: 2299,
+			modTime:          time.Date(2026, 10, 17, 2, 2, 13, 547571659, time.UTC),
+			uncompressedSize: 4706,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x4d\x8f\x1b\x37\x0c\xbd\xcf\xaf\x20\xd2\x43\x2e\xb5\x8d\x6d\x2e\xc5\xdc\x02\xa7\x87\x45\xd0\x45\x10\x6f\xf7\x52\xf4\x40\x4b\xb4\xcd\xee\x0c\xa5\x8a\xd4\xa0\x9b\xa2\xff\xbd\x90\xc6\xe3\xaf\x78\x9b\xc5\x22\x73\x1a\x3d\x52\xe4\xd3\x23\xf5\xd1\xcc\x66\xb3\x06\x23\x3f\x50\x52\x0e\xd2\x02\x46\xa6\xbf\x8d\xa4\x8c\x74\xfe\xf8\xb3\xce\x39\x2c\x86\x9b\x35\x19\xde\x34\x8f\x2c\xbe\x85\x65\x56\x0b\xfd\x67\xd2\x90\x93\xa3\x0f\xb4\x61\x61\xe3\x20\x4d\x4f\x86\x1e\x0d\xdb\x06\xc0\x25\xc2\x02\xde\x73\x4f\x6a\xd8\xc7\x16\x24\x77\x5d\x03\x20\xd8\x53\x0b\x12\x3c\x29\x99\xce\x49\xb6\x2c\x34\xc7\x2f\x8f\x73\x0e\x8d\x46\x72\x65\xfa\x36\x85\x1c\x5b\x38\x37\x8e\x73\xb5\xd8\x01\x46\x2e\x77\xc1\xd3\x8a\xac\x22\xb1\xcb\x09\xbb\x63\xe8\x06\x40\x5d\x88\xd4\xc2\x9b\x37\xe5\x3f\xaf\xd3\x9e\xf3\x3e\x84\x1a\x5a\xd6\x16\xfe\xf9\xb7\x01\x18\xb0\x63\x5f\x29\x8f\xc6\x10\x49\xde\x7f\xba\x7d\x78\xb7\x72\x3b\xea\x71\x04\x01\x3c\xa9\x4b\x1c\xab\xdf\x94\x1d\x58\xc1\x76\x04\xa3\x27\x6c\x42\xaa\xc3\x89\x07\xbc\xff\x74\xbb\x9f\x1d\x53\x88\x94\x8c\x27\x06\xe5\x3b\x51\xff\x80\x5d\xe4\x79\x5b\x88\x8c\x3e\xe0\x8b\xde\x34\x26\x1c\x46\x8c\x3c\xe8\x98\x3a\x6c\xc0\x76\xac\x90\x28\x26\x52\x12\xab\x0b\x3a\x09\x0b\xc5\x05\x05\xc2\xfa\x4f\x72\x36\x87\x15\xa5\x12\x04\x74\x17\x72\xe7\xc1\x05\x19\x28\x19\x24\x72\x61\x2b\xfc\xe5\x10\x59\xc1\x42\x4d\xd9\xa1\x91\xda\x59\x44\x16\xa3\x24\xd8\x15\x09\x33\xfd\x08\x28\x1e\x7a\x7c\x82\x44\x25\x07\x64\x39\x89\x56\x5d\x74\x0e\xbf\x86\x44\xc0\xb2\x09\x2d\xec\xcc\xa2\xb6\x8b\xc5\x96\x6d\xea\x37\x17\xfa\x3e\x0b\xdb\xd3\xc2\x05\xb1\xc4\xeb\x6c\x21\xe9\xc2\xd3\x40\xdd\x02\x23\xcf\x2a\x4f\xb1\xda\xa3\xbd\xff\xe1\x50\xd7\xb7\x27\xc4\xec\xa9\x94\x5e\x2d\xb1\x6c\x0f\x70\xed\x9a\x67\x65\xfe\xc8\xe2\x4b\x31\x71\x3f\x6d\xa4\x7b\x54\xb3\x40\x45\x84\xcf\xbf\xac\xee\x61\x4a\x5a\x15\x3f\x97\xb8\x8a\x7b\x9c\xa6\x47\x9d\x8b\x2e\x2c\x1b\x4a\x63\x9d\x36\x29\xf4\x35\x22\x89\x8f\x81\xc5\xea\xc0\x75\x4c\x72\xae\xb1\xe6\x75\xcf\x56\x0a\xfb\x57\x26\xb5\x52\x8e\x39\x2c\x51\x24\x18\xac\x09\x72\xf4\x68\xe4\xe7\x70\x2b\xb0\xc4\x9e\xba\x25\x2a\x7d\x6f\x95\x8b\xa0\x3a\x2b\x0a\x7e\x5b\xe7\xd3\xa3\xe0\xdc\x71\x14\xe7\x00\x4f\x1b\xfe\x6a\x41\xf6\xfb\x6b\x15\xc9\x9d\xf5\xbd\x27\xe5\x54\x7a\xd3\xd0\xa8\x74\xf4\xe9\x31\xf0\xfc\x4e\xab\x0d\x90\xd7\x94\x84\x8c\xf4\xca\xa6\x7b\x76\x39\xe5\x4b\x14\x3b\x76\xa8\x97\x13\x36\x21\xf5\x68\x6d\xd9\x06\xef\x7e\xba\x1a\xac\x6c\x90\x2d\xa5\x33\xdb\xd0\xaf\x3e\xfe\x76\x5f\xcc\x2f\xcb\x7f\x5d\xbc\xf1\x00\xfb\x96\x7c\xd5\xeb\x4c\xc0\xb0\xd6\xd2\x90\xaf\x56\x70\x19\x64\xc3\xdb\x17\x4b\xf7\x7a\xd1\xcb\x19\xba\xfa\x6a\x95\xe5\x63\xa3\xfe\x2b\xf0\x79\xd6\x93\xea\xcb\xd0\xc7\x6c\x94\xee\xca\x2d\x74\xc5\xe3\x7f\xb8\x4c\x21\x6e\x45\x0d\xc5\xd1\xed\x87\x57\x04\xb8\x5a\xc7\x53\x13\xa6\x84\x4f\xcd\xc5\x92\x06\x2e\xaa\xb1\x6c\x8b\x16\x2f\x6d\x99\xef\xdb\xb2\x57\x88\x5f\x40\xc3\xf4\x86\x18\x6e\xb0\x8b\x3b\xbc\x39\x62\x95\xc1\x6c\x7f\xf5\x9f\x98\x01\xc6\x3e\x6c\xc1\x52\xa6\xfd\xa5\x1c\x12\x6e\x69\x8f\x1c\x3b\x1c\x9d\xa3\x68\xe4\xef\x2e\x9f\x00\xf5\x72\x3f\xde\xfe\x75\xe8\x82\xf8\xfa\x26\xd1\x16\x7e\xff\xa3\x19\xa3\x92\x7f\x98\xd8\x14\xf0\xbf\x00\x00\x00\xff\xff\x7a\xe1\x0b\x9f\xfb\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x58\x5f\x6f\xdb\xc8\x11\x7f\xd7\xa7\xf8\xc1\x79\x48\x0b\x58\x14\xdc\x14\x45\x21\x20\x48\x0d\xa7\x2d\xdc\x34\xb6\x61\x39\x79\x09\xf2\x30\xe2\x8e\xa4\x8d\xc9\x5d\xde\xee\x50\x3e\xe7\x70\xdf\xfd\xb0\xbb\x24\x45\x49\x94\xec\xf3\x05\x38\xbf\xd8\x3b\x9c\xf9\xcd\xff\x99\x5d\x8f\xc6\xe3\xf1\x88\x2a\xfd\x99\x9d\xd7\xd6\x4c\x41\x95\xe6\x9f\x85\x4d\x38\xf9\xec\xfe\x9f\x3e\xd3\x76\xb2\x3e\x9b\xb3\xd0\xd9\xe8\x5e\x1b\x35\xc5\x45\xed\xc5\x96\xb7\xec\x6d\xed\x72\x7e\xcf\x0b\x6d\xb4\x68\x6b\x46\x25\x0b\x29\x12\x9a\x8e\x80\xdc\x31\x05\xe2\x9d\x2e\xd9\x0b\x95\xd5\x14\xa6\x2e\x8a\x11\x60\xa8\xe4\x29\x8c\x55\xec\x59\x7c\xc6\x66\xa9\x0d\x67\xf4\xfd\x3e\xd3\x76\xe4\x2b\xce\x83\x38\x29\x15\x31\xa9\xb8\x71\xda\x08\xbb\x0b\x5b\xd4\xa5\xf1\xe1\xdb\x18\xff\x9b\x5d\x5f\xdd\x90\xac\xa6\xc8\xbc\x90\xd4\x3e\x73\x5c\x15\x3a\x27\x3f\x02\x5a\x0d\xb7\x7d\x92\x3c\x56\x3c\x45\x40\x5a\xb2\x3b\x80\x71\x5f\xcf\xd9\x19\x16\xf6\x4d\x34\x7a\x60\x7d\x4a\xc2\xf2\xe2\xb4\x59\x1e\x80\xaa\x9c\x5d\xeb\x20\xa0\xcd\x72\x26\x24\xdc\x83\xda\x9c\x9f\x01\x94\x5b\x93\x02\xe1\xbf\xbc\xfb\xcb\xbf\xb2\x20\xf1\xf6\xed\xc9\x2d\x93\x7a\x3c\xf9\xeb\xd7\x86\x6b\xcb\x69\x52\x8f\x4f\x82\xb7\x89\xca\xf6\xb2\xd4\x83\x3a\x5f\xf6\xad\x54\xc9\xe8\xa5\xb3\x75\x35\xc5\x76\xd2\x92\x44\xcc\x0d\x90\x6a\xe4\xca\x2a\x9e\xb1\x8c\x00\xa0\x2a\x6a\x47\xc5\x26\xe5\x23\xc0\xe7\x36\x80\x9e\x9c\x84\xbf\xeb\xb9\x6b\x6a\xa9\x81\x48\x5e\x4d\xf1\xcb\xaf\x23\x60\x4d\x85\x56\xd1\xc8\xf4\xd1\x56\x6c\xce\x6f\x2e\x3f\xbf\x99\xe5\x2b\x2e\x29\x11\x01\xc5\x3e\x77\xba\x8a\x7c\xad\x76\x68\x0f\x59\x31\x12\x27\x16\xd6\xc5\x63\x6b\x07\xce\x6f\x2e\x1b\xe9\xca\xd9\x8a\x9d\xe8\xd6\x02\x00\xe8\x75\x45\x47\xdb\xd1\xf3\x3a\x18\x92\x78\xa0\x42\x1f\x70\x52\xb8\x4e\x34\x56\xf0\x49\xb5\x5d\x40\x56\xda\xc3\x71\xe5\xd8\xb3\x91\xe8\x50\x0f\x16\x81\x85\x0c\xec\xfc\x1b\xe7\x92\x61\xc6\x2e\x80\xc0\xaf\x6c\x5d\x28\xe4\xd6\xac\xd9\x09\x1c\xe7\x76\x69\xf4\xf7\x0e\xd9\x43\x6c\x54\x59\x90\xb0\x97\x2d\xc4\xd8\x37\x86\x8a\x10\xc2\x9a\x4f\x41\x46\xa1\xa4\x47\x38\x0e\x3a\x50\x9b\x1e\x5a\x64\xf1\x19\x3e\x5a\xc7\xd0\x66\x61\xa7\x58\x89\x54\x7e\x3a\x99\x2c\xb5\xb4\x73\x20\xb7\x65\x59\x1b\x2d\x8f\x93\xdc\x1a\x71\x7a\x5e\x8b\x75\x7e\xa2\x78\xcd\xc5\x84\x2a\x3d\x8e\x76\x9a\x58\xaf\x59\xa9\x5e\x75\x79\x7d\xdd\x33\x6c\xa7\x30\x81\xae\x6a\x0e\x86\xf9\x83\x36\x0a\xda\x83\x1a\xb1\x64\xee\x26\x9a\x81\x14\x82\x70\xfb\xef\xd9\x1d\x5a\xa5\x31\xe2\xdb\x21\x8e\xc1\xdd\x88\xf9\x4d\x9c\x43\x5c\xb4\x59\xb0\x8b\x52\x58\x38\x5b\x46\x44\x36\xaa\xb2\xda\x48\x3c\xe4\x85\x66\xb3\x1d\x63\x5f\xcf\x4b\x2d\x21\xb1\x3f\xd5\xec\x25\xa4\x23\xc3\x05\x19\x63\x05\x73\x46\x5d\x85\xbe\x51\x19\x2e\x0d\x2e\xa8\xe4\xe2\x82\x3c\xff\xe8\x28\x87\x80\xfa\x71\x88\xe0\xd3\x71\xee\x8f\xe8\x6d\xc6\x14\x9c\x8e\xdc\x0e\x62\xe0\x70\x7f\xcd\x2a\xce\xb7\xea\x5e\xb1\xd7\x2e\xd4\x66\x18\x71\xb0\x8b\xad\x31\x70\xb8\xd3\x00\x20\x2f\x6a\x2f\xec\x6e\x79\xb1\x4d\xdf\xd1\x7c\xd1\xb1\xb5\xcd\xdd\x50\xa0\x4d\x3c\x7a\x2a\x39\x8d\xa3\x8a\x62\x0d\xb0\x6f\x3a\x7e\x07\x16\xf8\x66\xb5\xd9\x21\x1e\xb2\xae\x9b\x8a\x7b\xd4\xdd\x52\xbd\x0a\xfa\x63\xbb\x33\x1c\x2f\xd8\xb1\x91\xc1\x84\x6f\xb6\x4d\xc8\xb9\xb2\xb9\x0f\xe9\xce\xb9\x12\x3f\xb1\x6b\x76\x6b\xcd\x0f\x93\x07\xeb\xee\xb5\x59\x8e\x1f\xb4\xac\xc6\x29\x43\x7e\x12\xbd\x9b\xbc\x8a\xbf\x06\xec\x01\xee\xae\xdf\x5f\x4f\x71\xae\x14\xac\xac\xd8\xa1\xf6\xbc\xa8\x0b\x2c\x34\x17\xca\x67\xbd\xc1\x76\x1a\xfb\xee\x14\xb5\x56\xef\x5e\x0f\x40\x0d\x96\xd0\x91\xa2\x01\x80\xbd\x2d\x3a\x1d\x3d\x13\xb4\xdd\xe1\xbb\x02\x0b\xeb\x4a\x92\xb8\xbd\xdf\xfc\x6d\x10\x6c\xb3\xd7\x37\x3f\xeb\x72\xf6\xe1\xd3\x5d\xf8\xfc\x3c\xfd\xc3\x5d\x90\x36\xd1\x53\x7d\x10\xb9\xb6\x3a\xc1\xce\x7d\x98\x2c\x2f\x6b\x85\x6e\xe7\x6f\xd3\x01\x2d\x5c\xee\x11\x77\x3b\xa4\x95\x6e\xc8\xf3\xc6\xa2\xce\x90\x6e\xcb\x80\x04\x84\x9c\x9d\xd0\x5e\x23\x00\x40\x1c\x7c\xa7\x28\xad\xe2\xa2\x60\x05\x5a\x08\xa7\x0d\x5a\x57\x5e\x1c\x53\x19\xc7\xc9\xfa\x2c\xeb\x74\xee\xa1\x1c\x6b\x28\xa0\x20\x2f\x77\x8e\x8c\xd7\xed\x25\x64\x88\x6b\xc7\xc1\xff\xef\x09\xb5\xa3\x20\xc0\x41\x02\x21\x9c\xf2\x83\x46\x01\x00\x20\x1d\x06\xab\x34\xf0\xad\xe1\x26\xe3\x10\x0b\x32\xb1\x7b\x06\xa5\xdb\x92\x0c\xd3\x7d\x1c\x34\x0e\x72\x1d\x69\x1f\x00\x28\xd9\x7b\x5a\x3e\xc7\xe5\x8f\x89\x33\xad\xc0\x55\x5d\x92\x81\x63\x52\x34\x2f\xb8\x45\x81\x36\x4a\xe7\x14\x57\xa1\x62\x21\x5d\xf8\x03\x7e\xd3\xdc\xd6\x69\x9f\x6d\x22\xf0\x12\xf3\xdb\x12\xff\x2f\x1b\x76\xbd\x0b\xda\x51\x4f\xae\xf7\x84\xda\xe4\x6d\x2e\xa5\xcb\xcd\x37\x59\xf1\x01\x2f\xba\xec\xe2\x81\x3c\x3c\x0b\xe6\xe4\x59\xa1\xae\xac\x39\x9a\x32\x6d\xe4\x1f\x7f\x3f\xe2\xef\xd0\x2c\x01\x00\x84\x90\xfb\x67\x39\x79\x1b\x19\x53\xb6\x2a\x67\x97\x8e\xca\x92\x44\xe7\xd0\x2a\xac\xed\x85\x66\xd7\x4f\xd7\x61\x27\x93\xc6\xee\xe2\x9a\xea\xfb\x0f\x25\x6d\x7f\xa0\x1d\xf0\xa1\x99\x69\xcd\x26\xeb\xa2\x7d\x1a\x9b\xc4\x2e\x70\xe7\xc2\x95\xf2\x3f\x54\x78\x3e\xc5\x27\x73\x6f\xec\xc3\x8b\x0c\x92\x81\x09\x3d\x60\x4e\x18\xe4\x41\x6d\x67\x08\x74\xef\x46\xf5\xfb\x15\x87\xfb\x5a\xb8\xaa\xec\xab\x1e\x47\xc1\x01\x72\xef\xad\xf5\xac\x45\xd8\x7e\x22\xe7\xe8\xf1\x07\xad\xc8\x70\x91\x99\x0d\xa6\xf0\xc0\x6a\x38\x3e\x7f\xd7\xe5\x85\x2d\xab\x5a\xd8\x5d\xd1\xa1\xd9\xfb\x44\xfe\xd6\xe5\xa5\xf1\x42\x26\xe7\xcb\xf7\x2f\x00\x78\x41\xf4\x9e\x9e\x3b\xc7\x5a\xfd\x70\x93\xef\xbd\xd9\xff\x94\x8b\xcb\x40\x40\x76\x48\xcd\xe3\x72\x8a\xf5\x19\x15\xd5\x8a\xce\x36\xb4\xe6\xdf\x23\xe9\xfd\xde\xfb\x0c\xa4\x90\x4d\x21\xae\xe6\x44\x10\xeb\xc2\xf2\x49\x94\xcd\x58\xa0\x3c\x5c\x41\x59\x5d\xed\xbe\xe8\x4f\x4e\xb6\x1e\xf3\xf1\xd8\xbb\xa9\xe0\xcb\xd7\x51\x42\x65\xf5\xb9\xb5\x26\x10\x7f\x1b\x00\xa0\xfc\x33\x8e\x62\x12\x00\x00"),
 		},
 		"/crd/kustomization.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "kustomization.yaml",
-			modTime:          time.Date(2019, 9, 25, 4, 19, 58, 961521466, time.UTC),
-			uncompressedSize: 1261,
+			modTime:          time.Date(2026, 10, 17, 2, 42, 40, 518530558, time.UTC),
+			uncompressedSize: 1527,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x94\x41\x6f\xdb\x3c\x0c\x86\xef\xfe\x15\x04\x7c\xfc\xda\xe4\x9e\x5b\xbe\x34\xd8\x86\xa1\x2b\x90\x05\xd8\x61\x18\x0a\x59\xa2\x6d\x2d\x0a\x69\x88\x54\xb3\xf6\xd7\x0f\xb2\x93\x34\xcd\xea\x0c\xd9\xcd\x90\x1e\xbd\x34\x1f\x41\x2c\x61\xdd\x7a\x81\x4d\x12\xe5\xad\x7f\x31\xea\x99\x26\xcf\x66\x1b\xc0\x0b\x10\x2b\x78\x52\x24\x87\x0e\x94\xa1\x42\x88\x89\xa0\x7a\x06\xaf\x82\xa1\xbe\x29\x4a\x10\x4f\x16\xc1\x2b\x38\xec\x90\x9c\x00\x13\x08\xc6\x27\x6f\x11\xc8\x6c\x11\x0c\xb9\xfe\x43\x3a\x63\x11\xb4\x35\x0a\x26\x22\x70\x52\xe0\x1a\xf4\xb4\x3a\x42\x67\xec\xc6\x34\x38\x29\x4a\xf8\xa4\x20\x2d\xa7\xe0\x4e\xca\x5a\xa6\xda\x37\x53\x87\xb5\x49\x41\x8b\x88\xc2\x29\x5a\x94\x59\x71\x0b\x95\x11\x94\x29\x52\xe3\x09\x27\xe6\x65\x33\xf1\xfc\x68\x43\x12\xc5\x28\x7d\x47\x63\x0c\x93\x46\x0e\x5d\x30\x84\x17\x41\x62\x87\x1d\x73\xf8\x2b\x24\xa8\x7b\xa6\x84\xff\x36\xa9\xc2\x2a\xf9\xe0\x30\xce\xc4\x9a\xba\xe6\xe0\x66\x36\xba\x63\xcf\x87\x26\x8a\xa2\x33\x6a\x5b\x94\xaf\x1a\x8d\x62\xe3\xed\x3d\xc6\x06\x67\x45\x09\xdf\xbf\x2d\xff\xff\xf8\xf0\xf0\xf9\x07\xac\x19\x90\x4c\x15\x10\x76\x58\xb5\xcc\x9b\x1b\x48\x64\x79\xbb\x45\x52\x30\x21\x80\xb6\x08\x82\x36\x5f\xa3\xc0\xce\x6b\x7b\x72\xb8\x8b\x58\xfb\x5f\xd9\xed\xbe\x12\xb4\x18\xb1\xbf\x8d\x9a\xe3\x10\xec\xa9\xe9\x33\x2c\xd3\x13\x46\xf1\x4c\x87\x4a\x03\x63\x6c\x0b\x8b\xd5\x5d\x51\xde\x1e\x42\xa6\xfb\xfd\x47\x4f\x67\xbe\x47\x98\x3f\x7d\xbf\x0f\x9e\xf9\x1e\x87\xae\xf3\xbd\x3f\xdc\x67\x15\x59\xee\x62\xb9\x5a\xdf\xcf\xbf\xcc\x3f\x2c\x57\xff\x26\xf8\x4d\xc0\x35\x92\x17\x73\xf0\xf4\x73\x08\x1b\xd5\x6b\xcd\x91\xb9\xa8\xf8\x9c\xbb\xa8\xf9\x0c\x1e\x57\xfd\x0e\x78\x9d\xee\x93\x80\xa3\xf2\xdc\x7b\xcd\x21\xf0\x2e\x9b\x18\x1e\x75\x9e\x37\x59\x81\x66\x07\x79\xf9\x75\x28\xb4\xbc\xcb\xd3\xc7\xf1\xdb\x31\xd5\xe3\x8b\xd5\x9d\x4c\x8a\x21\x22\xc5\x7e\xbd\x9f\x06\xaf\xf5\xfb\xad\xe1\x7f\x7f\x07\x00\x00\xff\xff\xb8\x82\x8e\x07\xed\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x94\xc1\x6e\xdb\x30\x0c\x86\xef\x7e\x0a\x02\x3e\xae\x4d\xef\xb9\x65\x69\xb0\x0d\x43\x57\x20\x0b\xb0\xc3\x30\x14\xb2\x44\xdb\x9a\x15\xd2\x10\xa9\x66\xed\xd3\x0f\xb6\x93\x34\x4d\xe3\x74\xd9\xcd\x90\x3e\xff\x94\xff\xcf\x50\x0e\xab\xda\x0b\x34\x49\x94\xd7\xfe\xd9\xa8\x67\x9a\x3c\x99\x75\x00\x2f\x40\xac\xe0\x49\x91\x1c\x3a\x50\x86\x02\x21\x26\x82\xe2\x09\xbc\x0a\x86\xf2\x2a\xcb\x41\x3c\x59\x04\xaf\xe0\xb0\x45\x72\x02\x4c\x20\x18\x1f\xbd\x45\x20\xb3\x46\x30\xe4\xfa\x07\x69\x8d\x45\xd0\xda\x28\x98\x88\xc0\x49\x81\x4b\xd0\xc3\xe9\x08\xad\xb1\x8d\xa9\x70\x92\xe5\xf0\x45\x41\x6a\x4e\xc1\x1d\x8c\xb5\x4c\xa5\xaf\x6e\x1c\x96\x26\x05\xcd\x22\x0a\xa7\x68\x51\xa6\xd9\x35\x14\x46\x50\x6e\x90\x2a\x4f\x38\x31\xcf\xcd\xc4\xf3\x83\x0d\x49\x14\xa3\xf4\x5f\x34\xc6\x30\x69\xe4\xd0\x06\x43\x78\x16\x24\x76\xd8\x32\x87\x77\x21\x41\x3d\xcb\xa0\x5a\x57\x18\xdb\xa4\xf6\x5f\x31\x5b\xa3\x4b\x61\x77\xbc\x1c\x3e\x34\xa9\xc0\x22\xf9\xe0\x30\x4e\xc5\x9a\xb2\xe4\xe0\xa6\x36\xba\x7d\x93\xbb\x6a\xb2\xac\x35\x6a\x6b\x94\xef\x1a\x8d\x62\xe5\xed\x1d\xc6\x0a\xa7\x59\x0e\x3f\x7f\x2c\x3e\x7e\xbe\xbf\xff\xfa\x0b\x56\x0c\x48\xa6\x08\x08\x1b\x2c\x6a\xe6\xe6\x0a\x12\x59\x5e\xaf\x91\x14\x4c\x08\xa0\x35\x82\xa0\xed\x7e\x0e\x81\x8d\xd7\xfa\xe0\xe5\x36\x62\xe9\xff\x74\xc6\xb6\x93\xa0\xc6\x88\xbd\xe3\x92\xe3\x10\xec\xa9\xea\x33\x2c\xd3\x23\x46\xf1\x4c\xbb\x49\x03\x63\x6c\x0d\xf3\xe5\x6d\x96\x5f\xef\x42\x6e\xb6\xfb\x0f\x9e\x8e\x2c\x8e\x30\x6f\x2d\x9e\x06\x8f\x2c\x8e\x43\x2f\x16\x4f\x33\x6f\x2c\xbe\x8b\x5d\x6e\x71\x9b\xd3\xc7\x66\x9d\xb2\xf9\x62\xb9\xba\x9b\x7d\x9b\x7d\x5a\x2c\xff\x4f\xdb\xab\x80\x4b\xd4\xcd\x67\xe0\xe9\xf7\x10\x36\x2a\xcd\x9a\x3d\x73\x56\xdc\x31\x77\x56\xde\x11\x3c\x2e\xf0\x04\x78\x52\xe2\x11\x77\x4e\xe4\x38\x7a\xb9\xcc\x83\xac\xbd\xd0\xae\xd9\x92\x43\xe0\x4d\xd7\xf3\x70\xbd\x81\x97\xbe\x60\xed\x1a\xee\x96\xf7\x09\x50\xf3\x06\x94\xc1\xf1\xeb\x0b\xbb\xc7\xe7\xcb\x5b\x99\x64\x43\x44\x8a\xfd\x7a\x7f\x2f\xbe\xcc\xef\xb7\x86\xf3\xfe\x1d\x00\x7d\x90\x46\x86\xf7\x05\x00\x00"),
 		},
 		"/crd/kustomizeconfig.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "kustomizeconfig.yaml",
@@ -115,6 +129,20 @@ var Assets = func() http.FileSystem {
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x8f\x31\x6b\xeb\x50\x0c\x85\x77\xff\x8a\x03\x79\xc3\xcb\x90\x1b\xcc\x5b\x82\x37\xe3\xf8\x41\x86\x96\x92\x86\xae\x45\xbd\x56\x12\xd5\xb6\xe4\xde\xab\xa4\xa5\xbf\xbe\x98\x50\x28\x74\x11\x1c\xe9\x43\x7c\x67\x81\xc3\x99\x71\xb4\x61\xb0\x77\xd1\x13\x26\xf2\x78\x06\x75\x5d\x06\xa1\x93\xc4\xd1\xe5\x3a\x03\x09\x91\x93\x8f\xa4\x74\xe2\x04\x37\x88\xbe\x72\x74\x34\x35\x44\xdd\xe0\x67\x46\xb3\xdf\x16\x8b\x79\x22\x9a\x5e\x39\x65\x31\x45\xe2\xb7\x8b\x24\xce\xe8\x37\x19\x65\x28\xff\xc1\x12\x06\x72\x4e\xa1\xa0\x49\x9e\x6e\x58\x05\x9a\x84\x3f\x9c\x75\x4e\x39\xf4\x9b\x1c\xc4\xd6\xd7\xf2\x85\x9d\xca\xa2\x17\xed\x2a\x34\x97\xec\x36\xee\x39\xdb\x25\x45\xde\xf2\x51\x54\x5c\x4c\x8b\x91\x9d\x3a\x72\xaa\x0a\x80\x54\xcd\x69\x5e\xe7\x39\xe2\xa7\xf7\xf7\xdb\x9b\xfb\x2a\xd2\xea\x98\x6c\xac\xf0\xe7\x6f\xd3\xee\x0f\xbb\xff\xbb\xa6\x3e\xb4\xcf\xf7\xf5\x5d\xfb\xf8\x50\x37\xed\x72\xfd\xfb\xb0\x2c\x00\xa5\x91\xab\xb9\xa3\x27\x1b\xa6\x81\x94\x73\x60\x3d\x89\x72\xa0\xcf\x3e\x88\x15\x5f\x01\x00\x00\xff\xff\x9f\xa2\x2a\x24\x57\x01\x00\x00"),
 		},
+		"/crd/patches/cainjection_in_etcdbackups.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "cainjection_in_etcdbackups.yaml",
+			modTime:          time.Date(2026, 10, 17, 2, 42, 43, 464794601, time.UTC),
+			uncompressedSize: 341,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x8e\x41\x6b\xe3\x40\x0c\x46\xef\xfe\x15\x1f\x64\x0f\x9b\x43\x26\x98\xbd\x04\xdf\x8c\xe3\x85\x1c\x76\x29\x69\xe8\xb5\x28\x63\x25\x51\x1d\x4b\xee\x8c\x9c\x96\xfe\xfa\x62\x42\xa1\xd0\x8b\xe0\x49\x0f\xf1\x16\x38\x5c\x18\x27\xbb\x5e\xed\x4d\xf4\x8c\x91\x3c\x5e\x40\x5d\x97\x41\xe8\x24\x71\x74\xb9\xcd\x42\x42\xe4\xe4\x03\x29\x9d\x39\xc1\x0d\xa2\x2f\x1c\x1d\x4d\x0d\x51\x37\xf8\x85\xd1\xec\xb7\xc5\x62\x9e\x88\xa6\x37\x4e\x59\x4c\x91\xf8\x75\x92\xc4\x19\xfd\x26\xa3\x0c\xe5\x1f\x58\xc2\x95\x9c\x53\x28\x68\x94\xa7\xbb\x56\x81\x46\xe1\x77\x67\x9d\x29\x87\x7e\x93\x83\xd8\xfa\x56\x1e\xd9\xa9\x2c\x7a\xd1\xae\x42\x33\x65\xb7\x61\xcf\xd9\xa6\x14\x79\xcb\x27\x51\x71\x31\x2d\x06\x76\xea\xc8\xa9\x2a\x00\x52\x35\xa7\x79\x9d\x67\xc4\xf7\xee\xaf\xb7\xf7\xf6\x55\xa4\xd5\x29\xd9\x50\xe1\xd7\xef\xa6\xdd\x1f\x76\x7f\x77\x4d\x7d\x68\x9f\xff\xd7\xff\xda\xc7\x87\xba\x69\x97\xeb\x9f\x87\x65\x01\x28\x0d\x5c\x81\x3d\x76\x47\x8a\xfd\x34\xe6\xc0\x7a\x16\xe5\x40\x1f\x7d\x10\x2b\x3e\x07\x00\xff\x84\x88\xc1\x55\x01\x00\x00"),
+		},
+		"/crd/patches/cainjection_in_etcdbackupschedules.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "cainjection_in_etcdbackupschedules.yaml",
+			modTime:          time.Date(2026, 10, 17, 2, 42, 43, 469973171, time.UTC),
+			uncompressedSize: 349,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x8e\x41\x6b\xe3\x40\x0c\x46\xef\xfe\x15\x1f\x64\x0f\x9b\x43\x1c\xcc\x5e\x82\x6f\xc6\xf1\x42\x0e\xbb\x94\x34\xf4\x5a\x94\xb1\x12\xab\xb6\x25\x77\x46\x4e\x4b\x7f\x7d\x31\xa1\x50\xe8\x45\xf0\xa4\x87\x78\x2b\x9c\x3a\xc6\xc5\x86\xc1\xde\x44\xaf\x98\xc8\x43\x07\x6a\xdb\x04\x42\x2b\x91\x83\xcb\x6d\x11\x22\x02\x47\x1f\x49\xe9\xca\x11\x6e\x10\x7d\xe1\xe0\xa8\x2b\x88\xba\xc1\x3b\x46\x7d\xdc\x67\xab\x65\x22\x98\xde\x38\x26\x31\x45\xe4\xd7\x59\x22\x27\xf4\xbb\x84\x22\x2f\xfe\xc0\x22\x06\x72\x8e\x79\x46\x93\x3c\xdd\xb5\x12\x34\x09\xbf\x3b\xeb\x42\x29\xef\x77\x29\x17\xdb\xde\x8a\x33\x3b\x15\x59\x2f\xda\x96\xa8\xe7\xe4\x36\x1e\x39\xd9\x1c\x03\xef\xf9\x22\x2a\x2e\xa6\xd9\xc8\x4e\x2d\x39\x95\x19\x40\xaa\xe6\xb4\xac\xd3\x82\xf8\xde\xfd\xf5\xf6\xde\xbe\x09\xb4\xb9\x44\x1b\x4b\xfc\xfa\x5d\x37\xc7\xd3\xe1\xef\xa1\xae\x4e\xcd\xf3\xff\xea\x5f\xf3\xf8\x50\xd5\xcd\x7a\xfb\xf3\xb0\xce\x00\xa5\x91\x4b\xb0\x87\xf6\x4c\xa1\x9f\xa7\x14\x3a\x6e\xe7\x81\x53\xce\x7a\x15\xe5\x9c\x3e\xfa\x5c\x2c\xfb\x1c\x00\xf4\x4e\xac\x41\x5d\x01\x00\x00"),
+		},
 		"/crd/patches/cainjection_in_nodepools.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "cainjection_in_nodepools.yaml",
 			modTime:          time.Date(2019, 9, 25, 4, 19, 50, 383555511, time.UTC),
@@ -143,6 +171,20 @@ var Assets = func() http.FileSystem {
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x91\xc1\x6e\xdb\x40\x0c\x44\xef\xfa\x8a\x41\x72\x68\x0b\x24\x0a\x8c\x5e\x02\x01\xb9\xd4\xf9\x82\xa0\x68\x2f\xbd\x50\xd2\x58\x62\xb5\x5e\xaa\x4b\xca\xae\xfb\xf5\x85\x6c\x27\x4d\x2f\x0b\x90\x1c\x2e\x87\x8f\xb7\xf8\x3a\x12\x3b\x4b\xc9\x8e\x9a\x07\xcc\x12\xdd\x08\x66\x69\x13\x1d\x9d\xe5\x03\x8b\xab\x65\x1c\xd9\x8e\x66\x13\x76\x56\xb0\x7d\x79\xae\x6e\xd7\xf7\xbd\xa0\xf0\xd7\xa2\x85\x8e\xe9\xd1\xb1\xa9\x37\x9f\x61\x05\x49\x82\xa5\xae\x64\xd6\x6f\x17\x59\x03\x99\x95\xbf\x83\x79\x8d\xbc\x9e\x1e\xbd\x56\x7b\x38\x6c\x5a\x86\x6c\xaa\x49\x73\xdf\x60\xbb\x78\xd8\xfe\x85\x6e\x4b\xe9\xf8\xcc\x9d\x66\x0d\xb5\x5c\xed\x19\xd2\x4b\x48\x53\x01\x59\xf6\x6c\x56\x03\x51\x2c\xcd\x49\x32\xbd\x66\x1e\x34\xb3\x96\x3f\x53\xad\x56\xf9\xcc\x6e\x55\xfe\x33\xb9\x46\x80\x47\x91\xe0\x70\x6a\xf0\xfd\xb2\xd4\x39\x7b\x5d\x70\x9b\x94\x39\xb6\x96\x77\x3a\x5c\xe4\xc0\x2d\x62\x54\x87\x3a\x6e\x7e\xe4\x1b\x2c\xce\x1e\xe2\x10\xcc\x49\x3a\x8e\x96\x7a\x96\x3b\x58\x8c\x2c\x47\x75\x42\x03\x47\x4d\x09\x2d\x51\xf8\x93\x5d\xb0\x47\x7b\x42\x8c\x5c\xb7\x77\x96\x03\xcb\x19\x64\xcb\x95\x79\x9b\x24\x4f\x77\x6f\xb3\xda\x25\x70\xe4\x87\x42\x0c\xb6\x96\xc3\xe0\x8c\xf5\xd3\x33\x4d\x2c\x7e\xce\x8e\x44\xc7\x12\xf7\x7b\xc9\x32\xb0\xe0\xa3\x15\xcc\x16\xcc\xa1\x92\xd2\x69\x75\x77\xbe\xa5\xee\x90\x2d\xae\x5d\xef\x3b\x3e\x5d\x27\x76\xf2\x65\xc9\x7d\x62\x83\xed\xf0\xf4\x74\x4d\xae\x26\xb5\xe3\x2b\x81\x0b\x6e\x9f\xa5\x63\x03\x3f\x79\x70\xff\x5f\xa5\x79\xc5\x77\x7f\x6d\x7c\xab\xce\x12\x63\x83\x87\xcb\x0d\xa2\xfa\x1b\x00\x00\xff\xff\x28\x1d\x33\xcf\x70\x02\x00\x00"),
 		},
+		"/crd/patches/webhook_in_etcdbackups.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "webhook_in_etcdbackups.yaml",
+			modTime:          time.Date(2026, 10, 17, 2, 42, 43, 467443593, time.UTC),
+			uncompressedSize: 622,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x91\x41\x6f\xd4\x40\x0c\x85\xef\xf9\x15\x4f\xed\x01\x90\xda\x54\x2b\x2e\x55\xa4\x5e\xd8\xfe\x82\x0a\xc1\x85\x8b\x33\x79\x9b\x98\xcc\xce\x84\xb1\xb3\xcb\xf2\xeb\x51\x36\x69\x29\x97\x91\x6c\x3f\x8f\xfd\x3e\xdf\xe2\xeb\x40\x1c\x72\x8c\xf9\xac\xa9\xc7\x24\x1e\x06\x30\x49\x1b\x69\x08\x39\x9d\x58\x4c\x73\xc2\x99\xed\x90\xf3\x88\x43\x2e\xd8\xbf\x3c\x57\xb7\xcb\xfb\x5e\x50\xf8\x6b\xd6\x42\xc3\xf8\x68\xd8\xd5\xbb\xcf\xc8\x05\x51\x9c\xa5\xae\x64\xd2\x6f\xab\xac\x81\x4c\xca\xdf\xce\xb4\x44\x56\x8f\x8f\x56\x6b\x7e\x38\xed\x5a\xba\xec\xaa\x51\x53\xd7\x60\x3f\x9b\xe7\xe3\x0b\x2d\xcf\x25\xf0\x99\x07\x4d\xea\x9a\x53\x75\xa4\x4b\x27\x2e\x4d\x05\x24\x39\xb2\x01\x3d\x74\xad\x84\x71\x9e\xac\x66\xea\x35\xb1\x96\x3f\x63\xad\xb9\xb2\x89\x61\xd1\xfd\x5b\x71\x89\x00\xf3\x22\xce\xfe\xd2\xe0\xfb\x6a\xe9\x9a\xdd\xec\xed\xa3\x32\xf9\x3e\xa7\x83\xf6\xab\x1c\xb8\x85\x0f\x6a\x50\xc3\xcd\x8f\x74\x83\xd9\xd8\x41\x0c\x82\x29\x4a\xe0\x90\x63\xc7\x72\x87\xec\x03\xcb\x59\x8d\x50\xc7\x59\x63\x44\x4b\x14\xfe\x64\x70\x76\x68\x2f\xf0\x81\x8b\x77\x63\x39\xb1\x5c\x31\xb6\x5c\x88\xb7\x51\xd2\x78\xf7\x36\xab\x9d\x1d\x67\x7e\x28\x44\x9f\x97\xb2\x67\x18\x7d\xf9\xf4\xca\x12\xb3\x5d\xb3\x03\x11\x58\xfc\xfe\x28\x49\x7a\x16\x7c\xcc\x05\x53\x76\x26\x57\x89\xf1\x02\xd9\x2e\xa9\x07\xa4\xec\x5b\xd7\xfb\x8e\x4f\xdb\xc4\x20\x5f\xe6\xd4\x45\x36\xd8\xf7\x4f\x4f\x5b\x72\x59\x52\x03\x5f\x09\xac\xb0\x6d\x92\xc0\x06\x76\x31\xe7\xf1\xbf\x4a\xf3\x8a\xef\x7e\x6b\x7c\xab\x4e\xe2\x43\x83\x87\xf5\x06\x5e\xfd\x1d\x00\x91\x79\x87\x1a\x6e\x02\x00\x00"),
+		},
+		"/crd/patches/webhook_in_etcdbackupschedules.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "webhook_in_etcdbackupschedules.yaml",
+			modTime:          time.Date(2026, 10, 17, 2, 42, 43, 471710237, time.UTC),
+			uncompressedSize: 630,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x91\x41\x6f\xd4\x40\x0c\x85\xef\xf9\x15\x4f\xed\x01\x90\xda\x54\x2b\x2e\x55\xa4\x5e\xd8\xfe\x82\x0a\xc1\x85\x8b\x33\x79\x9b\x98\xcc\xce\x84\xb1\xb3\xcb\xf2\xeb\x51\x36\x69\x29\x97\x91\x6c\xbf\x37\xb6\x3f\xdf\xe2\xeb\x40\x1c\x72\x8c\xf9\xac\xa9\xc7\x24\x1e\x06\x30\x49\x1b\x69\x08\x39\x9d\x58\x4c\x73\xc2\x99\xed\x90\xf3\x88\x43\x2e\xd8\xbf\x3c\x57\xb7\xcb\xfb\x5e\x50\xf8\x6b\xd6\x42\xc3\xf8\x68\xd8\xd5\xbb\xcf\xc8\x05\x51\x9c\xa5\xae\x64\xd2\x6f\xab\xac\x81\x4c\xca\xdf\xce\xb4\x44\x56\x8f\x8f\x56\x6b\x7e\x38\xed\x5a\xba\xec\xaa\x51\x53\xd7\x60\x3f\x9b\xe7\xe3\x0b\x2d\xcf\x25\xf0\x99\x07\x4d\xea\x9a\x53\x75\xa4\x4b\x27\x2e\x4d\x05\x24\x39\xb2\x01\x3d\x74\xad\x84\x71\x9e\x2c\x0c\xec\xe6\x48\xab\x99\x7a\x4d\xac\xe5\xcf\x58\x6b\xae\x6c\x62\x58\xf4\xff\x46\x5d\x22\xc0\xbc\x88\xb3\xbf\x34\xf8\xbe\xae\x76\xcd\x6e\x6b\xee\xa3\x32\xf9\x3e\xa7\x83\xf6\xab\x1c\xb8\x85\x0f\x6a\x50\xc3\xcd\x8f\x74\x83\xd9\xd8\x41\x0c\x82\x29\x4a\xe0\x90\x63\xc7\x72\x87\xec\x03\xcb\x59\x8d\x50\xc7\x59\x63\x44\x4b\x14\xfe\x64\x70\x76\x68\x2f\xf0\x81\x0b\x03\x63\x39\xb1\x5c\x71\xb6\x5c\xc8\xb7\x51\xd2\x78\xf7\xd6\xab\x9d\x1d\x67\x7e\x28\x44\x9f\x97\xb2\x67\x18\x7d\xf9\xf4\xca\x14\xb3\x5d\xb3\x03\x11\x58\xfc\xfe\x28\x49\x7a\x16\x7c\xcc\x05\x53\x76\x26\x57\x89\xf1\x02\xd9\x2e\xaa\x07\xa4\xec\x9b\xeb\xbd\xe3\xd3\xd6\x31\xc8\x97\x39\x75\x91\x0d\xf6\xfd\xd3\xd3\x96\x5c\x86\xd4\xc0\x57\x02\x2b\x74\x9b\x24\xb0\x81\x5d\xcc\x79\xfc\xaf\xd2\xbc\xe2\xbb\xdf\x8c\x6f\xd5\x49\x7c\x68\xf0\xb0\xde\xc0\xab\xbf\x03\x00\x0d\xfe\x45\x7e\x76\x02\x00\x00"),
+		},
 		"/crd/patches/webhook_in_nodepools.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "webhook_in_nodepools.yaml",
 			modTime:          time.Date(2019, 9, 25, 4, 19, 50, 383276588, time.UTC),
@@ -202,10 +244,10 @@ var Assets = func() http.FileSystem {
 		},
 		"/deployment/azk-deployment.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "azk-deployment.yaml",
-			modTime:          time.Date(2019, 9, 28, 14, 35, 16, 458620535, time.UTC),
-			uncompressedSize: 15579,
+			modTime:          time.Date(2026, 10, 17, 4, 22, 45, 379946023, time.UTC),
+			uncompressedSize: 60921,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\xcd\x72\x1b\xb9\x11\xbe\xf3\x29\x50\xce\x61\x2f\x19\x52\xb2\x9d\x8a\x6b\xaa\x7c\x50\x64\xd7\x46\xe5\xb5\x8b\x25\x3a\xbe\xa4\x72\x00\x81\xe6\x10\x11\x06\xc0\x02\x0d\x5a\x74\x2a\xef\x9e\x02\xe6\x7f\xc8\x91\x86\x14\x65\xef\x66\x49\x5d\x48\xfc\x34\xba\xbf\x6e\xa0\xbf\xc6\x8c\xa8\x11\x5f\xc0\x3a\xa1\x55\x4a\x36\x97\x93\x3b\xa1\x78\x4a\x3e\xd1\x1c\x9c\xa1\x0c\x26\x39\x20\xe5\x14\x69\x3a\x21\x44\xd2\x25\x48\x17\xbe\x11\xc2\xb4\x42\xab\x65\x62\x24\x55\x90\x56\x3f\x25\xd8\x24\xa7\x8a\x66\x60\x27\x84\x28\x9a\x43\x4a\xe8\xb7\xbb\xc4\x6d\x1d\x42\x3e\x49\x92\x64\xd2\x5e\x8f\x1a\x01\xf7\x08\x2a\xfc\x72\xd3\xbb\x37\x6e\x2a\xf4\x6c\x73\xb9\x04\xa4\x95\x26\xd7\xde\xa1\xce\x6f\xc1\x69\x6f\x19\xbc\x83\x95\x50\x02\x85\x56\x1d\xc5\x98\x05\x1a\x1a\x3f\x8b\x1c\x1c\xd2\xdc\xa4\x44\x79\x29\x6b\x15\x98\xf4\x0e\xc1\xba\x29\xa8\x4c\x28\x98\xd2\x6f\x77\x53\xa1\x27\xce\x00\x0b\xd3\x33\xab\xbd\x49\x49\xb7\xb3\x98\x5b\x5a\x5b\xea\x52\x88\x89\x2d\x46\x7a\x4b\x65\x23\x7a\x42\x88\x63\xda\x40\x4a\x5e\xbc\x08\xdf\xfd\xd2\x96\x3a\x97\x22\x1c\x52\xf4\x2e\x25\xff\xf9\xef\x84\x90\x0d\x95\x82\x47\x95\x8b\x4e\x6d\x40\x5d\xcd\x6f\xbe\xbc\x5a\xb0\x35\xe4\xb4\x68\x24\x84\x83\x63\x56\x98\x38\xae\x5a\x9d\x08\x47\x70\x0d\xa4\x18\x49\x56\xda\xc6\x9f\x95\x1e\xe4\x6a\x7e\x53\xce\x36\x56\x1b\xb0\x28\x2a\x0d\xc2\xa7\x85\x7e\xdd\xd6\x5b\xe7\xa7\xa0\x48\x31\x86\xf0\x80\x37\x14\x0b\x6e\x8a\x36\xe0\xc4\x15\x4b\xeb\x15\xc1\xb5\x70\xc4\x82\xb1\xe0\x40\x61\x34\xa8\x25\x96\x84\x21\x54\x11\xbd\xfc\x37\x30\x9c\x92\x05\xd8\x20\x84\xb8\xb5\xf6\x92\x87\x98\xd9\x80\x45\x62\x81\xe9\x4c\x89\x6f\xb5\x64\x47\x50\xc7\x25\x25\x45\x70\xd8\x91\x28\x14\x82\x55\x54\x06\x08\x3d\xfc\x99\x50\xc5\x49\x4e\xb7\xc4\x42\x58\x83\x78\xd5\x92\x16\x87\xb8\x29\xf9\xa8\x2d\x10\xa1\x56\x3a\x25\x6b\x44\xe3\xd2\xd9\x2c\x13\x58\xc5\x1b\xd3\x79\xee\x95\xc0\xed\x2c\x06\xb1\x58\x7a\xd4\xd6\xcd\x38\x6c\x40\xce\xa8\x11\x49\xd4\x53\x61\x8c\xd1\x9c\xff\xa9\xf6\xeb\x4f\x2d\xc5\x70\x1b\x5c\xef\xd0\x0a\x95\xd5\xcd\x31\x6a\x06\x61\xfe\x20\x14\x0f\xce\xa4\xe5\xb4\x42\xdd\x06\xcd\xd0\x14\x40\xb8\x7d\xbf\xf8\x4c\xaa\x45\x23\xe2\x5d\x88\x23\xb8\xcd\x34\xd7\xe0\x1c\x70\x11\x6a\x05\xb6\xf0\xd3\xca\xea\x3c\x4a\x04\xc5\x8d\x16\x0a\xcb\xc0\x11\xa0\xba\x18\x3b\xbf\xcc\x05\x06\xc7\xfe\xea\xc1\x61\x70\xc7\x94\x5c\x53\xa5\x34\x92\x25\x10\x6f\x38\x45\xe0\x53\x72\xa3\xc8\x35\xcd\x41\x5e\x53\x07\xa7\x46\x39\x00\xea\x92\x80\xe0\xe3\x38\xb7\x8f\x82\xee\xc0\x02\x9c\xba\xb9\xda\xf0\x7b\x1d\x52\xee\xaf\x85\x01\xd6\x89\x7b\x0e\x4e\xd8\x10\x9b\x48\x11\x42\x44\xb7\x8f\x81\xe1\x9d\x16\x77\x1b\xcf\x85\xfa\xe0\x97\x70\xad\xd5\x4a\x64\xdd\xce\x01\x63\xe2\xbc\x6f\xde\xc2\xb5\xd4\x9e\xcf\xad\xde\x08\x0e\xf6\x40\x01\x4b\xad\xd1\xa1\xa5\x26\x2c\x6e\x15\x20\xb8\x3d\xdb\x7e\x9c\x88\x2f\x1f\x17\x1f\xfe\xf1\x39\x0c\x1b\x3b\x95\xd1\xeb\x80\xc6\x4a\x30\x8a\x47\xce\xfa\x00\xdb\xf1\x13\x63\x04\xdf\xbc\x3b\x70\xc2\x02\x98\x05\x3c\x60\x92\xf6\xfc\x80\xd1\x31\x46\x42\x1e\x1d\x3f\x27\xa6\x3a\xb0\x47\x04\x0c\x17\x8e\xe9\x0d\xd8\xed\xdf\xa9\x5b\xf7\xa3\x90\x10\x81\x90\xef\x34\x3e\x20\xae\xea\xa2\xd6\xd2\x6d\x77\x21\xe5\xe6\x16\x56\xe2\x7e\xb4\x6a\x80\x8c\x5f\x5f\x1d\x13\x10\x3b\x33\x0f\x09\x8a\x95\xd5\x0a\xe7\x56\xdf\x6f\x8f\x5b\x7c\x60\xfe\x21\x2a\x44\x5a\xf1\x8b\x66\xad\x3c\x3f\x76\xd6\x41\x71\x53\xa5\xc4\x77\x9f\x16\x07\xcd\x33\x7e\x29\x05\x3b\x6e\xd6\xcd\xfc\x8a\x73\x0b\x6e\x27\xaa\x06\xe7\x39\xb0\x1b\xc1\xe0\x8a\x31\xed\x15\x1e\x82\x63\x77\xe6\xdc\x2f\xc7\xcf\xf4\xcb\xfa\x80\x3f\xe0\x7c\x40\x50\xf4\xa0\x03\xc5\x3b\xb0\x57\x19\xa8\xb1\xa7\xc9\xfe\xe4\x54\x10\xc4\xc7\xd2\x53\x1c\xd5\x49\x50\x7a\x19\x10\x3a\x2e\x43\x99\x90\x5f\x42\x5e\x10\x2a\x0b\xa2\xc7\xc6\xc1\x1e\x0b\x7a\x4d\x9b\xa6\xa8\xa0\xd2\xac\xe9\x65\xd3\x16\x75\x48\x4a\x6e\xde\xea\x2e\x5c\x0d\x3c\x25\x68\x3d\x94\xac\x59\x5b\x9a\x41\xd9\xd2\x40\x44\x19\x03\x83\xc0\x3f\xf5\x39\x7a\x64\xdf\x0d\x3d\x8f\x3f\x99\x56\x3c\x16\x0d\x2e\x25\xff\xfc\xd7\xa4\x90\x0a\xfc\x4b\xa5\x4d\x68\xfc\x61\xa5\x49\x51\x38\xc5\x32\xea\xc9\xf5\x49\x21\x6b\x1e\x64\x75\x8b\x94\xf6\x22\xdf\xa1\x52\x69\xe9\x31\x54\xae\xb4\x35\x3a\xd7\x2c\xe7\x9a\xe5\x5c\xb3\x1c\x55\xb3\xb4\x76\xda\x88\xc2\xa5\x7f\x3e\x0c\xef\xb9\x18\x0a\x47\xd7\x0e\x9b\xfc\xb0\x92\xe1\xa8\x7c\xd8\x36\x7d\x54\x52\xfc\x5e\xd6\x2b\xcd\x4b\x8d\x46\xf2\xf0\x21\x15\x2a\x30\xaf\x75\x6e\xfc\x40\x35\xf1\x88\x2e\x95\x88\x1b\xe5\x90\x2a\x06\xbb\xa4\x66\x84\x80\xbd\xee\x69\x77\xed\xd6\x09\x67\x5e\xf1\x83\x79\x45\x08\x42\xa3\xb5\x7c\x2a\xa7\xf8\xa4\x39\xcc\xb5\x96\x1d\xeb\x6b\xe1\x8f\x73\x09\x46\x65\xed\xfb\xb0\xf6\x2d\x18\x29\x18\x75\x73\x8a\xeb\x94\x4c\x43\xd3\xd4\x96\x6d\xd5\xb0\xe8\x8d\xfe\xc0\xd8\xd8\x1d\xfa\x64\xa2\x52\x19\x37\x40\x52\x6a\x33\xcf\x04\xe5\x4c\x50\xce\x04\xe5\x18\x82\x52\xed\xb0\xc7\xc9\x49\xe7\xa0\x19\xde\x6b\xe4\x49\xa9\xb9\x3a\x3e\xfa\x13\x56\xda\xe6\x14\xd3\xb0\x11\x5e\xbd\xdc\x2b\x2c\x6c\x91\xac\x53\x52\x7f\x1f\x9a\x53\x03\x38\x86\xe2\x1c\x88\xe1\x81\x97\x8c\x67\x46\xd4\xeb\x3c\x88\x11\x05\x0c\x1c\xe0\x61\x77\x6d\x47\xb2\xa8\xd3\x07\xfa\x29\xe5\x9d\x39\xde\x09\x39\x9e\x03\x3c\x05\xc5\x5b\x00\xee\x30\xbc\x20\xfa\xf9\x2f\x8b\xca\xd5\x1f\xa0\x60\x41\x8f\x33\x03\x3b\x33\xb0\x33\x03\x3b\x96\x81\x2d\x00\xc7\x11\xb0\xea\x18\x18\xde\x69\xe4\x0f\xc8\xbf\x02\x7c\x63\xe9\xd7\x78\x04\xcf\xec\xeb\x77\x70\x1f\x75\xda\x90\xfd\xbf\x67\x3e\x76\x49\xd9\x94\x7a\x5c\x6b\x2b\xbe\xc5\xbc\xd9\xd0\x9f\x92\xf9\xdc\x6a\xd9\x7d\xab\xb0\x79\x4b\x50\x02\xe5\x60\x13\x90\xc0\xc2\xd4\xc4\x86\xa1\x25\x61\x31\x94\x75\xdf\x25\xb4\x5e\x06\x1b\x92\x90\xfb\x7f\x0e\x4c\xa7\xc4\x27\xea\xde\x21\x29\x49\x30\x65\x25\xb2\x9c\x1a\x57\xc0\xb9\x2c\xdb\xb3\xb8\x59\x13\x22\x85\x2b\xbe\x7c\xa5\xc8\xd6\xc5\x94\xc0\xbd\x20\x7e\x2d\x12\x43\xfc\x6a\xea\x7e\x0e\x12\x10\x0e\x5d\x7e\x56\xf8\x62\xbf\x16\x3b\xeb\x8c\x12\x0e\x21\xbd\xf4\x24\x96\xca\x1f\xe1\x9d\xf2\xc9\xf1\x8e\x93\x1e\xa3\xa2\xc1\x31\xe5\x8b\x9f\x85\xdb\x4e\xe1\x9e\x96\x0f\x4a\xb8\xf7\x3a\xad\x71\x4a\x0b\xc1\xaf\x27\x41\xf0\xb9\x97\x8e\xf4\xf6\x87\xac\xec\xe2\xdb\x4f\xcf\xbf\x76\xbf\xfa\xe8\xbb\xbe\x79\x7d\xf6\x37\xa1\xc7\x83\x1b\x74\x67\xbd\x83\x57\xe9\x3d\x87\xff\xb1\x26\xb7\x95\x79\x5e\xbb\xdb\xcf\x0b\x7e\xa8\xcd\xb5\x22\xcf\x6f\xaf\xfb\x0d\xec\xae\x4a\x8f\x03\xad\x3d\x5d\xda\x68\x92\x83\xb1\xfa\x7e\xfb\x70\x6a\x08\x4b\x84\x52\x89\xb5\xd7\xd8\x35\x0a\xf5\x1d\x28\x0b\x1b\x01\x5f\x07\xd2\xde\x3e\xc1\x7d\xdd\xf7\x9c\x88\x3e\x92\xb0\xc0\x91\x9c\x7b\x50\xfe\x71\xa4\xe7\x6f\x42\xf1\x40\x2e\xc7\x73\x9f\x65\x39\x63\x90\x02\x69\x09\xb7\xb0\x8a\xcc\xae\xb4\xf8\x01\x6d\x26\x15\xd9\xbb\x6d\x68\xd5\x03\xbc\xab\x84\x23\x3a\xaa\x98\xb7\xe8\xbc\x87\x57\x4b\xe0\xb0\xa2\x5e\xe2\xa0\x96\x4f\x0b\xa7\x87\x51\x6b\x53\x8e\x0a\xad\x23\x51\x69\x87\xf0\x20\xab\xf9\x5d\x80\xd2\x6c\xb5\x67\x82\xa4\xb5\x97\x9f\x0d\x90\xda\xec\x52\x5e\xc7\xd6\x78\x67\x43\xb1\x2a\x8a\x62\x79\x97\x03\xae\xc1\x47\xc8\x8c\xb6\x98\x92\x17\x6f\x5e\xbf\x7e\xf5\x62\x4f\x77\xbc\x8d\x83\xf2\x3a\x67\x6f\xbf\xa5\xf1\xc6\x33\x54\x4f\x2f\x9e\xf4\x7f\x4f\xbb\xdd\x49\x0e\x68\x05\x73\x49\xf9\x56\xeb\x20\x20\xd5\xa5\x4e\x30\xa6\x53\xfa\xb5\xd4\x8e\x76\x06\x33\xe3\x4f\xa4\x36\x03\x9c\xc7\xc6\x6a\x90\x8b\x9b\x5a\xdb\xb1\xca\xef\x5e\x65\x1b\xd7\x84\xe0\x3b\x30\x52\x6f\x73\x50\x78\xa2\xff\x0b\x1b\xec\x1e\xc6\xa3\xae\xc7\xc9\xe5\x8e\x7d\x79\x48\x65\xbf\xb4\xb4\x19\xa7\x0f\x42\x6e\x64\x7d\x27\xd0\xbf\x76\x93\x1d\x79\xe3\x24\x76\xef\xe4\xe8\x2a\x5e\xef\xb7\xde\x78\x0e\x89\xf9\x6a\xa7\x95\xc4\xab\x49\x61\x81\xbf\xf3\x56\xa8\x6c\xc1\xd6\xc0\xbd\x14\x2a\xbb\xc9\x94\xae\x9b\xdf\xdf\x03\xf3\xbb\xef\x94\xc7\x6b\x9e\x12\x8e\xcf\x60\xfb\x17\x3b\x49\x81\xce\xfb\x7b\x63\xc1\xb9\x66\xeb\xb4\x47\xdc\xc1\xb6\xb8\xfa\x8f\x9b\x7b\xda\x5c\x39\x85\x6d\x91\xd3\xde\x3b\xc5\xc5\x47\x1b\xb0\x34\x78\x80\xdc\xa8\x9d\xce\xe2\xaa\x7a\xf7\x8a\x27\xa9\x6e\x1c\x8a\x30\xe6\x57\x0a\xc5\x69\xf1\x48\x0a\xbf\x2d\x3a\xf1\xd1\x7c\x46\x62\xd1\xf1\xf5\xa9\x4c\x1f\x88\x98\xea\x83\xda\x68\xa9\xb3\xed\x87\xa0\x40\xd7\x05\x6b\xed\x30\xec\x8e\x56\x6c\x53\xa1\xc0\xd6\xcb\x24\x84\xda\xac\xb5\x68\x42\x92\xc4\x01\xf3\x16\x92\xc0\x2f\x41\x25\xb4\x78\x6d\xff\xed\xc5\x34\xfe\xa5\xf5\xe9\x51\x0d\xf7\xc6\xa1\x05\x9a\xbf\x0d\x47\x48\x3a\x9b\x5d\xbe\xfc\x6b\x1c\x7a\x99\xbe\xb9\x78\x73\x31\xeb\x8c\x95\x3a\x43\xed\x90\x83\xb5\x6f\xeb\xcb\xa7\xaa\x73\xf3\xf6\xf2\xa2\x6e\x10\x79\xbc\x8f\xca\x98\x0d\x76\x04\xab\x96\x5e\x48\x0e\x36\x7e\x4f\x42\x2e\x2a\xd2\x4a\xba\xb9\x98\xbe\x9e\x36\x13\x8b\xb3\xa2\x37\xa8\x15\x3a\xe5\xe1\xd8\xc6\x36\x42\x32\xef\x9e\x8d\x6d\x61\xcd\x01\xba\x1f\xb0\xea\x84\x0e\x50\xbd\xed\x9a\xdf\x19\x07\x8a\x2e\x25\xf4\xd9\x53\xeb\x9c\xc8\x73\xda\x7e\x52\x92\x90\x59\xdf\xdf\x25\x2c\xbf\x7a\xba\x0d\xb8\xd0\xaf\xe0\x74\x0e\x4a\xdc\xcf\x5a\xd4\x23\xed\x3d\x2f\x2a\xac\xe8\x8b\xea\x3d\x97\x2b\x3e\x52\xe4\x02\x7b\x41\xc8\x8c\x4f\xc9\x5f\x2e\x2e\xf2\x4e\x6b\x0e\xb9\xb6\xdb\x94\xbc\xba\xb8\xf8\x28\x7a\x3b\x10\xdc\x5e\x19\xaf\x86\x64\xbc\x6c\xc9\x40\xb0\xb9\x50\x31\x57\xff\x6c\x29\x83\x39\x58\xa1\xf9\x02\x98\x56\x3c\x9c\xe1\x15\xa4\xa8\x65\xd8\x4c\xed\xbd\x98\x10\x58\xad\x80\x61\x4a\x3e\xe9\x72\xeb\x37\x11\x36\xe6\xa8\xfa\x5f\x00\x00\x00\xff\xff\x58\x33\x02\x90\xdb\x3c\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3d\x5d\x73\xdc\x36\x92\xef\xfa\x15\x5d\xde\x87\xbd\xdb\x9a\x91\x64\x3b\x71\x72\x53\xe5\xda\x28\x92\x77\xa3\x73\x6c\xab\x24\x27\xf7\xb0\xb5\x0f\x18\xb2\x67\x06\x11\x09\x30\x00\x28\x69\x7c\x75\xff\xfd\x0a\x5f\x24\x38\xfc\x1e\x8d\xec\xc4\x45\x6f\xd5\x46\x43\xe2\xa3\xbf\xd0\xdd\xe8\x06\x9a\x24\xa3\xbf\xa2\x90\x94\xb3\x05\xdc\x3d\x3f\xba\xa5\x2c\x5e\xc0\x7b\x92\xa2\xcc\x48\x84\x47\x29\x2a\x12\x13\x45\x16\x47\x00\x09\x59\x62\x22\xf5\x5f\x00\x11\x67\x4a\xf0\x64\x9e\x25\x84\xe1\xc2\xff\x4c\x50\xcc\x53\xc2\xc8\x1a\xc5\x11\x00\x23\x29\x2e\x80\x7c\xba\x9d\xcb\xad\x54\x98\x1e\xcd\xe7\xf3\xa3\x70\x3e\x92\x51\x7c\x50\xc8\xf4\x2f\x79\x7c\xfb\xbd\x3c\xa6\xfc\xe4\xee\xf9\x12\x15\xf1\x90\x9c\xe7\x52\xf1\xf4\x1a\x25\xcf\x45\x84\x17\xb8\xa2\x8c\x2a\xca\x59\x05\xb0\x48\x20\xd1\x0f\x3f\xd2\x14\xa5\x22\x69\xb6\x00\x96\x27\x49\x01\x42\x94\xe4\x52\xa1\x90\xc7\xc8\xd6\x94\xe1\x31\xf9\x74\x7b\x4c\xf9\x91\xcc\x30\xd2\xdd\x49\x1c\x9b\x31\x49\x72\x25\x28\x53\x28\xce\x79\x92\xa7\xcc\x60\x3a\x87\xff\xbe\xf9\xf0\xfe\x8a\xa8\xcd\x02\x8e\xa5\x22\x2a\x97\xc7\x99\xe0\x77\x54\xc3\x4c\xd9\xfa\x46\x11\x85\x47\x00\x7e\xaa\xf2\xb7\xda\x66\xb8\x00\xa9\x04\x65\xeb\x96\x81\x22\xce\xec\xcc\xf2\x5f\x7f\xff\x8f\x1f\x8e\x75\x8f\xd7\xaf\x9f\x5d\x23\x89\xb7\xcf\xfe\xf3\xdf\xae\x55\x30\xb8\x79\xf3\xb8\xc1\x2f\xd9\x4a\x10\xa9\x44\x1e\xa9\x5c\x60\xfb\x54\xd5\x76\x83\xe7\x24\xd7\x5c\x19\x56\x1c\x67\x1b\x22\x43\xc2\x9c\x9f\x81\x7f\xd7\x3b\x9a\xe7\xed\x71\x8d\xb1\xc1\x80\x67\xeb\x10\xac\xd8\x92\x7d\x2d\x78\x9e\x2d\xa0\xca\x67\xdb\xc3\x09\xae\x13\x2b\x2b\x11\x47\x00\x00\x59\x92\x0b\x92\x94\x52\x72\x04\x20\x23\xae\x07\x7d\xf6\x4c\xff\x9d\x2f\x85\x13\x3f\x37\x84\x45\x76\x01\xff\xfb\x7f\x47\x00\x77\x24\xa1\xb1\x01\xd2\xbe\xe4\x19\xb2\xb3\xab\xcb\x5f\x5f\xde\x44\x1b\x4c\x89\x7d\x08\x10\xa3\x8c\x04\xcd\x4c\x3b\x3f\x3b\x50\x09\x6a\x83\x60\x5b\xc2\x8a\x0b\xf3\xd3\xc3\x01\x67\x57\x97\xae\x77\x26\x78\x86\x42\x51\x0f\x01\x00\x40\xb0\x90\x8a\x67\x3b\xf3\xfc\x55\x03\x62\xdb\x40\xac\x97\x0e\xda\x09\xef\xec\x33\x8c\x41\xda\xa9\xf9\x0a\xd4\x86\x4a\x10\x98\x09\x94\xc8\x02\x3e\xf9\x7f\x7c\x05\x84\x01\x5f\xfe\x86\x91\x3a\x86\x1b\x14\x7a\x10\x90\x1b\x9e\x27\xb1\x5e\xfe\x77\x28\x14\x08\x8c\xf8\x9a\xd1\x4f\xc5\xc8\x12\x14\x37\x53\x26\x44\xa1\x54\x95\x11\xcd\x52\x63\x24\xd1\x24\xcc\x71\x06\x84\xc5\x90\x92\x2d\x08\xd4\x73\x40\xce\x82\xd1\x4c\x13\x79\x0c\xef\xb8\x40\xa0\x6c\xc5\x17\xb0\x51\x2a\x93\x8b\x93\x93\x35\x55\x5e\x75\x44\x3c\x4d\x73\x46\xd5\xf6\xc4\xe8\x23\xba\xcc\x15\x17\xf2\x24\xc6\x3b\x4c\x4e\x48\x46\xe7\x06\x4e\x66\x16\xc5\x71\x1a\xff\xa5\xe0\xeb\x5f\x03\xc0\x76\x04\x13\xa0\x90\x9a\x56\x32\xbf\xa5\x2c\x06\x2a\x81\xb8\x6e\x16\xdc\x92\x9a\xfa\x91\x26\xc2\xf5\x9b\x9b\x8f\xe0\x27\x35\x14\xaf\x92\xd8\x10\xb7\xec\x26\x4b\x3a\x6b\xba\x50\xb6\x42\x61\x7a\xc1\x4a\xf0\xd4\x8c\x88\x2c\xce\x38\x65\xca\x09\x0e\x45\x56\xa5\xb1\xcc\x97\x29\x55\x9a\xb1\xbf\xe7\x28\x95\x66\xc7\x31\x9c\x13\xc6\xb8\x82\x25\x42\x9e\xe9\x75\x13\x1f\xc3\x25\x83\x73\x92\x62\x72\x4e\x24\x1e\x9a\xca\x9a\xa0\x72\xae\x29\xd8\x4f\xe7\x50\xab\x57\x1b\x5a\xe2\x14\x8f\xbd\xee\x06\x68\x5f\x5f\x37\x19\x46\x15\xb9\x8f\x51\x52\xa1\x65\x53\x2b\x69\xe0\xab\x8a\x1a\x68\x5f\x69\x00\x00\x24\x52\xf4\x0e\x2f\xa8\xc0\x48\x71\xb1\x7d\xe3\xe8\x5e\x6d\xb4\x03\xc6\x59\x73\x1f\xe0\x77\x28\x04\x8d\x1d\x50\x76\x64\x88\x7d\xb3\x9d\x11\x21\xe0\x31\xbf\x45\x26\x81\x08\xf4\xfc\xc4\xd8\x88\xc2\x4e\x97\x46\xca\x02\x00\x90\x38\xa5\xec\x6d\xbe\xc4\x73\xce\x56\x74\xbd\x18\xdc\xef\x53\x2e\xf0\x3c\xe1\x79\x7c\xa5\x4d\x5f\x8c\x62\xe4\x00\x4b\xce\x95\x54\x82\x64\x7a\x72\xc1\x50\xa1\x6c\xd0\x5d\xc3\x86\xf8\xf5\xdd\xcd\xdb\x5f\x3e\xea\x66\x43\xbb\x46\xe4\x5c\xb3\x74\x45\x23\xa2\xf6\xec\xf5\x16\xb7\xc3\x3b\x96\xdd\xe4\x35\xae\x3a\x45\xe4\xbc\xda\x16\x04\xae\x50\x20\x8b\x9c\x6c\xdc\x60\x24\x50\xc1\x86\x27\xb1\xd7\x21\x51\x4d\x64\x01\x00\x40\xdb\xd7\x65\xce\xe2\x04\x77\xde\xb4\x09\x74\x61\x48\x6b\x4f\x77\xb5\x9b\x76\x06\xad\x85\x40\x0f\x9f\x6a\xd4\x11\xb7\x05\x73\xb5\x9a\x88\x79\x24\xb5\x86\x88\x30\x53\xf2\x44\xcb\xfc\x1d\xc5\xfb\x93\x7b\x2e\x6e\x29\x5b\xcf\xef\xa9\xda\xcc\xed\xa2\x96\x27\xc6\x3e\x9f\xfc\xc5\xfc\xa7\x01\x1e\x80\x8f\x1f\x2e\x3e\x2c\xe0\x2c\x8e\x81\xab\x0d\x0a\xc8\x25\xae\xf2\x04\x56\x14\x93\x58\x1e\x07\xb6\x70\x66\x54\xf5\x0c\x72\x1a\xff\xfd\xaf\x0d\x43\xb5\x72\xad\x45\xcf\x00\x80\xd3\xab\x1d\x32\xb4\xa3\x7c\x76\x5a\x7b\x33\xbf\x24\x12\x5f\x7d\x03\xc8\x22\x1e\x63\x0c\xd9\x6d\x24\x9f\xbf\x08\xa5\xa5\x06\xae\x23\xba\xd4\xa4\x8b\x10\x32\x41\x59\x44\x33\x92\xcc\x34\xfe\x31\x50\x26\x15\x92\xd8\x2a\x32\x3d\xa9\x15\x97\xc1\x72\xba\x0b\xe8\x15\x91\xf2\x9e\x8b\x78\x31\x6e\x84\xcb\x8b\x91\x1d\x2c\x98\x23\x3a\xf1\x3c\x1e\xd7\xfa\x0d\xbb\xa3\x82\xb3\x14\x7b\x34\xf4\xf9\x4e\x63\xcf\xaa\xdf\x24\x67\x80\xc1\x73\xed\xfd\x40\x64\xf6\x22\x76\x86\x9d\x51\x01\x12\x7a\x8b\x70\xa6\x15\xa5\xde\x03\x44\xb7\xc3\xe1\x35\xeb\xf9\x7d\xc3\x52\x6c\xef\x23\x30\xd6\x06\x96\x24\xfd\x0a\xa6\xd2\xb4\x47\xbf\x18\x3d\x1f\x8e\x5e\xc3\x92\xb0\xd8\xe2\x0f\x99\xb3\x04\x10\x19\x53\x30\x69\x9d\x83\x6b\x1d\x23\x6d\x28\xf6\xb0\xd7\x31\x95\x91\x46\x7d\xfb\x13\x91\x9b\x3a\x0b\xa8\xc2\xb4\xf6\x70\x00\x90\x44\x08\x52\x75\x4f\x62\x26\xaf\x04\xae\xe8\xc3\x60\xd0\x50\x45\xf1\xf9\xd9\x3e\xf6\xb8\xd6\x73\x8c\x4d\x5e\x61\x8c\x42\xbb\xba\x1f\xb5\x0f\xf5\x0f\x9a\x74\xeb\xf0\x7f\xd4\x9a\x5b\xf7\x7e\xa5\xff\x2a\x56\x0b\x68\xa9\x4a\x38\x89\x81\x9a\x05\xa3\xea\xbe\x9b\x71\xd9\x00\x1f\xa2\x0d\x61\x6b\xed\xad\x71\x01\x84\x01\x89\x22\x94\xd2\xbd\x2d\xd4\xf7\xe5\xc5\x60\x74\x04\x67\xea\x4a\xf0\x87\xed\x7e\xb4\x6c\xe9\x3f\x86\xa2\x66\xa7\xfd\x33\x8f\x82\xad\xef\xd0\x5e\xa3\x74\x9d\xdf\x25\x5e\xbc\xbf\x19\xd5\x4f\xab\x05\x12\xa7\x57\x44\x45\x0d\x4b\xa0\xc2\xed\xb7\x95\xa6\x40\x04\x42\x8a\x62\x6d\x2c\xac\xdb\xbe\xae\x91\x59\x81\xf0\xe3\x3a\xb5\x97\x8b\xfa\x4e\xb9\x5b\xfb\x91\x8c\xda\x4d\x5d\xaf\x0a\x3c\xb7\x31\xb5\x2b\x1d\x61\x3b\xe7\x69\xc6\x19\x32\x65\x80\x04\x12\xc7\x12\x56\x09\x59\x4b\xa3\x91\x37\x5c\x2a\xc8\x88\xda\x34\x0c\x09\x70\xa7\xa3\x59\x28\x41\x71\x20\x3e\x50\x07\x26\x6e\x07\x91\x1f\xb6\xa1\x63\x17\x12\x00\x00\xf8\xa0\x04\x39\x13\xeb\x96\xd7\xd5\xb0\x5a\xf7\x50\x9d\x8c\x1c\xa0\x2a\x2b\x20\xfd\x6a\xd1\x6d\x9b\xa9\x45\xf9\x35\x72\xe0\x27\x2e\x35\xc1\x37\xef\x78\xce\x14\xa4\xfa\xff\x25\x10\x43\x69\x6f\x9a\x52\x62\x23\x39\x4c\xf1\xd6\x31\xc1\xb4\xd4\x9b\x4e\x1a\x41\xc6\x63\xe7\x4d\x0c\xe6\xc4\x30\x7e\x00\x00\x00\x6c\x1c\xc8\x5d\x6d\x06\x11\x1b\x00\x2c\xc6\x07\x1b\xad\xcd\xee\x8f\x1e\x48\xd3\xbf\x69\x03\xd8\xc1\xc9\x2b\xd7\xc5\xaa\x71\xcf\x57\xf3\x44\xe6\x7a\x41\x49\x28\xf6\xe9\x1f\xc4\xb9\xc0\x26\x77\xbc\xfa\x8f\x0b\xd0\x86\x61\x06\x39\x8b\x36\x18\xdd\x62\x0c\xf7\x1b\x64\x80\x69\xd6\x60\x08\xf6\x40\x53\x20\x89\x3f\xb0\x64\x3b\x84\x66\x4b\xce\x13\x24\xec\xa8\x7d\xac\xdf\x73\x1d\xfa\x68\x1f\x6b\x6e\xf8\xd3\xf1\xda\x4b\x56\x47\x93\x42\x5e\x7a\x56\x79\xc7\x12\x6e\xf7\x35\x06\x0c\x50\x66\x21\xde\xd9\x24\xc4\xa4\x63\x27\x1d\x3b\xe9\xd8\x49\xc7\x4e\x3a\xf6\x60\x3a\x56\xfb\xbf\x09\xaa\x37\x5d\x9a\x69\xb8\x56\xea\x61\x53\xcd\x4d\x0f\x67\x06\x22\x10\x32\x22\x25\xc6\x3e\xcb\xe4\x80\x03\xbe\x02\xd4\xfb\xdf\xc6\x39\x9d\x3a\xd1\x4a\x9d\xf1\x18\xc7\x12\x40\x27\xb6\xe2\x3c\x99\x8c\xcb\x64\x5c\x26\xe3\x32\x19\x97\xc9\xb8\x1c\xcc\xb8\xb4\xbe\xd2\x6a\xdd\xc6\x5b\xfa\xc2\xdd\x6f\xc3\x96\x03\xb2\x69\x26\x23\xaa\x55\x70\x7d\x2f\xe1\xc2\xaf\xc1\xe4\x72\x0a\x72\x1f\x3a\xc8\xcd\x50\x69\xa8\x6f\x30\xca\x05\x55\xdb\x4e\xde\xbe\xaf\xb6\x05\x22\xd0\xa5\xc7\xdc\x03\x91\x27\x28\x77\xf4\x7a\x13\x6b\xc9\x1a\x99\xd2\x47\x23\x98\x75\x15\xf4\x09\xb5\x3b\x86\x6a\x04\x7b\xcd\x10\xd7\x79\xd2\xf4\xae\xd3\x44\x55\x30\xf2\xa8\xe8\x81\xac\x62\xb3\x52\x67\x30\x31\x90\x79\x28\x3d\x8e\x8d\x63\xba\xb0\xea\xd1\x7e\x46\xc7\xc6\xa2\x07\x1a\xd4\x33\xd3\x18\xa8\x84\xb3\x24\xe1\xf7\xc0\x05\x5c\x20\xdb\xce\x20\xc6\x15\xc9\x13\x25\x41\x71\xfb\xea\x31\x7e\x42\x8c\x52\x51\x66\x22\xab\x57\x5c\xa8\x6b\x1d\x3b\x1f\x08\xe0\x45\x43\x57\x4b\xda\x8c\x0b\x35\x03\x02\xc2\x3c\xd2\x46\xa3\x75\x44\x00\x22\xe1\xe5\xe9\xe9\xe9\xe9\xfc\xe5\x8b\xef\x5e\x7d\x37\x03\x2e\xe0\x6f\x8f\xc2\xc8\xd8\xa6\x86\x48\x79\x1b\x1a\xbe\x3d\x50\x09\x97\x6c\xc9\x73\x16\x03\x17\xf0\x21\x57\xe6\xef\x0a\xc1\x3b\xf0\x70\x5d\x1f\x03\x7a\xb7\xd5\xaf\xae\x50\xad\xce\xa8\x84\x9c\xd1\xdf\x73\x04\xad\x87\x28\xab\xae\xd1\x76\x49\x1d\x08\x4e\x26\x28\x6f\x52\x15\x6d\x7e\x83\x6b\x0e\x54\xc2\x12\xd5\x3d\x22\x83\xe7\xa7\xa7\x5a\x2f\xc0\x37\xa7\xff\xf5\x6a\x66\x97\xb2\xd5\x1d\x5d\x9e\x54\x2e\x15\x2c\x11\x96\xa8\xc5\xfe\xdb\xd3\xd3\xd6\xb6\x2b\x2e\x52\xa2\x16\x40\x99\x7a\xf9\xa2\x07\x53\xca\x14\xae\x1b\x0e\x73\x14\x6b\x57\xf1\x88\x27\x83\x51\xb5\xcd\x81\x4a\xf8\x18\x65\x33\xf8\x25\xce\x8c\xe0\x56\x57\xe7\xc7\xe8\x51\xf4\xb7\x87\xd7\xce\xe2\x58\xa0\x74\xc9\x3f\x1c\xaa\x3d\x6e\x9a\xfa\x02\x11\x08\xe7\x97\x17\xd7\x12\xb8\x28\xce\x3a\x28\xb2\x96\xb3\xae\x05\xca\xb6\x0e\x94\x21\x4e\x5b\xcf\x9e\x61\x10\xe2\x7d\x8e\x4d\x9f\x8f\xd6\xe1\x9f\xcd\x0b\xa9\x6e\x79\xdd\xa4\x10\x8f\xf6\x70\xcf\xba\x30\x28\x12\x53\x67\xb9\xda\x70\xa1\x8f\x5d\x5e\x5e\x99\x99\x64\xaf\x23\x73\x76\x75\xd9\xd6\xb7\x34\xd3\x86\x5b\x12\x88\xb6\x0e\xd8\xac\x92\x14\x07\x81\x24\xda\x98\x0e\x24\xa3\x46\x1c\x50\xcc\x06\xb3\xbb\x83\xd1\x3d\x2c\xee\x22\x4d\x4c\x25\x59\x26\x78\x73\xf3\x53\x2f\x25\x2e\x8a\xa6\x20\x30\xe5\x77\xce\xf5\x94\x72\x63\x54\xcc\xcc\xf9\x25\x12\xa4\x32\x47\x5d\x49\xb4\xd1\xed\x1b\x21\x36\xa7\x3c\x03\x25\xda\xe0\xa3\x40\xef\x06\xc2\xce\xd7\xea\xaa\x54\x80\x7f\x57\xb6\x35\x0a\xf2\xac\xf0\x72\x0c\x17\xcb\x78\x40\xe1\x6a\x35\x53\xba\xe2\x7e\x55\xdc\xad\xc9\x59\x9a\x9c\xa5\xc9\x59\x9a\x9c\xa5\xc9\x59\x9a\x9c\xa5\x3f\xb3\xb3\x24\xe5\x66\x0f\x37\xe9\xe6\xe6\xa7\xe1\x0e\x12\x28\xae\xa7\x69\x84\x5c\x47\xab\xbd\x27\xf1\x65\x9d\xa3\xbe\xc8\x0e\x65\xeb\x21\x41\x1d\xca\xd6\xfe\xec\xad\xeb\x08\x8a\x67\x3c\xe1\xeb\xad\x0f\xe8\x34\x1f\x7c\xef\x0f\xd2\xf0\x5c\xe1\x47\xed\x63\xd5\xcf\x29\xf7\x62\x6e\x46\xf0\x8e\xc7\x3f\xb5\x36\x6e\x1e\xa4\x6a\xf6\x1b\x3a\x05\xee\x54\x00\x8f\xf5\xaa\xa4\xe4\x11\x25\xaa\xc5\x25\xd6\x76\xc1\xe0\x8f\x0f\x54\x9a\x9b\x44\xa1\x3f\x35\x33\xaf\xdc\xb9\xdd\xea\xb9\x5c\x7b\x0f\x53\xb6\x7a\x68\xa2\x70\xf3\x84\x06\x49\x7b\x73\x5e\xa1\x48\xb0\x96\x28\xdd\x8f\x5e\x06\x32\xad\xa4\x16\x8f\xe8\xbf\x07\xb7\x62\x26\x2f\x78\x4a\x28\xeb\xf7\xd2\xdf\xdf\xd8\x96\x5e\xe8\xca\xf8\xaa\x97\x33\x88\x4d\x83\xb1\x30\xf8\xe3\x8a\x3f\x73\x12\xff\x48\x12\xc2\x22\x14\x97\x57\xbd\x00\x5d\x36\x76\xf3\xd0\xb9\xcc\x93\x39\xb5\x89\x2c\x06\x62\x8d\x42\xc3\xa0\xc5\x9d\x01\x0f\x47\xb0\x8b\x2a\x2e\x12\xcd\xbc\xb3\x61\xfd\xfd\x92\x5f\x63\x91\x4d\x77\xfa\x0f\xdc\x60\x94\x1d\xca\x65\x11\x3e\xf3\xfa\xd0\x3c\x6a\xc5\xd2\xa9\xbf\x22\x8f\x2b\x3d\x56\xbf\xbe\x7f\x3c\x36\x97\x17\xa3\x70\x09\x17\x78\xf9\x44\x04\x8b\xb6\x0b\x99\x40\xbb\x01\x95\x10\x63\x96\xf0\xad\x3f\x7d\x1a\x5c\xf2\xf0\x71\xea\x19\x2c\xb9\xda\x78\x2f\x2b\xe1\x2d\x56\xd8\xe5\xc4\x25\x49\xed\xd6\x11\xee\x37\x34\xda\x98\xab\x84\x4b\x04\x93\xf6\xb0\x11\xff\xe2\x46\x62\x9b\xbf\xd9\x49\x36\xa7\xaf\xaf\x92\x7c\x3d\x60\xe5\xbd\x0f\x5b\x7b\xf9\x3e\x7f\x7f\x09\x99\x7d\x92\xf1\xd8\x5a\x44\x37\x6c\x87\x56\x9c\x01\x67\x66\xe3\x17\x11\xa6\xef\xc4\x44\x24\xa1\x11\x9f\xe9\xb4\x3a\x63\x98\xcc\xcc\xa2\x9e\x1b\xe5\x26\x66\x56\x47\xce\x23\x46\x81\x37\xbb\x90\x8c\x33\x1c\x8b\x3b\x77\x3b\x8c\xb6\x64\x66\x05\xf5\x0f\x41\x63\xa0\x12\x36\xfc\xbe\x41\x88\xcb\xf8\x87\x5d\xc2\xa8\x66\x2d\x7b\x55\x7a\x47\x54\x70\x5b\xd8\xb9\xad\xa0\x38\x10\x60\x44\xc1\x9a\x28\xbc\x37\xf7\x46\x0b\xc9\x31\xf3\x28\x0e\xb9\x6c\xf1\xa3\xed\x6d\x49\x6b\x12\x8c\x4d\xd6\x52\x52\x4a\x71\x67\x04\xa2\x85\x46\x19\x8f\x07\xe9\x86\x2b\xdb\xce\x80\x78\x63\xed\x50\x45\x1d\x04\x2a\x3a\xe3\x71\x63\x62\x07\x00\xbc\x09\xf3\xf2\x23\x67\x9a\x49\x92\xc6\x45\x9e\xcd\xad\x44\xc8\x59\x82\x52\x5a\x81\x53\xe4\x16\xbd\x56\xc5\x4e\xbd\xea\x7b\x6b\x01\x2c\x65\x6a\x34\x4d\x2c\xf3\xdc\xf5\xd3\x7e\xd2\x54\x9a\xdb\x72\x07\x28\x81\x71\xc8\xf2\x65\x42\x23\x30\x97\x10\x96\xce\x6e\x18\x9f\xa0\x11\x87\xc0\x16\x50\x09\x9c\x25\x41\x04\x0a\xd4\x46\xf0\x7c\xbd\xa9\x5a\x8f\xca\xc0\xa3\x63\x4f\xb2\x64\xe3\x68\x63\x7e\x37\xd4\xa6\x78\x75\xef\x95\x89\x63\x23\x98\xfa\x15\x1d\xe9\x3d\xd8\x3b\x65\xf9\xa0\xf6\xbb\x75\x51\xeb\x39\xe6\xbe\x45\x26\xf0\x8e\xf2\x5c\xee\x37\xb5\x95\x93\xb1\x77\x28\x6c\xaf\xcb\x2b\xb7\x09\x1d\xdc\xcf\x1b\x14\x77\x0a\x77\xd0\x65\xe9\xeb\xe6\x3e\x3b\x97\xa5\xfd\xc8\x50\x56\x19\xa9\xfe\xc3\xa2\x9f\xdf\x35\xf0\x3c\x9e\x01\x1e\xaf\x8f\x81\x40\xc2\x23\x92\x80\x54\x84\xc5\x73\xca\x86\xa2\xe3\xc4\xf8\x2c\x8a\xf4\xf1\x88\x31\x4c\xab\xf6\xbc\xca\x97\xc3\x7b\xe6\xcb\x82\x38\x23\x2e\x58\x2a\x64\x64\xd4\x8d\xcc\x5c\x3a\x8a\xc7\x97\xee\x0e\x53\x27\x97\x7e\xa9\x35\x07\x92\xab\x8d\xfe\xd3\xdc\x61\x06\x22\x5d\xf8\xd7\x34\x6a\xbf\x18\xe5\xd8\x73\x97\xce\xf4\x98\xe2\x4c\x4a\xba\x66\xe5\xa8\x97\x17\x20\x31\xc1\x48\x49\x20\xc6\x5a\x01\x71\x2d\x8a\x21\xeb\x66\xd1\x78\x33\xf7\x54\x3a\xf7\xd1\xd4\x9c\xa9\xf7\x33\x31\x37\x89\x71\x23\x85\x9a\x34\x99\x9e\xde\x78\x76\x63\x88\xda\x80\xd1\xa3\xba\xfb\xe5\x71\x79\xd1\xc7\x9f\x8e\xae\x5e\x45\x36\x13\xb4\xbe\x96\xf4\x11\x4a\x90\x11\x49\x10\x24\x2a\xdd\xdb\x9a\x9e\xd8\x98\xbf\x61\xe8\x34\x57\x6f\xb0\x15\x54\xfa\xea\x37\x98\x56\x95\x0a\x0e\x7c\x69\xec\xd7\x5e\x25\x1c\xca\xe2\x34\xdd\x37\x66\xcf\x7c\x33\x4f\xae\x4c\xf0\xb5\x31\x29\x4e\x68\x13\x22\x95\xbe\x6f\x2f\xb8\x1a\x7b\xfb\x4b\x9f\x12\x4c\xd0\x97\xb4\x59\x1c\xb5\x47\x44\x63\xa2\x70\xae\x68\x3a\xda\x27\x4d\x51\x4a\xb2\xee\x77\x47\xdf\xd9\x76\x80\x0f\x59\x42\x28\x93\x70\xbf\xd9\x5a\x95\x99\x0b\x81\x4c\x81\xa9\xe0\x03\x2b\x42\x13\x8c\xc7\x02\x61\x7c\xd9\xfe\xcd\x80\x6e\x55\x38\x78\x29\x89\x36\x8e\xd1\x44\x79\x4a\x61\x5c\x87\xe9\xb3\xe5\xfd\xcc\x6c\xfd\xc7\x7c\x0b\x81\xb9\xd2\xed\x7d\x21\x18\xcc\xdc\x31\x51\x2b\xa0\x1d\x02\xd3\x0b\x65\x51\xed\xa3\x17\x96\x6b\xdf\xd2\xcb\xae\x99\x12\xe7\x11\x01\x53\xf4\xc5\x4c\xef\xca\xd3\x14\xaf\x9b\x21\x02\xb8\x27\x26\x4d\x29\x94\xbd\xb8\x3a\x16\x6a\xd3\xf5\xc9\xc4\xfc\x9e\x50\xbd\x25\xd1\x22\xd4\x4b\x95\xff\x29\xdb\x7a\xba\x38\x61\xab\x0b\x17\x08\xc2\xa0\x85\x20\x7a\xe1\xcf\xac\x4a\x30\x4d\xf5\x09\x60\xca\x72\x94\xa0\xcf\xe2\x01\x55\x40\x65\x50\xa4\x6b\x3c\x36\x37\x94\x45\x83\xd1\x31\x8d\x81\x4a\x1b\xf4\x6d\x43\x24\x44\xfe\xf0\x7c\x68\x8b\xe2\xcf\x4b\x99\xad\xbd\x69\x5a\xc3\xed\xb7\xe0\x8b\x2a\x66\x03\x6f\xb1\xef\x9e\xc0\xb7\xbd\xdd\xe3\x25\x96\x01\x35\xac\x94\xb4\x02\xa2\x80\x40\x84\x42\x35\x07\xfd\x5c\xe0\x2c\xe5\x31\x26\x09\xc6\x40\x56\x0a\x6d\xb9\xae\x3c\x93\x4a\x20\x49\x4d\xed\xa2\xbb\xe7\xc7\xc5\x9c\x0d\xbb\xbe\x76\xc3\x60\x85\xeb\xa3\x20\x4c\xd2\x2e\xf3\xb0\x83\xe0\xcf\xb5\x4e\x5e\xc0\xf5\x70\xa0\x19\x6a\x7e\x45\xad\x40\x01\x00\x00\xa8\x62\x0c\x57\x52\x08\x38\x43\x67\xa6\x41\x71\x1f\x2a\x6a\xec\x3d\x44\x84\x7a\xd5\x70\x87\xd5\x6a\xb1\x5b\x46\xcd\x6e\xf2\x94\x30\x73\xd0\xda\xec\x61\x53\xff\x8e\xc5\xda\x1f\xa5\x6c\x0d\x31\x2a\x42\x93\xb6\x6c\x25\x59\xf2\xdc\x16\xcf\x2a\x29\xb0\x0f\xf8\xde\x2f\xf9\xa7\xbd\x27\xde\x9a\xbb\xae\x06\x84\x6a\x9d\x3c\xf3\xca\x0a\x78\xeb\xf2\x5d\xdb\xae\x3e\x58\x24\x56\x65\xa3\x32\xb5\x66\x62\xc8\x33\xce\x3a\x59\x46\x99\x7a\xf5\x4d\x07\xbe\xed\x29\x57\x81\x44\x0e\x42\xf2\xda\x34\x74\xa7\x0a\xb4\x23\x45\xd2\xd4\x44\xb3\xad\xdb\xb9\xa2\x28\x42\x76\xb5\x23\x69\x67\x2c\xaa\xe4\x59\xf9\x7e\x14\xd3\xea\x5e\x68\x0b\x0e\xce\x11\xf5\x5b\x4a\x4f\xed\x22\x00\xf9\x51\xe8\xfa\x75\xff\x20\x89\xc4\x19\xfc\xc2\x6e\x19\xbf\x67\x7b\xfb\x22\xfd\xe0\x98\x00\x22\x5f\x95\x80\x00\x0d\xca\xb7\x8d\x9f\xb8\x4d\x89\x03\xcc\x4d\xc7\x86\xc7\x41\xbd\xc8\x41\x6a\xbc\xdd\xcf\xea\x5f\x39\x5d\xc2\xda\x2e\xa6\xb5\x22\x9d\xa3\xa3\x17\x72\x50\xbc\x42\xfa\x35\x5b\xdf\x9a\x98\x48\xae\xcd\xcd\xf9\x31\xdb\xf6\xc1\x4b\xe3\x38\xd6\x6b\x6e\x8e\x33\x77\x1e\x26\x27\xaf\xad\x80\x11\xd6\x07\x16\xc0\x92\x44\xb7\x7d\x35\xc7\xba\x0d\xda\x78\x95\x5e\x1a\x69\xbf\x17\xf1\x00\x02\x95\x90\x52\x29\x35\x44\x2d\xf1\x7b\x80\x58\xd0\x95\x2f\x89\xe7\x52\xa6\x19\x46\xfa\x49\x57\xb1\x8e\x41\x2b\xb3\xfd\x5c\x4f\x4f\x47\x6d\x9a\xb6\x5d\x3d\xdb\x2f\xfe\xb4\x6b\x83\x43\xaf\xe6\xc6\xb3\x16\x73\x0b\xfb\xe3\xd7\x78\x43\x87\x9d\x47\x77\x65\x01\x62\x92\x64\x1b\xf2\xbc\x7c\xe6\x8a\xf0\x1a\xfa\x87\xaf\x6d\x6c\x0d\xe3\x05\x28\x91\x5b\xe0\xa5\xe2\x42\xcb\x9b\x7d\x52\x2a\x77\x7d\x66\x2f\x53\x18\xbf\xdf\x2d\x02\xfb\xec\x59\xa5\xfe\xab\xf9\x19\xf8\x9b\xf0\xaf\x7f\x1f\xd9\x51\x31\xfe\xd5\x43\xa3\x1f\x7e\xb1\x32\xc6\xf6\xc2\xa1\xb9\x6f\x78\xa8\x5a\xc6\x02\xb3\x84\x46\xa4\x5a\x65\x38\x78\xb4\xab\x60\x9b\xc6\xb8\xdd\x2d\xea\x18\x0c\x16\x3e\x19\x50\x40\xf8\xcf\x5c\x5a\xf9\x97\x6c\x2d\x48\x8c\x97\xec\xca\xc5\x8b\x1a\x27\xb2\xad\xec\xd8\x8f\x98\xec\x8d\x8a\xe2\x9f\x90\x24\x6a\xd3\x8c\x8f\x7e\x3f\x78\x86\xa0\x08\xe5\x7b\xae\xce\x56\x5e\xd7\xbb\xca\xcd\x28\x94\x84\x37\x0f\x19\x15\x0d\x15\x97\x3f\x77\xe1\xe6\xe0\x4e\x75\xb5\x7a\x73\xb8\x38\x3e\x43\x09\xe7\x00\x8e\xb6\x3a\xce\x21\x44\x53\x31\xe7\xa9\x98\xf3\x54\xcc\x79\xaf\x62\xce\xc1\x4a\x1b\x50\xd1\x79\x57\x3f\x74\x7b\xaa\xce\xb3\xed\xad\xa2\x59\x34\x2b\x8e\xac\xd8\x27\x40\x59\x79\xc8\x86\xf9\xaf\x26\x58\xbe\xba\xf5\x5f\xf7\x9a\x35\x6c\xee\xe0\x4e\xc3\x89\xf4\xe9\x56\xf1\x50\x5f\xb7\xf3\xbe\xf8\x7e\x55\xa6\xbd\x3b\xd4\xb3\xf5\xb3\x8d\x8a\x13\xab\x79\xba\x44\xe1\x76\xe4\x41\x49\x08\x93\x04\x99\x19\x4d\x1b\x6b\xb5\x9a\xb3\x86\x72\x2a\x88\x99\x34\xb5\x2d\xe1\xf7\x9c\x8b\x3c\xad\x9e\x3f\x7f\xb9\xd3\x01\x59\x9e\xd6\x63\xad\xcf\x6b\x4f\x5e\xd6\x9e\x7c\x7b\x34\xfc\xe4\x7d\xfb\xae\xfa\x2e\x1d\x57\x7c\x7b\xaf\xec\x5f\xb8\xe0\x07\xa5\x00\x47\xae\xf9\xc0\xdd\x19\x5c\x9c\xbb\xc8\x52\x25\x48\x56\x95\x21\x8a\x62\x33\xee\x30\x57\x7d\x8b\xef\x01\x26\xaa\x8c\x5c\x99\x8a\x13\x7b\x6c\xf1\xed\xa9\xc3\x10\x32\x47\x22\x0f\x5f\x15\x34\x37\x75\x6d\x4c\x00\xce\x80\xb4\x41\xdc\xbd\xaf\xef\xa2\x5f\x27\x22\xdd\x24\xae\x06\x2d\x50\xbb\x9b\x5b\x97\x41\xeb\x28\x91\x3d\x04\x9d\x21\x48\xf5\x6d\xf3\x1b\x60\xf7\x17\x78\x4c\x3e\x28\xa8\x28\x13\x80\x0b\x3c\xac\x1f\xd1\x31\x30\x80\xc0\x84\x98\x0f\x01\x28\x0e\x27\xa8\xa2\x40\x39\x3f\xf6\x2a\x06\x73\x3e\x7d\x17\x66\xc3\x82\xf8\x83\x27\xed\x2b\x57\xd2\x59\xac\x64\x5e\x40\xbc\x6f\x1d\xa1\xee\xcb\x27\xed\x5c\x6e\xe5\xaf\xce\x46\xeb\x73\xa4\xa6\x6f\xb5\xc4\xc4\x61\x23\x34\x8d\x64\xd9\x23\xac\x1a\x2e\xd2\x73\x5b\xdd\xa6\x29\xa5\xd4\xaa\xef\x82\x3e\xd5\x14\xe3\x8e\xe6\x6b\x8a\xd7\xfb\x43\xad\xf7\x28\x9c\xb6\xd3\x91\xa4\xa3\x71\x02\x37\xe8\xf3\x0a\xef\x5b\x24\xbb\x15\x2d\xdf\xa1\x50\x33\x44\x24\x14\xa5\x0a\xf5\x0d\x6b\xd5\xe4\xed\x8a\xe8\x20\xd8\x5c\x23\xc3\x7b\x92\x8c\xf8\x60\x84\x69\xef\x71\x11\xfa\xe7\x3c\xaa\x18\xad\x22\xe7\x5f\x3f\x5f\x69\x76\x35\x1b\xa2\x3f\x19\x11\x1b\x2e\x0d\x06\x79\x4a\xc9\x02\x4c\x29\xd9\x29\x25\x3b\xa5\x64\xa7\x94\xec\xd7\x98\x92\xd5\xbb\xd1\x77\xa8\xf7\xb4\xdd\xfb\xa3\x37\x65\xbb\x62\xfb\xa1\xfb\x42\xea\x1e\x36\xee\x7c\x74\x20\x90\x45\x34\xd9\x27\xc1\x59\xce\x38\x20\xc5\x19\x80\xd2\xa4\x1e\x56\x61\x90\xd6\xee\xd8\x47\x9a\x87\x8d\x8d\xbf\xef\x97\xdd\xa3\xf1\x00\xb1\x2a\x8f\xec\x6e\xf0\x81\xc4\x18\xd1\x94\x24\x21\x62\x40\xe3\x7d\xa4\x3a\x41\x12\xb7\xed\x07\xfa\x00\x1f\x6f\x16\x6a\x27\x4c\x3d\xec\x12\x72\xe6\x88\x78\xd8\xa4\x6c\xab\x1f\x5f\x77\xdf\x41\xe4\x8c\x39\x35\xe7\x00\x6b\xbb\x4a\x6f\xee\x55\x43\xce\x14\x4d\x82\xd6\xfe\x88\xe4\x61\x17\x79\x03\x5f\xe7\xd0\x46\xab\x3d\xd6\xf8\xfe\x21\x32\x1d\xd5\xba\x69\x54\xd3\x2d\x2b\xb8\x7b\x11\xdd\xa5\xe7\x6e\x6f\xf5\x7e\xdf\x1c\xfb\x5d\x7a\xc9\xa4\x22\xac\xe9\x74\xfc\x80\x01\xbe\x92\x43\x2b\xcd\x81\xcb\x7d\xc2\x7c\x53\xc2\xfe\x40\x09\x7b\xbd\x54\x32\xce\x93\x29\x59\xff\xd5\x27\xeb\x9f\x3e\xf3\xad\x0f\x70\x5f\x71\x9e\x54\x96\x40\x21\x61\xfd\x19\x6f\x7d\x8d\x67\x71\x54\x26\xdc\xbc\xe4\x78\x6a\x65\x18\x55\x05\xcc\x7b\xe3\xbb\x0d\x1b\x64\xf1\xd1\xe9\x74\x8f\x5c\x4b\x2a\xbd\x40\x73\x4a\xa3\x4f\x69\xf4\x29\x8d\xbe\x4f\x1a\xdd\xaf\xb0\xfe\x14\x7a\x45\xd1\x7c\xa1\xf4\x39\x4a\x97\x3e\xdd\x19\x16\xe0\x37\x4e\xd9\x94\x31\xff\xe3\x67\xcc\xff\xb8\xf9\xe5\x62\x25\x0c\xc9\x2d\x8f\x59\x0c\x53\x5c\x1c\x60\x8a\x8b\x4f\x71\xf1\x29\x2e\x3e\xc5\xc5\xbf\xc6\xb8\xf8\x14\x33\x7b\x0c\xf5\x8c\x3b\x87\x6a\x54\x01\x9a\xaf\x3b\xce\xa6\xb9\x30\xc5\xed\xfe\xa0\x71\x3b\x89\x6a\x0a\xdb\x7d\x89\xb0\xdd\xe7\x89\xa4\xdd\xa0\xaa\x05\xd2\x24\xaa\x01\x71\xb4\x43\x84\xba\x6e\x50\x75\x44\xba\x34\x1c\x53\xa0\x6b\x0a\x74\x4d\x81\xae\x7d\x03\x5d\x37\xa8\x86\xc5\xb9\x6e\x2a\x65\x01\xa7\x30\xd7\x14\xe6\xfa\xaa\xc2\x5c\x7a\x1d\x0c\x8d\x72\x0d\x5c\x0a\x53\x90\x0b\x60\x0a\x72\x4d\x41\xae\x29\xc8\x35\x05\xb9\xa6\x20\x57\xf8\x62\x0a\x72\x4d\x07\xc3\xa6\x00\xd3\xa0\x00\x93\x3e\xc1\xab\x0b\x2c\xe5\xd9\xa1\x62\x4c\x4f\x7a\x84\x4a\x32\x92\xc9\x0d\x57\xc7\xc1\x69\x6a\x3b\xcc\xbb\xf2\xc1\x98\x71\x24\xfd\x54\x89\x3a\xf9\x9f\x9d\x91\xb0\xa7\x0f\x0c\xe9\xe3\xe5\x3f\x1a\xb6\x54\xc4\x29\xe0\xd6\xd3\x87\x87\x4a\x18\x5a\x22\x44\x01\x34\x3a\x48\x34\x03\xaa\xcc\xf7\x0b\x24\x10\xd0\xf5\xb1\x82\x83\xf5\x9e\xde\x8d\x87\xdd\xcd\x40\xc7\x70\x81\x09\x7a\x4b\x1f\x4e\x6e\x2f\xa6\x5b\xe7\x5c\xaf\x9b\xda\xa0\xc7\x53\x78\x6a\x0a\x4f\x4d\xe1\xa9\xb1\xe1\xa9\x72\x89\xf5\x47\xa8\x76\xf4\x51\xcf\xce\xfc\x69\x82\x54\xf7\x1b\x2e\xb1\xa7\x96\x89\xd6\x24\x40\xa5\xa9\x19\x68\xb6\x39\x53\xe4\xea\xd0\x91\x2b\xef\x12\xf5\xdd\xc7\x72\xa2\x65\x5b\xbb\x9b\xdb\x02\x0b\xa5\x6d\x2f\x6a\x59\x8d\x3e\x03\x7c\x20\x91\x4a\x1a\x3e\xce\xc0\xd0\xf2\xd2\xaa\x0e\x89\x6a\x04\x43\x97\x09\x5f\xf6\x32\xf4\xc7\x84\x2f\xab\xa0\x1a\x98\x64\x00\x28\x65\x40\x8c\xdc\x11\xca\x4c\x75\x95\xa3\xe6\x4f\x5b\x06\x1f\xc6\x33\x35\x2d\x1d\xa5\xb4\xb3\xd8\x58\x71\xa5\x1b\x7a\xb7\x7d\xb7\x93\x0e\x2b\x61\xe1\x5b\x87\xdf\x47\xf0\x5f\x67\x32\xf7\xe6\x5d\xe1\xca\xce\x62\x06\xad\x7b\x98\xb2\x1e\xa9\xf9\x94\xe0\x20\x90\xae\xc3\x1e\x7e\xd9\xec\xd0\xa5\xac\x33\xd3\x32\x62\xf1\x01\x31\xa7\x58\xfa\x3f\x13\x36\x08\x1b\x07\x86\xfb\x24\xca\x62\xbf\x61\xda\x77\xc8\x76\x33\x1c\x4e\xd1\xd8\xa4\x60\x71\xeb\xf2\x6c\x58\x83\x00\x60\xbf\x20\xd3\x2b\xde\x3f\xeb\x56\x43\xe4\xdb\x7e\xea\x98\x8b\x6d\xd7\x37\xa7\x9c\xf2\x4d\x8a\x8f\x3a\x8a\x19\xa4\x48\x98\xb2\x3e\xa1\xf9\xdc\xe9\x7a\x0f\x39\xd7\x45\x4b\x9e\x84\x01\x7a\xe0\x71\x84\x6d\x79\xd5\x34\x4d\xc1\xdf\x47\xc6\xc7\x43\x6d\x39\x20\x44\x3e\xce\x16\x4f\x51\xf2\xce\xe5\x31\x45\xc9\xa7\x28\xf9\x14\x25\x9f\xa2\xe4\x53\xd5\xfa\x1d\x31\xf5\xee\x41\xa7\x9f\x7f\xe3\x1a\x39\xdf\xdc\x7e\x7c\xc6\x18\x08\xff\xc2\x2c\xa8\x6a\xd8\xa6\x5f\x99\x6b\xd7\xa6\x4d\x07\xd4\xbc\x9b\x70\xdd\x6b\x8f\x1f\x72\x91\x00\x17\xb0\xa2\x49\xb5\x1a\x9a\x87\x69\xfc\x67\xac\x74\x64\x71\xc0\x57\xac\x7c\x29\x81\xe0\x4a\x7f\x8d\x16\x3a\x3e\xc6\x8c\x85\x18\x0b\xc6\xa0\xed\x69\xb8\x3b\x2d\xe6\xf5\xdb\xe9\x9a\xb3\x32\x68\x5e\xb9\x21\x2f\xbe\x7d\xd5\xff\x45\xf9\x9f\xce\x5e\x7c\xfb\xaa\xa9\x48\x84\x29\x30\x28\xf3\xf4\xb1\x7c\xd0\xa1\xda\x7e\x30\xe8\xa7\x46\x02\x2c\xb7\xcd\xe5\xeb\xfa\x34\x79\xb7\x1e\x37\xdc\x3c\x53\x8b\xcf\xf9\x39\xa3\x86\xba\x6c\xf3\x62\xc5\xd4\x5e\x14\xf1\xed\xf0\xa1\x03\x7b\x98\xe7\x3b\xa5\x5a\x0e\x9f\x6a\x89\x36\x18\xeb\x8f\xde\x3f\x3a\xe5\xa2\xaf\x4a\xfb\xd1\xc2\x34\x46\xf8\xa8\x33\x1d\x62\x06\xc8\x65\x86\x2c\x0e\xfb\x07\x4f\x76\xab\xb0\x34\xa5\x53\xb4\x33\x71\x93\x47\x11\x4a\xb9\xca\x93\x8f\x5e\xda\xed\x60\xda\xc7\x06\xf7\xb6\xa7\x6a\xfb\x9f\xfc\x38\x6f\xb0\x91\x0b\x19\xd0\x90\xbd\x71\xaf\x3f\x6b\x16\xc7\xc3\xd4\x9f\xcd\xf1\xd0\x99\xac\xce\x11\x00\x00\x00\x00\x55\xc5\xf7\x9e\xcb\x41\x25\x70\x06\x04\x22\xc1\x19\xf8\x7e\x40\x58\x0c\xb1\xce\xe6\xa0\xac\x28\x62\x09\xf8\x10\x21\xc6\xa1\xba\x13\xa8\x6c\x14\x7c\x4a\xe2\x4c\x49\x9c\x29\x89\xf3\x88\x24\x8e\x5b\x7d\x63\x92\x39\x15\x35\xd5\xed\x97\x4f\x49\x9d\xaf\x39\xa9\x53\x68\xe1\x9e\x72\xf3\xae\x55\xbd\xde\xbc\x2c\xac\x7f\xa0\xed\x6f\x31\x53\x33\xe0\x49\xdc\xe0\x3a\x57\xf3\x40\xd6\x5a\xc4\xd5\x92\xf3\xdf\x8d\x38\x28\x94\x52\x46\xd3\x3c\x5d\xc0\xf3\x46\x94\x1b\x77\xb8\x4e\xf8\xbb\x77\xb8\x81\xd1\x74\x66\x0e\x1f\xb4\xd2\xd2\x9c\x80\x84\xde\x22\x3c\x3b\x85\xbf\x9d\xbc\x82\xbf\xe9\xff\x3d\x03\x2e\xe0\x87\x0d\xcf\x45\xd2\xf0\x59\xf0\x1f\x62\x42\x93\xed\x0c\x7e\xb8\x47\xbc\xd5\x7f\xa0\xd6\x9e\x5a\x2d\x01\x65\xf0\xcb\xc7\xf3\xc1\xdf\x64\x9f\x72\x70\x53\x0e\x6e\xca\xc1\xf5\xec\x95\x61\xca\xc1\x8d\x90\xf3\x3f\x7e\x0e\x0e\xc0\xed\x54\xbb\x35\xb6\x6d\xa3\x49\x9c\xb9\x25\xa8\xd5\x01\xc3\x7b\x70\xdb\x9b\x59\xa9\x24\xdc\x13\x20\x02\x1b\x3e\x88\x92\x35\x03\x56\xaf\x48\xda\x92\x1d\xac\x7b\x57\x4f\x90\x32\xf4\x6e\xdf\xb8\xd4\xe1\x18\xcf\x6f\x4a\x21\x76\xae\xee\x29\x85\x38\xa5\x10\xa7\x14\xe2\x94\x42\xfc\xd3\xa6\x10\x4d\xf4\xd6\xd9\x83\xde\x4f\xb3\xfc\xbc\xd3\xb8\xae\xec\x88\xb3\xaa\x66\x25\x38\x17\xf8\x50\x1f\x29\xa9\x07\x9a\xfb\x81\xad\x34\xf7\xe0\x16\x6a\xd9\x46\xeb\x40\xa0\xf1\x00\xe3\xf6\xec\x54\x91\xbd\x3b\x14\x2e\x4f\x9d\xb9\x95\x83\x52\xb7\x65\xc1\xfc\x1a\x09\xe4\x4c\x7b\x4d\x28\x15\xac\xa8\x90\x6a\xcf\x2a\xf9\x7e\xa2\xc0\xfe\x13\xb7\xe7\xb4\x91\x9d\x56\x8a\xf7\x18\xeb\x8e\x24\xf1\x13\xa5\x89\x07\x98\xd0\xb6\x54\xf1\xe1\x93\xc5\x07\x2d\x4a\x3f\x3a\x65\xdc\xaf\xd9\x5b\xd3\xc6\x4f\x91\x38\xee\x07\xa7\x25\x79\xfc\xc8\xf4\xf1\x21\xec\x78\x47\x12\xf9\x20\x9e\xdd\xe8\x8f\x7c\xb5\xa6\x93\x5b\x12\xca\xed\x29\xe5\xe9\x1b\xed\xf5\x74\xb3\x58\x92\xe8\x98\xe4\x6a\xc3\x05\xfd\x64\xa8\x5c\xe6\x9c\x5d\xba\xf9\x9a\x27\x58\x49\x2d\x5b\x84\xc8\xa7\xdb\xb9\xfd\x5e\xc6\x1c\x13\x8c\x74\xd7\xb9\xe0\x09\xba\x06\x26\xa0\x6e\x5b\xc9\xad\x54\x98\x1e\x09\x9d\xc4\x5b\x1c\xcd\x81\x64\xd4\x44\x7f\x1c\x7d\x0c\xec\x95\x44\xa3\x89\x81\xac\xe8\x3a\x25\x99\xb4\xe4\x5c\xba\xe7\x6b\x54\xe6\xbf\x09\x95\xf6\x8f\x7b\xa2\xa2\x8d\xed\x62\x6c\xbb\xf9\xd3\x66\x57\x8e\xdc\x76\xdf\xbd\xb7\x41\xdd\xb1\xd3\x9f\x14\x8e\x4d\x03\x14\xb5\x79\x06\x0d\x8e\x3a\x47\xb3\x33\xa2\x03\x7e\x0f\xee\xf8\x14\xc7\x2e\x93\xfa\xf2\xff\x9a\x31\x2e\x62\x63\xd9\x76\x08\xf6\x04\x3c\x70\xe4\x6e\x64\x5a\xc9\x94\x80\x82\xf7\x07\xa1\xe0\x53\x4f\xed\xab\xd2\x7c\xfe\x99\x25\x46\x02\x3f\x03\xd6\x24\xcb\x64\x7d\xf6\x98\x60\xca\x99\xab\x04\xa6\x27\xcb\x12\xbe\x4d\x1d\x1b\xac\xfb\xaf\x13\x44\xb2\x06\xe1\xa3\x60\xd9\x3d\xe3\xb0\x2b\x86\x56\xf6\x9f\x9e\x26\x03\xe1\xe8\x54\x16\xb5\xf9\x46\xcf\xb2\xf3\x25\xff\x2f\x8b\x72\x08\xcc\xd3\xe2\x5d\xbd\x66\xfc\x45\xb1\x0e\x40\xf9\x6c\x38\x07\x87\x73\xfe\x28\xb8\x7b\x90\x9e\x96\x06\xe1\x37\x3c\xbe\x28\xe6\x05\x20\x4f\x8f\xaf\xfc\x1c\x1a\x7e\x20\x1c\x23\xb1\x3d\x9c\xeb\x52\x3a\x28\x99\xe0\x0f\xdb\x6e\xf7\x44\x4f\x81\x4c\xd1\x28\x9c\xa3\x8e\x94\xe2\xb7\xc8\x04\xea\x13\x11\x2d\xae\x57\xd3\xc0\xbb\xb0\xd7\xc7\x95\xb9\xd9\x08\x10\x13\xd5\xe9\x1c\x7f\x3f\xc7\xfb\x47\x1d\x0a\x65\xeb\x11\xfe\xf7\xd2\xf5\x68\x75\xc3\x79\x82\xee\xdc\x8c\xc7\xb8\x03\x9a\x23\x80\x12\x98\x7e\xdf\xdf\x91\xc3\x30\xca\xf6\xd3\x07\xa9\x68\x14\x24\x3c\xed\x08\x2e\xc1\xdb\x0a\xe5\xe3\xc4\xa9\x9b\x6a\xa1\xdb\xeb\xa9\xb5\x27\x55\x42\x11\x6e\xf5\xac\xff\x14\x44\x29\x97\xda\x13\x91\x24\x58\xcb\x4f\x46\x90\x02\x6d\x37\x5e\x05\xd7\xf2\xa3\xcf\x66\x65\x02\x64\x82\xa7\xa8\x36\x98\x1b\x92\x65\x5c\xa8\x05\x3c\xfb\xfe\x9b\x6f\x5e\x3e\x6b\x78\x6d\x8e\x55\xa2\x3b\x7b\xd5\xf8\x5e\x10\x73\x72\x56\xef\xe0\xf5\x00\x09\x59\x62\xe2\x66\x72\xde\xd2\xdc\xb8\x4b\x8b\x20\x69\xee\x05\xa5\x42\xa9\xfa\xeb\x79\x8a\x4a\xd0\x48\xce\xa5\xc3\xab\x8d\x20\xfe\x74\x9e\x46\xa6\x12\x7e\x08\xc0\x36\x78\x6a\x34\xcd\x4f\x45\xc4\x1a\xd5\x95\x79\xe8\x1b\x49\xb3\xa8\xb9\x18\x0a\x7c\xfd\x0c\x7b\x26\x4b\x11\xbc\x28\xf6\x0a\x15\x76\x1c\x92\x3e\xbd\xf4\x28\xaa\x3d\xc1\xf3\x1a\x7e\xa9\x36\x65\x3f\x07\xd0\x0c\x83\x47\x61\x9a\x25\x45\xc5\xa9\x10\x33\x80\x2a\x76\x43\x47\xac\x1e\xae\x24\x2b\x73\xae\x3f\xf8\x8c\xaa\x36\xcc\x67\xb5\xa7\x65\x48\xed\x22\xd7\x11\x37\x97\x0e\xa1\x6c\x7d\xb9\x66\xbc\x78\xfc\xe6\x01\xa3\xbc\x1e\xa1\x36\xc5\xc9\x1c\x39\x3e\xa2\xd8\x8d\xa1\xcf\x2d\x75\xde\x14\x87\xcc\x64\xfd\x0a\xc8\x2d\x6e\x6d\x9d\x68\xb3\xb8\x8f\xab\x87\x12\x5b\xbe\x18\xaf\x43\xe9\x44\x73\x00\x2e\x5b\x3e\xc1\x2e\x9b\x02\x84\x2e\xea\x05\x00\x90\xf1\xf8\x8c\x29\x7a\x58\x7a\xcc\x2d\xdf\x6e\x2a\xf2\x51\xfe\x1b\x48\x8b\x0a\xaf\x0f\x85\x7a\x8b\xc4\xf8\x7f\x8a\x67\x3c\xe1\xeb\xed\x5b\x0d\x40\x95\x05\x1b\x2e\x55\x10\x59\x2d\x8e\x17\x15\xd3\xcc\x81\x88\x75\xf1\x4b\xff\x9e\xcf\x25\x46\xb9\xc0\xb9\xf6\x2f\x91\xcd\x49\x1c\x6b\x9c\x5f\x9f\x1e\x9b\xff\x2d\x0a\xed\xe1\x9b\xfb\x33\x0f\xaf\xb5\x0a\x59\x9c\x9c\x3c\x7f\xf1\x9d\x69\xfa\x7c\xf1\xfd\xe9\xf7\xa7\x27\x95\xb6\x09\x5f\x2b\x2e\x55\x8c\x42\xbc\x2e\x02\xa0\xfe\xe5\xdd\xeb\xe7\xa7\xc5\x03\x9a\x9a\x98\xe8\x3a\x12\x1a\x0f\x8d\xd5\x32\xa7\xfa\xfc\xa6\xf9\x7b\xae\x6d\x91\x35\x2b\x8b\xbb\xd3\xe3\x6f\x8e\xcb\x8e\x56\x57\xec\x34\x0a\x44\x47\xa8\x0a\xba\x05\x49\xae\xaa\xba\x31\x1c\xac\x54\xa0\xcd\x04\xf3\x1a\x5a\x93\xea\x75\x15\xfd\x4a\x3b\x64\xfa\x60\xc2\xae\xf7\x14\xe8\x89\x34\x25\xe1\x99\xa2\x39\x9c\xec\xf2\xdb\x91\xe5\xf7\x9c\x6c\x35\x5d\xc8\x3d\x4a\x9e\x22\xa3\x0f\x27\x81\xeb\xb1\xd8\x39\xf8\x6f\xb1\xd8\x1d\xaa\xe2\xcd\xfa\x7f\x09\xd5\x47\xd7\xc3\x27\x00\x51\x96\x2f\xe0\xdb\xd3\xd3\x6a\xee\x27\xc5\x94\x8b\xed\x02\x5e\x9e\x9e\xbe\xa3\x3b\x2b\x10\x65\xe3\x18\x2f\xdb\xc6\x78\x11\x8c\xa1\x50\xa4\x94\x19\x5b\xfd\x4f\x41\x22\xbc\x42\x41\x79\x7c\x83\x3a\xc2\xad\x75\xb8\x27\xa9\xe2\x89\x4b\x56\x06\xc2\x8c\xab\x15\x46\x4a\x17\xfa\xad\x1d\x2b\x1a\xa2\xaa\xfe\x7f\x00\xbf\x9e\xb0\xad\xf9\xed\x00\x00"),
 		},
 		"/manager": &vfsgen۰DirInfo{
 			name:    "manager",
@@ -273,10 +315,10 @@ var Assets = func() http.FileSystem {
 		},
 		"/rbac/role.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "role.yaml",
-			modTime:          time.Date(2019, 9, 28, 14, 35, 16, 331687166, time.UTC),
-			uncompressedSize: 1588,
+			modTime:          time.Date(2026, 10, 17, 4, 22, 17, 801003415, time.UTC),
+			uncompressedSize: 2232,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x93\xb1\x6e\xf3\x30\x0c\x84\x77\x3d\x05\x91\xdd\x0e\xfe\xed\x87\xd7\x0e\xdd\x8b\xa2\x3b\x63\xb3\x8e\x10\x89\x14\x48\x2a\x05\xf2\xf4\x85\x9d\xb8\x43\xdb\xa1\x19\x12\x67\xf2\x81\xc0\xf9\xbb\x03\xa9\xd0\x34\x4d\xc0\x12\xdf\x48\x2d\x0a\x77\xa0\x3b\xec\x5b\xac\xbe\x17\x8d\x27\xf4\x28\xdc\x1e\xfe\x5b\x1b\x65\x7b\xfc\x17\x0e\x91\x87\x0e\x9e\x52\x35\x27\x7d\x91\x44\x21\x93\xe3\x80\x8e\x5d\x00\xe8\x95\x66\xc3\x6b\xcc\x64\x8e\xb9\x74\xc0\x35\xa5\x00\xc0\x98\xa9\x83\x8c\x8c\x23\x69\xa3\x93\x51\x6b\x22\xeb\x42\x03\x58\xe2\xb3\x4a\x2d\x36\xfd\xa2\x81\xcd\x26\x00\x28\x99\x54\xed\xe9\x32\xeb\x85\xdf\xe3\x98\xb1\x58\x00\x38\x92\xee\x96\xf9\x04\xa4\x59\x0e\x94\xe8\x22\x47\xf2\xf9\x9b\xa2\x9d\x45\x41\xef\xf7\xb3\xaa\x65\x58\x0c\x1f\xf3\xf0\x4f\x78\x3a\x12\xfb\x3a\x68\x96\x81\xd6\x21\x1b\xf5\x4a\x77\x68\x4d\x3c\x46\xa6\x16\x4f\x87\x36\xca\x2f\xab\x3f\xdf\xda\xc3\xe4\xd8\x9a\xa3\xd7\x6f\x71\x16\xf0\x0f\xde\xd5\x14\x61\x57\x49\x25\x21\xdf\x61\xed\xd7\x84\xb9\x6d\xef\xe9\xcc\x8b\x48\x5a\xbd\xf3\x57\x90\xdb\xf7\xb5\x07\x78\x5d\x4b\x8e\xeb\xda\x7e\x06\x00\x00\xff\xff\x82\x1d\xed\x1e\x34\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x94\x41\x6b\xf3\x30\x0c\x86\xef\xfe\x15\xa2\xf7\xa4\x7c\xb7\x8f\x5c\x77\xd8\x7d\x8c\xdd\x55\x5b\x4d\x4d\x6c\xcb\x48\x76\xc7\xfa\xeb\x47\xd2\x76\x14\xba\x41\xcb\x48\xb3\x53\x85\x40\x7d\x9f\x47\x31\x32\x4d\xd3\x18\xcc\xfe\x8d\x44\x3d\xa7\x0e\x64\x83\xb6\xc5\x5a\x76\x2c\xfe\x80\xc5\x73\x6a\x87\xff\xda\x7a\x5e\xef\xff\x99\xc1\x27\xd7\xc1\x53\xa8\x5a\x48\x5e\x38\x90\x89\x54\xd0\x61\xc1\xce\x00\x58\xa1\x69\xe0\xd5\x47\xd2\x82\x31\x77\x90\x6a\x08\x06\x20\x61\xa4\x0e\x22\x26\xec\x49\x1a\x19\x07\xa5\x06\xd2\xce\x34\x80\xd9\x3f\x0b\xd7\xac\xe3\x5f\x34\xb0\x5a\x19\x00\x21\xe5\x2a\x96\x4e\x3d\xcb\x69\xeb\xfb\x88\x59\x0d\xc0\x9e\x64\x73\xee\x8f\x81\x34\x95\x8e\x02\x9d\xca\x9e\xca\xf4\x1b\xbc\x1e\x8b\x8c\xc5\xee\xa6\xaa\x66\x77\x1e\x78\x9f\x9a\x37\xc5\xd3\x9e\x52\x59\x26\x3a\xb1\xa3\x65\x92\x95\xac\xd0\x03\xac\x31\x67\xbd\x4e\x77\x48\x91\x93\x1e\x01\xc6\xb0\x1c\xf8\x23\x9e\x3e\x43\x03\x5a\xb0\xd0\xb6\x06\xbd\x22\xfc\x15\x0b\xa5\xde\x27\x6a\xf1\x30\xb4\x9e\xbf\x79\x86\xc7\x77\x3f\xff\x4e\x6e\xe4\x58\x8f\x6b\xa8\x3f\x2c\xe0\x2a\xef\xee\x14\x4e\x45\x38\xe4\x80\x89\x96\x57\xbe\x84\x99\xd7\x9b\x8a\x75\x1b\xb4\x43\xcd\x8b\x5b\x5f\xa0\x3c\xcc\xd9\xee\xc8\x8d\xa7\xf9\x0f\xb9\x9f\x91\xe6\xdd\xc1\x78\x6a\x33\x73\x58\xdc\xfc\x0b\x64\x7e\x5f\x7d\xc4\x85\xbf\x91\xe3\x3e\xdb\xcf\x01\x00\xd0\xde\xc8\x45\xb8\x08\x00\x00"),
 		},
 		"/rbac/role_binding.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "role_binding.yaml",
@@ -299,6 +341,20 @@ var Assets = func() http.FileSystem {
 			modTime: time.Date(2019, 9, 25, 4, 19, 35, 235344933, time.UTC),
 			content: []byte("\x61\x70\x69\x56\x65\x72\x73\x69\x6f\x6e\x3a\x20\x65\x6e\x67\x69\x6e\x65\x2e\x61\x7a\x6b\x2e\x69\x6f\x2f\x76\x31\x61\x6c\x70\x68\x61\x31\x0a\x6b\x69\x6e\x64\x3a\x20\x43\x6f\x6e\x74\x72\x6f\x6c\x50\x6c\x61\x6e\x65\x0a\x6d\x65\x74\x61\x64\x61\x74\x61\x3a\x0a\x20\x20\x6e\x61\x6d\x65\x3a\x20\x63\x6f\x6e\x74\x72\x6f\x6c\x70\x6c\x61\x6e\x65\x2d\x73\x61\x6d\x70\x6c\x65\x0a\x73\x70\x65\x63\x3a\x0a\x20\x20\x23\x20\x41\x64\x64\x20\x66\x69\x65\x6c\x64\x73\x20\x68\x65\x72\x65\x0a\x20\x20\x66\x6f\x6f\x3a\x20\x62\x61\x72\x0a"),
 		},
+		"/samples/engine_v1alpha1_etcdbackup.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "engine_v1alpha1_etcdbackup.yaml",
+			modTime:          time.Date(2026, 10, 17, 2, 42, 48, 162122542, time.UTC),
+			uncompressedSize: 170,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\xcc\x31\x0e\xc2\x30\x0c\x85\xe1\x3d\xa7\xf0\x05\x28\xea\x9a\x0d\x24\xae\xc0\xfe\xea\x58\x25\x4a\x6b\x47\x8d\xcb\xd0\xd3\xa3\x12\xc4\xf6\xa4\xef\xe9\x47\xcd\x4f\xd9\x5a\x36\x8d\x24\x3a\x67\x95\x01\x47\x19\xb2\x5d\xdf\x23\x96\xfa\xc2\x18\x4a\xd6\x14\xe9\xe1\x9c\xee\xe0\xb2\xd7\xb0\x8a\x23\xc1\x11\x03\x91\x62\x95\x48\xe2\x9c\xa6\x2f\x5e\x1a\xd6\xba\x48\x68\x55\xf8\xf4\xe6\xb6\x61\x96\x73\x12\x4d\x8b\x4d\x7d\xfd\xe1\xc6\x6c\xbb\x7a\x24\x1c\xa5\x17\xda\xef\xc0\xa6\x8e\xac\xb2\xf5\x7c\xf8\x0c\x00\x11\xef\x91\x56\xaa\x00\x00\x00"),
+		},
+		"/samples/engine_v1alpha1_etcdbackupschedule.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "engine_v1alpha1_etcdbackupschedule.yaml",
+			modTime:          time.Date(2026, 10, 17, 2, 42, 48, 163370763, time.UTC),
+			uncompressedSize: 227,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\xce\x31\x6a\xc5\x30\x0c\x06\xe0\xdd\xa7\x10\x19\x03\x4d\x9a\xa5\x05\x6f\x2d\xf4\x04\x85\xee\x8a\x2c\x12\x63\x47\x36\xb6\xd2\x21\xa7\x7f\x38\xc9\x7b\x68\x11\xd2\x27\xf4\x63\xf6\x7f\x5c\xaa\x4f\x62\x81\x65\xf1\xc2\x03\x1e\x61\xf0\x69\xfc\x9f\x30\xe6\x15\x27\x13\xbc\x38\x0b\x3f\x4a\xee\x1b\x29\xec\xf9\x97\x56\x76\x7b\x64\xb3\xb1\xa2\x43\x45\x6b\x00\x04\x37\xb6\xc0\x4a\x6e\x3e\x51\xbd\xd1\x5b\xc5\x2d\x47\x36\x35\x33\x35\xf7\x9c\x5b\xe8\xde\xa1\x1f\x3f\xa0\x6f\xd5\x19\x80\xc2\xca\xa2\x67\x90\xcf\x06\x35\x15\x5c\xb8\xdd\x00\xcc\x31\xcd\x57\xf7\x5a\x7c\x11\xa5\x5d\xd4\x02\x1e\xe1\x7e\x79\x03\x4a\xa2\xe8\x85\xcb\x95\xc7\x3c\x06\x00\xe8\xd5\x3f\xc4\xe3\x00\x00\x00"),
+		},
 		"/samples/engine_v1alpha1_nodepool.yaml": &vfsgen۰FileInfo{
 			name:    "engine_v1alpha1_nodepool.yaml",
 			modTime: time.Date(2019, 9, 25, 4, 19, 50, 382941084, time.UTC),
@@ -362,16 +418,22 @@ var Assets = func() http.FileSystem {
 	fs["/crd/bases"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/crd/bases/engine.azk.io_clusters.yaml"].(os.FileInfo),
 		fs["/crd/bases/engine.azk.io_controlplanes.yaml"].(os.FileInfo),
+		fs["/crd/bases/engine.azk.io_etcdbackups.yaml"].(os.FileInfo),
+		fs["/crd/bases/engine.azk.io_etcdbackupschedules.yaml"].(os.FileInfo),
 		fs["/crd/bases/engine.azk.io_nodepools.yaml"].(os.FileInfo),
 		fs["/crd/bases/engine.azk.io_nodesets.yaml"].(os.FileInfo),
 	}
 	fs["/crd/patches"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/crd/patches/cainjection_in_clusters.yaml"].(os.FileInfo),
 		fs["/crd/patches/cainjection_in_controlplanes.yaml"].(os.FileInfo),
+		fs["/crd/patches/cainjection_in_etcdbackups.yaml"].(os.FileInfo),
+		fs["/crd/patches/cainjection_in_etcdbackupschedules.yaml"].(os.FileInfo),
 		fs["/crd/patches/cainjection_in_nodepools.yaml"].(os.FileInfo),
 		fs["/crd/patches/cainjection_in_nodesets.yaml"].(os.FileInfo),
 		fs["/crd/patches/webhook_in_clusters.yaml"].(os.FileInfo),
 		fs["/crd/patches/webhook_in_controlplanes.yaml"].(os.FileInfo),
+		fs["/crd/patches/webhook_in_etcdbackups.yaml"].(os.FileInfo),
+		fs["/crd/patches/webhook_in_etcdbackupschedules.yaml"].(os.FileInfo),
 		fs["/crd/patches/webhook_in_nodepools.yaml"].(os.FileInfo),
 		fs["/crd/patches/webhook_in_nodesets.yaml"].(os.FileInfo),
 	}
@@ -402,6 +464,8 @@ var Assets = func() http.FileSystem {
 	fs["/samples"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/samples/engine_v1alpha1_cluster.yaml"].(os.FileInfo),
 		fs["/samples/engine_v1alpha1_controlplane.yaml"].(os.FileInfo),
+		fs["/samples/engine_v1alpha1_etcdbackup.yaml"].(os.FileInfo),
+		fs["/samples/engine_v1alpha1_etcdbackupschedule.yaml"].(os.FileInfo),
 		fs["/samples/engine_v1alpha1_nodepool.yaml"].(os.FileInfo),
 		fs["/samples/engine_v1alpha1_nodeset.yaml"].(os.FileInfo),
 	}
@@ -0,0 +1,557 @@
+// Package armserver is a local stand-in for the subset of the Azure Resource Manager
+// and Active Directory REST apis azk uses, so the cli and the manager can be exercised
+// end to end without an azure subscription
+package armserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
+	"github.com/go-chi/chi"
+)
+
+const (
+	// Token is the access token issued for every token request
+	Token = "azk-armserver-token"
+
+	succeeded = "Succeeded"
+)
+
+// Server serves resource groups, networking and scale set resources from memory,
+// every long running operation completes on the first poll
+type Server struct {
+	// SKUs are returned by the resource skus api
+	SKUs []compute.ResourceSku
+	// RunCommandOutput is reported as the stdout of every run command
+	RunCommandOutput string
+
+	mu         sync.Mutex
+	resources  map[string]map[string]interface{}
+	operations map[string]interface{}
+	instances  map[string]int64
+	operation  int
+	address    int
+	router     chi.Router
+}
+
+// NewServer returns an empty server
+func NewServer() *Server {
+	s := &Server{
+		resources:  map[string]map[string]interface{}{},
+		operations: map[string]interface{}{},
+		instances:  map[string]int64{},
+	}
+	r := chi.NewRouter()
+	r.Post("/{tenantID}/oauth2/token", s.token)
+	r.Get("/operations/{operationID}", s.operationStatus)
+	r.Get("/operations/{operationID}/result", s.operationResult)
+	r.Get("/subscriptions/{subscriptionID}/providers/Microsoft.Compute/skus", s.authorized(s.skus))
+	r.HandleFunc("/subscriptions/*", s.authorized(s.resource))
+	s.router = r
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.router.ServeHTTP(w, r)
+}
+
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	now := time.Now()
+	writeJSON(w, http.StatusOK, map[string]string{
+		"token_type":   "Bearer",
+		"access_token": Token,
+		"expires_in":   "3600",
+		"expires_on":   strconv.FormatInt(now.Add(time.Hour).Unix(), 10),
+		"not_before":   strconv.FormatInt(now.Unix(), 10),
+		"resource":     r.FormValue("resource"),
+	})
+}
+
+func (s *Server) authorized(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+			writeError(w, http.StatusUnauthorized, "AuthenticationFailed", "missing bearer token")
+			return
+		}
+		next(w, r)
+	}
+}
+
+func (s *Server) skus(w http.ResponseWriter, r *http.Request) {
+	skus := s.SKUs
+	if skus == nil {
+		skus = []compute.ResourceSku{}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"value": skus})
+}
+
+func (s *Server) operationStatus(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.operations[chi.URLParam(r, "operationID")]; !ok {
+		writeError(w, http.StatusNotFound, "OperationNotFound", "operation not found")
+		return
+	}
+	w.Header().Set("Retry-After", "0")
+	writeJSON(w, http.StatusOK, map[string]string{"status": succeeded})
+}
+
+func (s *Server) operationResult(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	result, ok := s.operations[chi.URLParam(r, "operationID")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "OperationNotFound", "operation not found")
+		return
+	}
+	if result == nil {
+		result = map[string]interface{}{}
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// accepted records a completed operation and points the client at it for polling,
+// the caller must hold the lock
+func (s *Server) accepted(w http.ResponseWriter, r *http.Request, status int, body, result interface{}) {
+	s.operation++
+	id := strconv.Itoa(s.operation)
+	s.operations[id] = result
+	operationURL := fmt.Sprintf("http://%s/operations/%s", r.Host, id)
+	w.Header().Set("Azure-AsyncOperation", operationURL)
+	w.Header().Set("Retry-After", "0")
+	if status == http.StatusAccepted {
+		w.Header().Set("Location", operationURL+"/result")
+	}
+	if body == nil {
+		w.WriteHeader(status)
+		return
+	}
+	writeJSON(w, status, body)
+}
+
+func (s *Server) resource(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := strings.TrimSuffix(r.URL.Path, "/")
+	segments := strings.Split(strings.TrimPrefix(id, "/"), "/")
+	if len(segments) < 4 || !strings.EqualFold(segments[2], "resourceGroups") {
+		writeError(w, http.StatusNotFound, "NotFound", fmt.Sprintf("no route for %s", id))
+		return
+	}
+	groupID := "/" + strings.Join(segments[:4], "/")
+	if len(segments) == 4 {
+		s.resourceGroup(w, r, groupID)
+		return
+	}
+	if len(segments) < 8 || !strings.EqualFold(segments[4], "providers") {
+		writeError(w, http.StatusNotFound, "NotFound", fmt.Sprintf("no route for %s", id))
+		return
+	}
+	if _, ok := s.resources[key(groupID)]; !ok {
+		writeError(w, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("Resource group '%s' could not be found.", segments[3]))
+		return
+	}
+
+	if len(segments[6:])%2 == 1 {
+		parentID := id[:strings.LastIndex(id, "/")]
+		switch r.Method {
+		case http.MethodGet:
+			s.list(w, id)
+		case http.MethodPost:
+			s.action(w, r, parentID, segments[len(segments)-1])
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", r.Method)
+		}
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		s.get(w, id)
+	case http.MethodPut:
+		s.put(w, r, id, segments[5], segments[6:])
+	case http.MethodPatch:
+		s.patch(w, r, id)
+	case http.MethodDelete:
+		s.delete(w, r, id)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", r.Method)
+	}
+}
+
+func (s *Server) resourceGroup(w http.ResponseWriter, r *http.Request, id string) {
+	name := id[strings.LastIndex(id, "/")+1:]
+	group, exists := s.resources[key(id)]
+	switch r.Method {
+	case http.MethodHead:
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodGet:
+		if !exists {
+			writeError(w, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("Resource group '%s' could not be found.", name))
+			return
+		}
+		writeJSON(w, http.StatusOK, group)
+	case http.MethodPut:
+		body, err := readBody(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+			return
+		}
+		body["id"] = id
+		body["name"] = name
+		body["type"] = "Microsoft.Resources/resourceGroups"
+		body["properties"] = map[string]interface{}{"provisioningState": succeeded}
+		s.resources[key(id)] = body
+		status := http.StatusCreated
+		if exists {
+			status = http.StatusOK
+		}
+		writeJSON(w, status, body)
+	case http.MethodDelete:
+		if !exists {
+			writeError(w, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("Resource group '%s' could not be found.", name))
+			return
+		}
+		s.remove(id)
+		s.accepted(w, r, http.StatusAccepted, nil, nil)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", r.Method)
+	}
+}
+
+func (s *Server) get(w http.ResponseWriter, id string) {
+	if resource, ok := s.resources[key(id)]; ok {
+		writeJSON(w, http.StatusOK, resource)
+		return
+	}
+	if child := s.child(id); child != nil {
+		writeJSON(w, http.StatusOK, child)
+		return
+	}
+	writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The Resource '%s' was not found.", id))
+}
+
+// child looks up a resource defined inline in the properties of its parent, e.g. a subnet
+func (s *Server) child(id string) map[string]interface{} {
+	segments := strings.Split(id, "/")
+	if len(segments) < 11 {
+		return nil
+	}
+	parent, ok := s.resources[key(strings.Join(segments[:len(segments)-2], "/"))]
+	if !ok {
+		return nil
+	}
+	properties, _ := parent["properties"].(map[string]interface{})
+	children, _ := properties[segments[len(segments)-2]].([]interface{})
+	for _, c := range children {
+		child, ok := c.(map[string]interface{})
+		if ok && strings.EqualFold(fmt.Sprint(child["name"]), segments[len(segments)-1]) {
+			return child
+		}
+	}
+	return nil
+}
+
+func (s *Server) list(w http.ResponseWriter, collectionID string) {
+	prefix := key(collectionID) + "/"
+	var keys []string
+	for k := range s.resources {
+		if strings.HasPrefix(k, prefix) && !strings.Contains(k[len(prefix):], "/") {
+			keys = append(keys, k)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) < len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+	value := []interface{}{}
+	for _, k := range keys {
+		value = append(value, s.resources[k])
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"value": value})
+}
+
+func (s *Server) put(w http.ResponseWriter, r *http.Request, id, namespace string, path []string) {
+	body, err := readBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+		return
+	}
+	if len(path) > 2 {
+		parentID := id[:len(id)-len(strings.Join(path[len(path)-2:], "/"))-1]
+		if _, ok := s.resources[key(parentID)]; !ok {
+			writeError(w, http.StatusNotFound, "ParentResourceNotFound", fmt.Sprintf("Parent resource '%s' was not found.", parentID))
+			return
+		}
+	}
+
+	var types []string
+	for i := 0; i < len(path); i += 2 {
+		types = append(types, path[i])
+	}
+	body["id"] = id
+	body["name"] = path[len(path)-1]
+	body["type"] = namespace + "/" + strings.Join(types, "/")
+	properties, _ := body["properties"].(map[string]interface{})
+	if properties == nil {
+		properties = map[string]interface{}{}
+		body["properties"] = properties
+	}
+	normalize(id, properties)
+
+	status := http.StatusCreated
+	if existing, ok := s.resources[key(id)]; ok {
+		status = http.StatusOK
+		if p, ok := existing["properties"].(map[string]interface{}); ok && properties["ipAddress"] == nil && p["ipAddress"] != nil {
+			properties["ipAddress"] = p["ipAddress"]
+		}
+	}
+	s.resources[key(id)] = body
+	s.provision(body)
+	s.accepted(w, r, status, inProgress(body), nil)
+}
+
+func (s *Server) patch(w http.ResponseWriter, r *http.Request, id string) {
+	existing, ok := s.resources[key(id)]
+	if !ok {
+		writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The Resource '%s' was not found.", id))
+		return
+	}
+	body, err := readBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+		return
+	}
+	merge(existing, body)
+	if properties, ok := existing["properties"].(map[string]interface{}); ok {
+		normalize(id, properties)
+	}
+	s.provision(existing)
+	s.accepted(w, r, http.StatusOK, inProgress(existing), nil)
+}
+
+func (s *Server) delete(w http.ResponseWriter, r *http.Request, id string) {
+	if _, ok := s.resources[key(id)]; !ok {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	s.remove(id)
+
+	// deleting a single instance shrinks the scale set
+	segments := strings.Split(id, "/")
+	if len(segments) == 11 && strings.EqualFold(segments[9], "virtualMachines") {
+		if vmss, ok := s.resources[key(strings.Join(segments[:9], "/"))]; ok {
+			if sku, ok := vmss["sku"].(map[string]interface{}); ok {
+				if capacity, ok := sku["capacity"].(float64); ok && capacity > 0 {
+					sku["capacity"] = capacity - 1
+				}
+			}
+		}
+	}
+	s.accepted(w, r, http.StatusAccepted, nil, nil)
+}
+
+func (s *Server) action(w http.ResponseWriter, r *http.Request, id, action string) {
+	if _, ok := s.resources[key(id)]; !ok {
+		writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The Resource '%s' was not found.", id))
+		return
+	}
+	var result interface{}
+	if strings.EqualFold(action, "runCommand") {
+		result = compute.RunCommandResult{
+			Value: &[]compute.InstanceViewStatus{{
+				Code:          strPtr("ComponentStatus/StdOut/succeeded"),
+				Level:         compute.Info,
+				DisplayStatus: strPtr("Provisioning succeeded"),
+				Message:       strPtr(s.RunCommandOutput),
+			}},
+		}
+	}
+	s.accepted(w, r, http.StatusAccepted, nil, result)
+}
+
+// remove deletes a resource along with everything nested under it, the caller must hold the lock
+func (s *Server) remove(id string) {
+	prefix := key(id)
+	for k := range s.resources {
+		if k == prefix || strings.HasPrefix(k, prefix+"/") {
+			delete(s.resources, k)
+		}
+	}
+}
+
+// provision fills in the values azure assigns on creation, the caller must hold the lock
+func (s *Server) provision(resource map[string]interface{}) {
+	properties := resource["properties"].(map[string]interface{})
+	switch strings.ToLower(fmt.Sprint(resource["type"])) {
+	case "microsoft.network/publicipaddresses":
+		if properties["ipAddress"] == nil {
+			s.address++
+			properties["ipAddress"] = fmt.Sprintf("20.0.%d.%d", s.address/256, s.address%256)
+		}
+		if dns, ok := properties["dnsSettings"].(map[string]interface{}); ok && dns["domainNameLabel"] != nil {
+			dns["fqdn"] = fmt.Sprintf("%s.%s.cloudapp.azure.com", dns["domainNameLabel"], resource["location"])
+		}
+	case "microsoft.compute/virtualmachinescalesets":
+		s.scale(resource)
+	}
+}
+
+// scale adds or removes instances of a scale set to match its sku capacity, the caller must hold the lock
+func (s *Server) scale(vmss map[string]interface{}) {
+	id := fmt.Sprint(vmss["id"])
+	name := fmt.Sprint(vmss["name"])
+	capacity := 0
+	if sku, ok := vmss["sku"].(map[string]interface{}); ok {
+		if c, ok := sku["capacity"].(float64); ok {
+			capacity = int(c)
+		}
+	}
+
+	prefix := key(id) + "/virtualmachines/"
+	var instances []int64
+	for k := range s.resources {
+		if strings.HasPrefix(k, prefix) {
+			n, _ := strconv.ParseInt(k[len(prefix):], 10, 64)
+			instances = append(instances, n)
+		}
+	}
+	sort.Slice(instances, func(i, j int) bool { return instances[i] < instances[j] })
+
+	for len(instances) > capacity {
+		s.remove(fmt.Sprintf("%s/virtualMachines/%d", id, instances[len(instances)-1]))
+		instances = instances[:len(instances)-1]
+	}
+	for len(instances) < capacity {
+		n := s.instances[key(id)]
+		s.instances[key(id)] = n + 1
+		instanceID := strconv.FormatInt(n, 10)
+		vmID := id + "/virtualMachines/" + instanceID
+		s.resources[key(vmID)] = map[string]interface{}{
+			"id":         vmID,
+			"name":       name + "_" + instanceID,
+			"type":       "Microsoft.Compute/virtualMachineScaleSets/virtualMachines",
+			"location":   vmss["location"],
+			"instanceId": instanceID,
+			"properties": map[string]interface{}{
+				"provisioningState":  succeeded,
+				"latestModelApplied": true,
+				"osProfile": map[string]interface{}{
+					"computerName": fmt.Sprintf("%s%06s", name, strconv.FormatInt(n, 36)),
+				},
+			},
+		}
+		instances = append(instances, n)
+	}
+}
+
+// normalize marks properties provisioned, assigns ids to inline children and
+// reduces references to other resources down to their id
+func normalize(id string, properties map[string]interface{}) {
+	properties["provisioningState"] = succeeded
+	for name, value := range properties {
+		children, ok := value.([]interface{})
+		if !ok {
+			properties[name] = reference(value)
+			continue
+		}
+		for i, c := range children {
+			child, ok := c.(map[string]interface{})
+			if !ok || child["name"] == nil {
+				children[i] = reference(c)
+				continue
+			}
+			childID := fmt.Sprintf("%s/%s/%s", id, name, child["name"])
+			child["id"] = childID
+			if p, ok := child["properties"].(map[string]interface{}); ok {
+				normalize(childID, p)
+			}
+		}
+	}
+}
+
+func reference(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if id, ok := v["id"]; ok {
+			return map[string]interface{}{"id": id}
+		}
+		for name, item := range v {
+			v[name] = reference(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = reference(item)
+		}
+	}
+	return value
+}
+
+// inProgress returns a copy of resource as reported while an operation is still running
+func inProgress(resource map[string]interface{}) map[string]interface{} {
+	out := map[string]interface{}{}
+	for k, v := range resource {
+		out[k] = v
+	}
+	properties := map[string]interface{}{}
+	for k, v := range resource["properties"].(map[string]interface{}) {
+		properties[k] = v
+	}
+	properties["provisioningState"] = "Updating"
+	out["properties"] = properties
+	return out
+}
+
+func merge(dst, src map[string]interface{}) {
+	for k, v := range src {
+		if m, ok := v.(map[string]interface{}); ok {
+			if d, ok := dst[k].(map[string]interface{}); ok {
+				merge(d, m)
+				continue
+			}
+		}
+		dst[k] = v
+	}
+}
+
+func key(id string) string {
+	return strings.ToLower(id)
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func readBody(r *http.Request) (map[string]interface{}, error) {
+	body := map[string]interface{}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("cannot decode request body: %v", err)
+	}
+	return body, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"error": map[string]string{"code": code, "message": message},
+	})
+}
@@ -0,0 +1,126 @@
+package armserver_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+
+	azhelpers "github.com/awesomenix/azk/azure"
+	"github.com/awesomenix/azk/azure/armserver"
+	"github.com/awesomenix/azk/bootstrap"
+)
+
+func newSpec(t *testing.T, ts *httptest.Server) (*bootstrap.Spec, azhelpers.Provider) {
+	spec := &bootstrap.Spec{
+		CloudConfiguration: azhelpers.CloudConfiguration{
+			CloudName:               azhelpers.AzurePublicCloudName,
+			SubscriptionID:          "subscription",
+			ClientID:                "client",
+			ClientSecret:            "secret",
+			TenantID:                "tenant",
+			GroupName:               "group",
+			GroupLocation:           "westus2",
+			UserAgent:               "azk",
+			ResourceManagerEndpoint: ts.URL,
+			ActiveDirectoryEndpoint: ts.URL,
+		},
+		ClusterName:     "cluster",
+		DNSPrefix:       "azk",
+		InternalDNSName: "azk0123abcd.internal",
+	}
+	provider, err := azhelpers.NewProvider(&spec.CloudConfiguration)
+	if err != nil {
+		t.Fatalf("Failed to create provider: %v", err)
+	}
+	return spec, provider
+}
+
+func TestBaseInfrastructure(t *testing.T) {
+	ctx := context.Background()
+	ts := httptest.NewServer(armserver.NewServer())
+	defer ts.Close()
+	spec, provider := newSpec(t, ts)
+
+	if err := spec.CreateBaseInfrastructure(provider); err != nil {
+		t.Fatalf("Failed to create base infrastructure: %v", err)
+	}
+	if spec.PublicIPAdress == "" {
+		t.Fatalf("Expected public ip address to be set")
+	}
+
+	states, err := spec.CheckBaseInfrastructure(ctx, provider)
+	if err != nil {
+		t.Fatalf("Failed to check base infrastructure: %v", err)
+	}
+	for _, state := range states {
+		if state.Err != nil {
+			t.Errorf("Expected %s %s ready, got %v", state.Type, state.Name, state.Err)
+		}
+	}
+
+	if err := provider.DeleteNetworkSecurityGroup(ctx, "azk-master-nsg"); err != nil {
+		t.Fatalf("Failed to delete security group: %v", err)
+	}
+	if _, err := provider.GetNetworkSecurityGroup(ctx, "azk-master-nsg"); !azhelpers.ResourceNotFound(err) {
+		t.Fatalf("Expected security group to be not found, got %v", err)
+	}
+}
+
+func TestVMSS(t *testing.T) {
+	ctx := context.Background()
+	server := armserver.NewServer()
+	server.RunCommandOutput = "ok"
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	spec, provider := newSpec(t, ts)
+
+	if err := spec.CreateBaseInfrastructure(provider); err != nil {
+		t.Fatalf("Failed to create base infrastructure: %v", err)
+	}
+	subnet, err := provider.GetSubnet(ctx, "azk-vnet", "agent-subnet")
+	if err != nil {
+		t.Fatalf("Failed to get subnet: %v", err)
+	}
+	if err := provider.CreateVMSS(ctx, "agent", to.String(subnet.ID), nil, nil, "", "Standard_DS2_v2", 2, 1); err != nil {
+		t.Fatalf("Failed to create vmss: %v", err)
+	}
+
+	vms, err := provider.ListVMSSVMs(ctx, "agent")
+	if err != nil {
+		t.Fatalf("Failed to list vmss vms: %v", err)
+	}
+	if len(vms) != 2 || to.String(vms[1].OsProfile.ComputerName) != "agent000001" {
+		t.Fatalf("Expected instances agent000000 and agent000001, got %d", len(vms))
+	}
+
+	if err := provider.ScaleVMSS(ctx, "agent", "", 3); err != nil {
+		t.Fatalf("Failed to scale vmss: %v", err)
+	}
+	if err := provider.DeleteVMSSVM(ctx, "agent", "0"); err != nil {
+		t.Fatalf("Failed to delete vmss vm: %v", err)
+	}
+	vmss, err := provider.GetVMSS(ctx, "agent")
+	if err != nil {
+		t.Fatalf("Failed to get vmss: %v", err)
+	}
+	if capacity := to.Int64(vmss.Sku.Capacity); capacity != 2 {
+		t.Fatalf("Expected capacity 2, got %d", capacity)
+	}
+
+	if err := provider.ReimageVMSSVM(ctx, "agent", "1"); err != nil {
+		t.Fatalf("Failed to reimage vmss vm: %v", err)
+	}
+	result, err := provider.RunCommandVMSSVM(ctx, "agent", "1", compute.RunCommandInput{
+		CommandID: to.StringPtr("RunShellScript"),
+		Script:    &[]string{"true"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to run command: %v", err)
+	}
+	if result.Value == nil || len(*result.Value) != 1 || to.String((*result.Value)[0].Message) != "ok" {
+		t.Fatalf("Expected run command output ok, got %+v", result.Value)
+	}
+}
@@ -0,0 +1,116 @@
+package azhelpers
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"golang.org/x/crypto/pkcs12"
+)
+
+// hasCredentials returns true if any supported credentials are configured
+func (c *CloudConfiguration) hasCredentials() bool {
+	if c.UseManagedIdentity {
+		return true
+	}
+	return c.ClientID != "" && c.TenantID != "" &&
+		(c.ClientSecret != "" || c.ClientCertificate != "" || c.FederatedTokenFile != "")
+}
+
+// ManagedIdentityConfiguration returns a copy of the configuration authenticating as the
+// user assigned identity of the scale sets, with all service principal credentials removed
+func (c CloudConfiguration) ManagedIdentityConfiguration() CloudConfiguration {
+	c.UseManagedIdentity = true
+	c.ClientID = ""
+	c.ClientSecret = ""
+	c.ClientCertificate = ""
+	c.ClientCertificatePassword = ""
+	c.FederatedTokenFile = ""
+	return c
+}
+
+func (c *CloudConfiguration) getAuthorizerForResource() (autorest.Authorizer, error) {
+	token, err := c.servicePrincipalToken()
+	if err != nil {
+		return nil, err
+	}
+	return autorest.NewBearerAuthorizer(token), nil
+}
+
+// servicePrincipalToken returns a token for the resource manager, preferring managed identity,
+// then workload identity tokens, then certificates over client secrets
+func (c *CloudConfiguration) servicePrincipalToken() (*adal.ServicePrincipalToken, error) {
+	env, err := c.Environment()
+	if err != nil {
+		return nil, err
+	}
+	resource := env.ResourceManagerEndpoint
+
+	if c.UseManagedIdentity {
+		msiEndpoint, err := adal.GetMSIVMEndpoint()
+		if err != nil {
+			return nil, err
+		}
+		if c.UserAssignedIdentityID != "" {
+			return adal.NewServicePrincipalTokenFromMSIWithUserAssignedID(msiEndpoint, resource, c.UserAssignedIdentityID)
+		}
+		return adal.NewServicePrincipalTokenFromMSI(msiEndpoint, resource)
+	}
+
+	oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, c.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case c.FederatedTokenFile != "":
+		return adal.NewServicePrincipalTokenWithSecret(
+			*oauthConfig, c.ClientID, resource, &federatedTokenSecret{path: c.FederatedTokenFile})
+	case c.ClientCertificate != "":
+		secret, err := c.certificateSecret()
+		if err != nil {
+			return nil, err
+		}
+		return adal.NewServicePrincipalTokenFromCertificate(
+			*oauthConfig, c.ClientID, secret.Certificate, secret.PrivateKey, resource)
+	default:
+		return adal.NewServicePrincipalToken(*oauthConfig, c.ClientID, c.ClientSecret, resource)
+	}
+}
+
+// certificateSecret decodes the pkcs12 client certificate
+func (c *CloudConfiguration) certificateSecret() (*adal.ServicePrincipalCertificateSecret, error) {
+	data, err := base64.StdEncoding.DecodeString(c.ClientCertificate)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode client certificate: %v", err)
+	}
+	key, certificate, err := pkcs12.Decode(data, c.ClientCertificatePassword)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode client certificate: %v", err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("client certificate key is not an rsa private key")
+	}
+	return &adal.ServicePrincipalCertificateSecret{Certificate: certificate, PrivateKey: rsaKey}, nil
+}
+
+// federatedTokenSecret authenticates with a workload identity token, the file is read
+// on every refresh as the token is rotated by the issuer
+type federatedTokenSecret struct {
+	path string
+}
+
+func (s *federatedTokenSecret) SetAuthenticationValues(spt *adal.ServicePrincipalToken, v *url.Values) error {
+	token, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		return fmt.Errorf("cannot read federated token file: %v", err)
+	}
+	v.Set("client_assertion", string(token))
+	v.Set("client_assertion_type", "urn:ietf:params:oauth:client-assertion-type:jwt-bearer")
+	return nil
+}
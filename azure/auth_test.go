@@ -0,0 +1,78 @@
+package azhelpers
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFederatedTokenFile(t *testing.T) {
+	var assertion string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertion = r.FormValue("client_assertion")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"token_type":"Bearer","access_token":"token","expires_in":"3600","expires_on":"4102444800","not_before":"0"}`))
+	}))
+	defer ts.Close()
+
+	dir, err := ioutil.TempDir("", "azk")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	tokenFile := filepath.Join(dir, "token")
+	if err := ioutil.WriteFile(tokenFile, []byte("workload-token"), 0600); err != nil {
+		t.Fatalf("Failed to write token file: %v", err)
+	}
+
+	c := &CloudConfiguration{
+		CloudName:               AzurePublicCloudName,
+		SubscriptionID:          "subscription",
+		ClientID:                "client",
+		TenantID:                "tenant",
+		FederatedTokenFile:      tokenFile,
+		ActiveDirectoryEndpoint: ts.URL,
+	}
+	if !c.IsValid() {
+		t.Fatalf("Expected federated token configuration to be valid")
+	}
+	token, err := c.servicePrincipalToken()
+	if err != nil {
+		t.Fatalf("Failed to create token: %v", err)
+	}
+	if err := token.Refresh(); err != nil {
+		t.Fatalf("Failed to refresh token: %v", err)
+	}
+	if assertion != "workload-token" {
+		t.Fatalf("Expected workload token as client assertion, got %q", assertion)
+	}
+}
+
+func TestManagedIdentityConfiguration(t *testing.T) {
+	c := CloudConfiguration{
+		CloudName:                      AzurePublicCloudName,
+		SubscriptionID:                 "subscription",
+		ClientID:                       "client",
+		ClientSecret:                   "secret",
+		TenantID:                       "tenant",
+		UserAssignedIdentityID:         "identity",
+		UserAssignedIdentityResourceID: "/subscriptions/subscription/resourceGroups/group/providers/Microsoft.ManagedIdentity/userAssignedIdentities/azk",
+	}
+
+	mi := c.ManagedIdentityConfiguration()
+	if !mi.IsValid() || !mi.UseManagedIdentity || mi.ClientSecret != "" {
+		t.Fatalf("Expected a valid managed identity configuration without secrets, got %+v", mi)
+	}
+
+	config := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(GetAzureCloudProviderConfig(&c, CloudProviderNetwork{})), &config); err != nil {
+		t.Fatalf("Failed to parse cloud provider config: %v", err)
+	}
+	if config["useManagedIdentityExtension"] != true || config["userAssignedIdentityID"] != "identity" || config["aadClientSecret"] != "" {
+		t.Fatalf("Expected cloud provider to use the user assigned identity, got %v", config)
+	}
+}
@@ -0,0 +1,123 @@
+package azhelpers
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// clientCache holds the authorizer and sdk clients created for one set of credentials, the
+// authorizer refreshes its token in place so the clients stay usable across reconciles
+type clientCache struct {
+	credentials string
+	lastUsed    uint64
+	mu          sync.Mutex
+	authorizer  autorest.Authorizer
+	clients     map[string]interface{}
+	skus        *SKUCatalog
+}
+
+// maxClientCaches bounds the identities clients are cached for, the manager reconciles clusters of
+// many subscriptions and the caches of deleted clusters are never used again
+const maxClientCaches = 64
+
+var (
+	clientCachesMu  sync.Mutex
+	clientCaches    = map[string]*clientCache{}
+	clientCacheUses uint64
+)
+
+func hashKey(values ...string) string {
+	h := sha256.New()
+	for _, v := range values {
+		h.Write([]byte(v))
+		h.Write([]byte{0})
+	}
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+// identityKey identifies who clients authenticate as and which endpoints they talk to
+func (c *CloudConfiguration) identityKey() string {
+	return hashKey(
+		c.CloudName,
+		c.CloudEnvironment,
+		c.ResourceManagerEndpoint,
+		c.ActiveDirectoryEndpoint,
+		c.SubscriptionID,
+		c.TenantID,
+		c.ClientID,
+		strconv.FormatBool(c.UseManagedIdentity),
+		c.UserAssignedIdentityID,
+		c.UserAgent,
+	)
+}
+
+// credentialsKey changes whenever the credentials of an identity are rotated
+func (c *CloudConfiguration) credentialsKey() string {
+	return hashKey(
+		c.ClientSecret,
+		c.ClientCertificate,
+		c.ClientCertificatePassword,
+		c.FederatedTokenFile,
+	)
+}
+
+// clientCache returns the cache for the configuration, rotated credentials replace the
+// cache of the identity so fresh tokens are requested. Once maxClientCaches identities are
+// cached the least recently used one is dropped.
+func (c *CloudConfiguration) clientCache() *clientCache {
+	identity, credentials := c.identityKey(), c.credentialsKey()
+
+	clientCachesMu.Lock()
+	defer clientCachesMu.Unlock()
+	cache, ok := clientCaches[identity]
+	if !ok || cache.credentials != credentials {
+		cache = &clientCache{
+			credentials: credentials,
+			clients:     map[string]interface{}{},
+		}
+		clientCaches[identity] = cache
+	}
+	clientCacheUses++
+	cache.lastUsed = clientCacheUses
+	evictClientCaches()
+	return cache
+}
+
+// evictClientCaches drops the least recently used caches beyond maxClientCaches, the caller must
+// hold clientCachesMu
+func evictClientCaches() {
+	for len(clientCaches) > maxClientCaches {
+		var oldest string
+		for identity, cache := range clientCaches {
+			if oldest == "" || cache.lastUsed < clientCaches[oldest].lastUsed {
+				oldest = identity
+			}
+		}
+		delete(clientCaches, oldest)
+	}
+}
+
+// getClient returns the cached client of kind, creating it with the shared authorizer on first use
+func (c *CloudConfiguration) getClient(kind string, newClient func(autorest.Authorizer) interface{}) (interface{}, error) {
+	cache := c.clientCache()
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if client, ok := cache.clients[kind]; ok {
+		return client, nil
+	}
+	if cache.authorizer == nil {
+		authorizer, err := c.getAuthorizerForResource()
+		if err != nil {
+			return nil, err
+		}
+		cache.authorizer = authorizer
+	}
+	client := newClient(cache.authorizer)
+	cache.clients[kind] = client
+	return client, nil
+}
@@ -0,0 +1,90 @@
+package azhelpers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/awesomenix/azk/azure/armserver"
+)
+
+func TestClientCache(t *testing.T) {
+	var tokens int32
+	server := armserver.NewServer()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/oauth2/token") {
+			atomic.AddInt32(&tokens, 1)
+		}
+		server.ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	c := &CloudConfiguration{
+		CloudName:               AzurePublicCloudName,
+		SubscriptionID:          "subscription",
+		ClientID:                "client",
+		ClientSecret:            "secret",
+		TenantID:                "tenant",
+		GroupName:               "group",
+		GroupLocation:           "westus2",
+		ResourceManagerEndpoint: ts.URL,
+		ActiveDirectoryEndpoint: ts.URL,
+	}
+
+	if err := c.CreateOrUpdateResourceGroup(ctx); err != nil {
+		t.Fatalf("Failed to create resource group: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := c.GetResourceGroup(ctx); err != nil {
+			t.Fatalf("Failed to get resource group: %v", err)
+		}
+		if _, err := c.GetVirtualNetwork(ctx, "azk-vnet"); !ResourceNotFound(err) {
+			t.Fatalf("Expected virtual network to be not found, got %v", err)
+		}
+	}
+	if atomic.LoadInt32(&tokens) != 1 {
+		t.Fatalf("Expected a single token request across clients, got %d", tokens)
+	}
+
+	rotated := *c
+	rotated.ClientSecret = "rotated"
+	if _, err := rotated.GetResourceGroup(ctx); err != nil {
+		t.Fatalf("Failed to get resource group: %v", err)
+	}
+	if atomic.LoadInt32(&tokens) != 2 {
+		t.Fatalf("Expected rotated credentials to request a new token, got %d token requests", tokens)
+	}
+}
+
+func TestClientCacheEviction(t *testing.T) {
+	first := &CloudConfiguration{SubscriptionID: "first"}
+	cache := first.clientCache()
+	for i := 0; i < maxClientCaches; i++ {
+		c := &CloudConfiguration{SubscriptionID: fmt.Sprintf("subscription-%d", i)}
+		c.clientCache()
+		if i == maxClientCaches/2 && first.clientCache() != cache {
+			t.Fatalf("Expected the cache of a recently used identity to be kept")
+		}
+	}
+	if len(clientCaches) > maxClientCaches {
+		t.Fatalf("Expected at most %d cached identities, got %d", maxClientCaches, len(clientCaches))
+	}
+	if first.clientCache() != cache {
+		t.Fatalf("Expected the cache of a recently used identity to be kept")
+	}
+
+	last := &CloudConfiguration{SubscriptionID: fmt.Sprintf("subscription-%d", maxClientCaches-1)}
+	cache = last.clientCache()
+	for i := 0; i < maxClientCaches; i++ {
+		c := &CloudConfiguration{SubscriptionID: fmt.Sprintf("other-%d", i)}
+		c.clientCache()
+	}
+	if last.clientCache() == cache {
+		t.Fatalf("Expected the cache of an unused identity to be dropped")
+	}
+}
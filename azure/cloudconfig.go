@@ -1,47 +1,157 @@
 package azhelpers
 
-import "fmt"
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
 
-func GetAzureCloudProviderConfig(cloudConfig *CloudConfiguration) string {
-	return fmt.Sprintf(`{
-"cloud":"AzurePublicCloud",
-"tenantId": "%[1]s",
-"subscriptionId": "%[2]s",
-"aadClientId": "%[3]s",
-"aadClientSecret": "%[4]s",
-"resourceGroup": "%[5]s",
-"location": "%[6]s",
-"vmType": "vmss",
-"subnetName": "agent-subnet",
-"securityGroupName": "azk-nsg",
-"vnetName": "azk-vnet",
-"vnetResourceGroup": "%[5]s",
-"routeTableName": "azk-routetable",
-"primaryAvailabilitySetName": "",
-"primaryScaleSetName": "",
-"cloudProviderBackoff": true,
-"cloudProviderBackoffRetries": 6,
-"cloudProviderBackoffExponent": 1.5,
-"cloudProviderBackoffDuration": 5,
-"cloudProviderBackoffJitter": 1.0,
-"cloudProviderRatelimit": true,
-"cloudProviderRateLimitQPS": 3.0,
-"cloudProviderRateLimitBucket": 10,
-"useManagedIdentityExtension": false,
-"userAssignedIdentityID": "",
-"useInstanceMetadata": true,
-"loadBalancerSku": "Standard",
-"excludeMasterFromStandardLB": true,
-"providerVaultName": "",
-"maximumLoadBalancerRuleCount": 250,
-"providerKeyName": "k8s",
-"providerKeyVersion": ""
-}`,
-		cloudConfig.TenantID,
-		cloudConfig.SubscriptionID,
-		cloudConfig.ClientID,
-		cloudConfig.ClientSecret,
-		cloudConfig.GroupName,
-		cloudConfig.GroupLocation,
-	)
+const (
+	// CloudEnvironmentPath is where the environment of a custom cloud is written on every node
+	CloudEnvironmentPath   = "/etc/kubernetes/azurestackcloud.json"
+	kubeletEnvironmentPath = "/etc/systemd/system/kubelet.service.d/20-azurestackcloud.conf"
+	// ClientCertificatePath is where the service principal certificate is written on every node,
+	// under the pki directory kubeadm mounts into the control plane pods
+	ClientCertificatePath = "/etc/kubernetes/pki/azure-client.pfx"
+)
+
+// CloudProviderNetwork is the network the azure cloud provider manages, empty fields refer to the
+// network azk creates in the cluster resource group
+type CloudProviderNetwork struct {
+	VNetName                string
+	VNetResourceGroup       string
+	SubnetName              string
+	SecurityGroupName       string
+	RouteTableName          string
+	RouteTableResourceGroup string
+}
+
+func (n CloudProviderNetwork) withDefaults(groupName string) CloudProviderNetwork {
+	for _, field := range []struct {
+		value        *string
+		defaultValue string
+	}{
+		{&n.VNetName, "azk-vnet"},
+		{&n.VNetResourceGroup, groupName},
+		{&n.SubnetName, "agent-subnet"},
+		{&n.SecurityGroupName, "azk-nsg"},
+		{&n.RouteTableName, "azk-routetable"},
+		{&n.RouteTableResourceGroup, groupName},
+	} {
+		if *field.value == "" {
+			*field.value = field.defaultValue
+		}
+	}
+	return n
+}
+
+// azureCloudProviderConfig is the azure.json read by the azure cloud provider
+type azureCloudProviderConfig struct {
+	Cloud                        string  `json:"cloud"`
+	TenantID                     string  `json:"tenantId"`
+	SubscriptionID               string  `json:"subscriptionId"`
+	AADClientID                  string  `json:"aadClientId"`
+	AADClientSecret              string  `json:"aadClientSecret"`
+	AADClientCertPath            string  `json:"aadClientCertPath"`
+	AADClientCertPassword        string  `json:"aadClientCertPassword"`
+	ResourceGroup                string  `json:"resourceGroup"`
+	Location                     string  `json:"location"`
+	VMType                       string  `json:"vmType"`
+	SubnetName                   string  `json:"subnetName"`
+	SecurityGroupName            string  `json:"securityGroupName"`
+	VNetName                     string  `json:"vnetName"`
+	VNetResourceGroup            string  `json:"vnetResourceGroup"`
+	RouteTableName               string  `json:"routeTableName"`
+	RouteTableResourceGroup      string  `json:"routeTableResourceGroup"`
+	PrimaryAvailabilitySetName   string  `json:"primaryAvailabilitySetName"`
+	PrimaryScaleSetName          string  `json:"primaryScaleSetName"`
+	CloudProviderBackoff         bool    `json:"cloudProviderBackoff"`
+	CloudProviderBackoffRetries  int     `json:"cloudProviderBackoffRetries"`
+	CloudProviderBackoffExponent float64 `json:"cloudProviderBackoffExponent"`
+	CloudProviderBackoffDuration int     `json:"cloudProviderBackoffDuration"`
+	CloudProviderBackoffJitter   float64 `json:"cloudProviderBackoffJitter"`
+	CloudProviderRateLimit       bool    `json:"cloudProviderRatelimit"`
+	CloudProviderRateLimitQPS    float64 `json:"cloudProviderRateLimitQPS"`
+	CloudProviderRateLimitBucket int     `json:"cloudProviderRateLimitBucket"`
+	UseManagedIdentityExtension  bool    `json:"useManagedIdentityExtension"`
+	UserAssignedIdentityID       string  `json:"userAssignedIdentityID"`
+	UseInstanceMetadata          bool    `json:"useInstanceMetadata"`
+	LoadBalancerSku              string  `json:"loadBalancerSku"`
+	ExcludeMasterFromStandardLB  bool    `json:"excludeMasterFromStandardLB"`
+	ProviderVaultName            string  `json:"providerVaultName"`
+	MaximumLoadBalancerRuleCount int     `json:"maximumLoadBalancerRuleCount"`
+	ProviderKeyName              string  `json:"providerKeyName"`
+	ProviderKeyVersion           string  `json:"providerKeyVersion"`
+}
+
+func GetAzureCloudProviderConfig(cloudConfig *CloudConfiguration, network CloudProviderNetwork) string {
+	cloudName := cloudConfig.CloudName
+	if cloudName == "" {
+		cloudName = AzurePublicCloudName
+	}
+	// nodes authenticate as the scale set identity whenever one is assigned, otherwise
+	// with the service principal, workload identity tokens are only usable by azk itself
+	useManagedIdentity := cloudConfig.UseManagedIdentity || cloudConfig.UserAssignedIdentityResourceID != ""
+	clientID, clientSecret, clientCertPath, clientCertPassword := cloudConfig.ClientID, cloudConfig.ClientSecret, "", ""
+	if cloudConfig.ClientCertificate != "" {
+		clientCertPath, clientCertPassword = ClientCertificatePath, cloudConfig.ClientCertificatePassword
+	}
+	if useManagedIdentity {
+		clientID, clientSecret, clientCertPath, clientCertPassword = "", "", "", ""
+	}
+	network = network.withDefaults(cloudConfig.GroupName)
+	// user supplied credentials may hold any character, the config is encoded rather than formatted
+	buf, _ := json.MarshalIndent(azureCloudProviderConfig{
+		Cloud:                        cloudName,
+		TenantID:                     cloudConfig.TenantID,
+		SubscriptionID:               cloudConfig.SubscriptionID,
+		AADClientID:                  clientID,
+		AADClientSecret:              clientSecret,
+		AADClientCertPath:            clientCertPath,
+		AADClientCertPassword:        clientCertPassword,
+		ResourceGroup:                cloudConfig.GroupName,
+		Location:                     cloudConfig.GroupLocation,
+		VMType:                       "vmss",
+		SubnetName:                   network.SubnetName,
+		SecurityGroupName:            network.SecurityGroupName,
+		VNetName:                     network.VNetName,
+		VNetResourceGroup:            network.VNetResourceGroup,
+		RouteTableName:               network.RouteTableName,
+		RouteTableResourceGroup:      network.RouteTableResourceGroup,
+		CloudProviderBackoff:         true,
+		CloudProviderBackoffRetries:  6,
+		CloudProviderBackoffExponent: 1.5,
+		CloudProviderBackoffDuration: 5,
+		CloudProviderBackoffJitter:   1.0,
+		CloudProviderRateLimit:       true,
+		CloudProviderRateLimitQPS:    3.0,
+		CloudProviderRateLimitBucket: 10,
+		UseManagedIdentityExtension:  useManagedIdentity,
+		UserAssignedIdentityID:       cloudConfig.UserAssignedIdentityID,
+		UseInstanceMetadata:          true,
+		LoadBalancerSku:              "Standard",
+		ExcludeMasterFromStandardLB:  true,
+		MaximumLoadBalancerRuleCount: 250,
+		ProviderKeyName:              "k8s",
+	}, "", "    ")
+	return string(buf)
+}
+
+// GetCloudProviderFiles returns the files nodes need next to azure.json for the azure cloud
+// provider, the environment of a custom cloud, and the secrets only root may read, the service
+// principal certificate with its private key
+func GetCloudProviderFiles(cloudConfig *CloudConfiguration) (files map[string]string, secrets map[string]string) {
+	files, secrets = map[string]string{}, map[string]string{}
+	if cloudConfig.CloudEnvironment != "" {
+		files[CloudEnvironmentPath] = cloudConfig.CloudEnvironment
+		files[kubeletEnvironmentPath] = fmt.Sprintf(`[Service]
+Environment="AZURE_ENVIRONMENT_FILEPATH=%s"
+`, CloudEnvironmentPath)
+	}
+	if cloudConfig.ClientCertificate != "" && cloudConfig.UserAssignedIdentityResourceID == "" && !cloudConfig.UseManagedIdentity {
+		if data, err := base64.StdEncoding.DecodeString(cloudConfig.ClientCertificate); err == nil {
+			secrets[ClientCertificatePath] = string(data)
+		}
+	}
+	return files, secrets
 }
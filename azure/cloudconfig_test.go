@@ -0,0 +1,66 @@
+package azhelpers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+)
+
+func TestGetCloudProviderFiles(t *testing.T) {
+	config := &CloudConfiguration{
+		CloudEnvironment:  `{"name": "AzureStackCloud"}`,
+		ClientCertificate: base64.StdEncoding.EncodeToString([]byte("pfx")),
+	}
+	files, secrets := GetCloudProviderFiles(config)
+	if files[CloudEnvironmentPath] == "" || files[kubeletEnvironmentPath] == "" {
+		t.Errorf("Expected the cloud environment files, got %v", files)
+	}
+	if _, ok := files[ClientCertificatePath]; ok {
+		t.Errorf("Expected the client certificate not to be written readable by everyone")
+	}
+	if secrets[ClientCertificatePath] != "pfx" {
+		t.Errorf("Expected the decoded client certificate in the secrets, got %v", secrets)
+	}
+
+	config.UseManagedIdentity = true
+	if _, secrets := GetCloudProviderFiles(config); len(secrets) != 0 {
+		t.Errorf("Expected no client certificate with a managed identity, got %v", secrets)
+	}
+}
+
+func TestGetAzureCloudProviderConfigCredentials(t *testing.T) {
+	tests := []struct {
+		name   string
+		config CloudConfiguration
+		want   map[string]interface{}
+	}{
+		{
+			name:   "client secret",
+			config: CloudConfiguration{ClientID: "client", ClientSecret: `se"cr\et`},
+			want:   map[string]interface{}{"aadClientSecret": `se"cr\et`, "aadClientCertPath": "", "aadClientCertPassword": ""},
+		},
+		{
+			name:   "client certificate",
+			config: CloudConfiguration{ClientID: "client", ClientCertificate: "cGZ4", ClientCertificatePassword: `pass"word\", "cloud": "other`},
+			want:   map[string]interface{}{"aadClientCertPath": ClientCertificatePath, "aadClientCertPassword": `pass"word\", "cloud": "other`, "cloud": AzurePublicCloudName},
+		},
+		{
+			name:   "managed identity",
+			config: CloudConfiguration{ClientID: "client", ClientCertificate: "cGZ4", ClientCertificatePassword: "password", UseManagedIdentity: true},
+			want:   map[string]interface{}{"aadClientId": "", "aadClientCertPath": "", "aadClientCertPassword": "", "useManagedIdentityExtension": true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := map[string]interface{}{}
+			if err := json.Unmarshal([]byte(GetAzureCloudProviderConfig(&tt.config, CloudProviderNetwork{})), &config); err != nil {
+				t.Fatalf("Failed to parse cloud provider config: %v", err)
+			}
+			for key, value := range tt.want {
+				if config[key] != value {
+					t.Errorf("Expected %s %v, got %v", key, value, config[key])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+
+	azhelpers "github.com/awesomenix/azk/azure"
+)
+
+func (p *provider) GetVMSS(ctx context.Context, vmssName string) (compute.VirtualMachineScaleSet, error) {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	g, err := p.get("GetVMSS")
+	if err != nil {
+		return compute.VirtualMachineScaleSet{}, err
+	}
+	set, ok := g.vmss[key(vmssName)]
+	if !ok {
+		return compute.VirtualMachineScaleSet{}, notFound("VirtualMachineScaleSet", vmssName)
+	}
+	return set.vmss, nil
+}
+
+func (p *provider) ListVMSS(ctx context.Context) ([]compute.VirtualMachineScaleSet, error) {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	g, err := p.get("ListVMSS")
+	if err != nil {
+		return nil, err
+	}
+	var vmss []compute.VirtualMachineScaleSet
+	for _, set := range g.vmss {
+		vmss = append(vmss, set.vmss)
+	}
+	sort.Slice(vmss, func(i, j int) bool { return *vmss[i].Name < *vmss[j].Name })
+	return vmss, nil
+}
+
+func (p *provider) CreateVMSS(ctx context.Context, vmssName, subnetID string, loadbalancerIDs, natPoolIDs []string, customData, vmSKUType string, count, ipConfigurations int) error {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	g, err := p.start("CreateVMSS", vmssName)
+	if err != nil {
+		return err
+	}
+	skus := azhelpers.NewSKUCatalog(func(context.Context) ([]compute.ResourceSku, error) {
+		return p.cloud.SKUs, nil
+	}, 0)
+	// like a local stand-in of the resource manager, a cloud without skus accepts any size
+	skus.SkipUnlistedLocations = len(p.cloud.SKUs) == 0
+	sku, err := skus.Check(ctx, p.config.GroupLocation, vmSKUType)
+	if err != nil {
+		return err
+	}
+
+	ipConfigs := azhelpers.VMSSIPConfigurations(vmssName, subnetID, loadbalancerIDs, natPoolIDs, ipConfigurations)
+
+	set, ok := g.vmss[key(vmssName)]
+	if !ok {
+		set = &scaleSet{}
+		g.vmss[key(vmssName)] = set
+	}
+	set.vmss = compute.VirtualMachineScaleSet{
+		ID:       to.StringPtr(p.id("Microsoft.Compute", "virtualMachineScaleSets", vmssName)),
+		Name:     to.StringPtr(vmssName),
+		Location: to.StringPtr(p.config.GroupLocation),
+		Sku: &compute.Sku{
+			Name:     to.StringPtr(vmSKUType),
+			Tier:     to.StringPtr("Standard"),
+			Capacity: to.Int64Ptr(int64(count)),
+		},
+		VirtualMachineScaleSetProperties: &compute.VirtualMachineScaleSetProperties{
+			UpgradePolicy: &compute.UpgradePolicy{Mode: compute.Manual},
+			VirtualMachineProfile: &compute.VirtualMachineScaleSetVMProfile{
+				OsProfile: &compute.VirtualMachineScaleSetOSProfile{
+					ComputerNamePrefix: to.StringPtr(vmssName),
+					AdminUsername:      to.StringPtr("azureuser"),
+					CustomData:         to.StringPtr(customData),
+				},
+				NetworkProfile: &compute.VirtualMachineScaleSetNetworkProfile{
+					NetworkInterfaceConfigurations: &[]compute.VirtualMachineScaleSetNetworkConfiguration{
+						{
+							Name: to.StringPtr(vmssName),
+							VirtualMachineScaleSetNetworkConfigurationProperties: &compute.VirtualMachineScaleSetNetworkConfigurationProperties{
+								Primary:          to.BoolPtr(true),
+								IPConfigurations: &ipConfigs,
+							},
+						},
+					},
+				},
+			},
+			ProvisioningState: succeeded(),
+		},
+	}
+	if len(sku.Zones) > 0 {
+		set.vmss.Zones = &sku.Zones
+	}
+	p.scale(vmssName, set, count)
+	return nil
+}
+
+func (p *provider) ScaleVMSS(ctx context.Context, vmssName string, customData string, count int) error {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	g, err := p.start("ScaleVMSS", vmssName)
+	if err != nil {
+		return err
+	}
+	set, ok := g.vmss[key(vmssName)]
+	if !ok {
+		return fmt.Errorf("cannot update vmss: %v", notFound("VirtualMachineScaleSet", vmssName))
+	}
+	if *set.vmss.Sku.Capacity == int64(count) {
+		return nil
+	}
+	set.vmss.VirtualMachineProfile.OsProfile.CustomData = to.StringPtr(customData)
+	p.scale(vmssName, set, count)
+	return nil
+}
+
+// scale adds instances with increasing instance ids or removes the newest instances
+// until the scale set has count instances
+func (p *provider) scale(vmssName string, set *scaleSet, count int) {
+	for len(set.vms) > count {
+		set.vms = set.vms[:len(set.vms)-1]
+	}
+	for len(set.vms) < count {
+		instanceID := strconv.FormatInt(set.nextInstanceID, 10)
+		computerName := fmt.Sprintf("%s%06s", vmssName, strconv.FormatInt(set.nextInstanceID, 36))
+		set.nextInstanceID++
+		set.vms = append(set.vms, compute.VirtualMachineScaleSetVM{
+			ID:         to.StringPtr(*set.vmss.ID + "/virtualMachines/" + instanceID),
+			Name:       to.StringPtr(vmssName + "_" + instanceID),
+			InstanceID: to.StringPtr(instanceID),
+			Location:   to.StringPtr(p.config.GroupLocation),
+			VirtualMachineScaleSetVMProperties: &compute.VirtualMachineScaleSetVMProperties{
+				LatestModelApplied: to.BoolPtr(true),
+				OsProfile: &compute.OSProfile{
+					ComputerName: to.StringPtr(computerName),
+				},
+				ProvisioningState: succeeded(),
+			},
+		})
+	}
+	set.vmss.Sku.Capacity = to.Int64Ptr(int64(len(set.vms)))
+}
+
+func (p *provider) DeleteVMSS(ctx context.Context, vmssName string) error {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	g, err := p.start("DeleteVMSS", vmssName)
+	if err != nil {
+		return err
+	}
+	if _, ok := g.vmss[key(vmssName)]; !ok {
+		return notFound("VirtualMachineScaleSet", vmssName)
+	}
+	delete(g.vmss, key(vmssName))
+	return nil
+}
+
+func (p *provider) ListVMSSVMs(ctx context.Context, vmssName string) ([]compute.VirtualMachineScaleSetVM, error) {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	g, err := p.get("ListVMSSVMs")
+	if err != nil {
+		return nil, err
+	}
+	set, ok := g.vmss[key(vmssName)]
+	if !ok {
+		return nil, notFound("VirtualMachineScaleSet", vmssName)
+	}
+	return append([]compute.VirtualMachineScaleSetVM(nil), set.vms...), nil
+}
+
+// instance returns the scale set and index of the instance, the caller must hold the lock
+func (p *provider) instance(g *resourceGroup, vmssName, instanceID string) (*scaleSet, int, error) {
+	set, ok := g.vmss[key(vmssName)]
+	if !ok {
+		return nil, 0, notFound("VirtualMachineScaleSet", vmssName)
+	}
+	for i, vm := range set.vms {
+		if *vm.InstanceID == instanceID {
+			return set, i, nil
+		}
+	}
+	return nil, 0, notFound("VirtualMachineScaleSetVM", vmssName+"_"+instanceID)
+}
+
+func (p *provider) DeleteVMSSVM(ctx context.Context, vmssName, instanceID string) error {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	g, err := p.start("DeleteVMSSVM", vmssName+"_"+instanceID)
+	if err != nil {
+		return err
+	}
+	set, i, err := p.instance(g, vmssName, instanceID)
+	if err != nil {
+		return err
+	}
+	set.vms = append(set.vms[:i], set.vms[i+1:]...)
+	set.vmss.Sku.Capacity = to.Int64Ptr(int64(len(set.vms)))
+	return nil
+}
+
+func (p *provider) ReimageVMSSVM(ctx context.Context, vmssName, instanceID string) error {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	g, err := p.start("ReimageVMSSVM", vmssName+"_"+instanceID)
+	if err != nil {
+		return err
+	}
+	set, i, err := p.instance(g, vmssName, instanceID)
+	if err != nil {
+		return err
+	}
+	set.vms[i].LatestModelApplied = to.BoolPtr(true)
+	return nil
+}
+
+func (p *provider) RunCommandVMSSVM(ctx context.Context, vmssName, instanceID string, input compute.RunCommandInput) (compute.RunCommandResult, error) {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	g, err := p.start("RunCommandVMSSVM", vmssName+"_"+instanceID)
+	if err != nil {
+		return compute.RunCommandResult{}, err
+	}
+	if _, _, err := p.instance(g, vmssName, instanceID); err != nil {
+		return compute.RunCommandResult{}, err
+	}
+	output := p.cloud.RunCommandOutput
+	if p.cloud.RunCommand != nil && input.Script != nil {
+		output = p.cloud.RunCommand(vmssName, instanceID, strings.Join(*input.Script, "\n"))
+	}
+	return compute.RunCommandResult{
+		Value: &[]compute.InstanceViewStatus{
+			{
+				Code:          to.StringPtr("ProvisioningState/succeeded"),
+				Level:         compute.Info,
+				DisplayStatus: to.StringPtr("Provisioning succeeded"),
+				Message:       to.StringPtr(output),
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,217 @@
+// Package fake provides an in-memory azure provider so controllers and the bootstrap
+// package can be tested without a subscription
+package fake
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-02-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-03-01/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+
+	azhelpers "github.com/awesomenix/azk/azure"
+)
+
+// OperationStatus is the state of a long running operation
+type OperationStatus string
+
+const (
+	OperationInProgress OperationStatus = "InProgress"
+	OperationSucceeded  OperationStatus = "Succeeded"
+	OperationFailed     OperationStatus = "Failed"
+)
+
+// Operation is a long running operation started against the fake cloud
+type Operation struct {
+	// Name is the provider method which started the operation, e.g. CreateVMSS
+	Name          string
+	ResourceGroup string
+	Resource      string
+	Status        OperationStatus
+	Err           error
+}
+
+// Cloud is an in-memory azure, shared by every provider it returns so that
+// resource state survives across reconciles
+type Cloud struct {
+	// SKUs is returned by ListResourceSKUs and used to validate vm sizes and place scale sets in
+	// zones, any vm size is accepted without skus
+	SKUs []compute.ResourceSku
+	// RunCommandOutput is the message returned by RunCommandVMSSVM
+	RunCommandOutput string
+	// RunCommand returns the message of the script RunCommandVMSSVM runs on an instance instead of
+	// RunCommandOutput when set, it must not call the cloud
+	RunCommand func(vmssName, instanceID, script string) string
+
+	mu         sync.Mutex
+	groups     map[string]*resourceGroup
+	operations []Operation
+	failures   map[string][]error
+	addresses  int
+}
+
+type resourceGroup struct {
+	group       resources.Group
+	nsgs        map[string]network.SecurityGroup
+	routeTables map[string]network.RouteTable
+	vnets       map[string]network.VirtualNetwork
+	pips        map[string]network.PublicIPAddress
+	lbs         map[string]network.LoadBalancer
+	natGateways map[string]network.NatGateway
+	zones       map[string]*privateZone
+	vmss        map[string]*scaleSet
+}
+
+type scaleSet struct {
+	vmss           compute.VirtualMachineScaleSet
+	vms            []compute.VirtualMachineScaleSetVM
+	nextInstanceID int64
+}
+
+// NewCloud returns an empty fake cloud
+func NewCloud() *Cloud {
+	return &Cloud{
+		RunCommandOutput: "Enable succeeded",
+		groups:           map[string]*resourceGroup{},
+		failures:         map[string][]error{},
+	}
+}
+
+// Provider implements azhelpers.ProviderFactory
+func (f *Cloud) Provider(c *azhelpers.CloudConfiguration) (azhelpers.Provider, error) {
+	return &provider{cloud: f, config: *c}, nil
+}
+
+// PutVirtualNetwork stores an existing vnet in a resource group of the subscription, creating the
+// group if needed, so deployments into networks azk does not own can be tested
+func (f *Cloud) PutVirtualNetwork(subscriptionID, groupName string, vnet network.VirtualNetwork) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	groupKey := key(subscriptionID + "/" + groupName)
+	g, ok := f.groups[groupKey]
+	if !ok {
+		g = newResourceGroup()
+		g.group = resources.Group{
+			ID:   to.StringPtr("/subscriptions/" + subscriptionID + "/resourceGroups/" + groupName),
+			Name: to.StringPtr(groupName),
+		}
+		f.groups[groupKey] = g
+	}
+	g.vnets[key(*vnet.Name)] = vnet
+}
+
+// FailNext makes the next operation of the named provider method fail with err
+func (f *Cloud) FailNext(name string, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.failures[name] = append(f.failures[name], err)
+}
+
+// Operations returns every long running operation started so far
+func (f *Cloud) Operations() []Operation {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]Operation(nil), f.operations...)
+}
+
+// begin starts a long running operation, the caller must hold the lock
+func (f *Cloud) begin(name, group, resource string) int {
+	f.operations = append(f.operations, Operation{
+		Name:          name,
+		ResourceGroup: group,
+		Resource:      resource,
+		Status:        OperationInProgress,
+	})
+	return len(f.operations) - 1
+}
+
+// complete finishes a long running operation with the injected failure if any,
+// otherwise with err, the caller must hold the lock
+func (f *Cloud) complete(op int, err error) error {
+	name := f.operations[op].Name
+	if failures := f.failures[name]; len(failures) > 0 {
+		err = failures[0]
+		f.failures[name] = failures[1:]
+	}
+	f.operations[op].Status = OperationSucceeded
+	if err != nil {
+		f.operations[op].Status = OperationFailed
+		f.operations[op].Err = err
+	}
+	return err
+}
+
+// injected returns the injected failure of a synchronous call, the caller must hold the lock
+func (f *Cloud) injected(name string) error {
+	if failures := f.failures[name]; len(failures) > 0 {
+		f.failures[name] = failures[1:]
+		return failures[0]
+	}
+	return nil
+}
+
+func notFound(resourceType, name string) error {
+	return autorest.DetailedError{
+		StatusCode: http.StatusNotFound,
+		Message:    fmt.Sprintf("%s %s was not found", resourceType, name),
+	}
+}
+
+func key(name string) string {
+	return strings.ToLower(name)
+}
+
+func succeeded() *string {
+	return to.StringPtr(string(OperationSucceeded))
+}
+
+// provider is a view of the cloud bound to one subscription and resource group
+type provider struct {
+	cloud  *Cloud
+	config azhelpers.CloudConfiguration
+}
+
+var _ azhelpers.Provider = &provider{}
+
+func (p *provider) groupKey() string {
+	return key(p.config.SubscriptionID + "/" + p.config.GroupName)
+}
+
+// group returns the resource group of the provider, the caller must hold the lock
+func (p *provider) group() (*resourceGroup, error) {
+	g, ok := p.cloud.groups[p.groupKey()]
+	if !ok {
+		return nil, notFound("ResourceGroup", p.config.GroupName)
+	}
+	return g, nil
+}
+
+// start runs a long running operation against the resource group of the provider,
+// the caller must hold the lock and only change state once it returns without error
+func (p *provider) start(name, resource string) (*resourceGroup, error) {
+	op := p.cloud.begin(name, p.config.GroupName, resource)
+	g, err := p.group()
+	return g, p.cloud.complete(op, err)
+}
+
+// get returns the resource group for a synchronous read, the caller must hold the lock
+func (p *provider) get(name string) (*resourceGroup, error) {
+	if err := p.cloud.injected(name); err != nil {
+		return nil, err
+	}
+	return p.group()
+}
+
+func (p *provider) id(resourceProvider, resourceType, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/%s/%s/%s",
+		p.config.SubscriptionID, p.config.GroupName, resourceProvider, resourceType, name)
+}
+
+func (p *provider) networkID(resourceType, name string) string {
+	return p.id("Microsoft.Network", resourceType, name)
+}
@@ -0,0 +1,547 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-02-01/network"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/to"
+
+	azhelpers "github.com/awesomenix/azk/azure"
+)
+
+func (p *provider) GetNetworkSecurityGroup(ctx context.Context, nsgName string) (network.SecurityGroup, error) {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	g, err := p.get("GetNetworkSecurityGroup")
+	if err != nil {
+		return network.SecurityGroup{}, err
+	}
+	nsg, ok := g.nsgs[key(nsgName)]
+	if !ok {
+		return network.SecurityGroup{}, notFound("NetworkSecurityGroup", nsgName)
+	}
+	return nsg, nil
+}
+
+func (p *provider) CreateNetworkSecurityGroup(ctx context.Context, nsgName string) (network.SecurityGroup, error) {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	return p.createNetworkSecurityGroup("CreateNetworkSecurityGroup", nsgName, &[]network.SecurityRule{
+		p.securityRule(nsgName, "allow_ssh", "22", 100),
+		p.securityRule(nsgName, "allow_6443", "6443", 101),
+	})
+}
+
+func (p *provider) CreateDefaultNetworkSecurityGroup(ctx context.Context, nsgName string) (network.SecurityGroup, error) {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	return p.createNetworkSecurityGroup("CreateDefaultNetworkSecurityGroup", nsgName, nil)
+}
+
+func (p *provider) UpdateNetworkSecurityGroupRules(ctx context.Context, nsgName string, rules []network.SecurityRule) error {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	g, err := p.get("UpdateNetworkSecurityGroupRules")
+	if err != nil {
+		return err
+	}
+	if _, ok := g.nsgs[key(nsgName)]; !ok {
+		return notFound("NetworkSecurityGroup", nsgName)
+	}
+	updated := make([]network.SecurityRule, len(rules))
+	for i, rule := range rules {
+		updated[i] = rule
+		updated[i].ID = to.StringPtr(p.networkID("networkSecurityGroups", nsgName) + "/securityRules/" + *rule.Name)
+	}
+	_, err = p.createNetworkSecurityGroup("UpdateNetworkSecurityGroupRules", nsgName, &updated)
+	return err
+}
+
+func (p *provider) securityRule(nsgName, name, port string, priority int32) network.SecurityRule {
+	return network.SecurityRule{
+		ID:   to.StringPtr(p.networkID("networkSecurityGroups", nsgName) + "/securityRules/" + name),
+		Name: to.StringPtr(name),
+		SecurityRulePropertiesFormat: &network.SecurityRulePropertiesFormat{
+			Protocol:                 network.SecurityRuleProtocolTCP,
+			SourceAddressPrefix:      to.StringPtr("*"),
+			SourcePortRange:          to.StringPtr("*"),
+			DestinationAddressPrefix: to.StringPtr("*"),
+			DestinationPortRange:     to.StringPtr(port),
+			Access:                   network.SecurityRuleAccessAllow,
+			Direction:                network.SecurityRuleDirectionInbound,
+			Priority:                 to.Int32Ptr(priority),
+			ProvisioningState:        succeeded(),
+		},
+	}
+}
+
+// createNetworkSecurityGroup creates or updates a security group, nil rules preserve
+// the rules of an existing group, the caller must hold the lock
+func (p *provider) createNetworkSecurityGroup(name, nsgName string, rules *[]network.SecurityRule) (network.SecurityGroup, error) {
+	g, err := p.start(name, nsgName)
+	if err != nil {
+		return network.SecurityGroup{}, err
+	}
+	if existing, ok := g.nsgs[key(nsgName)]; ok && rules == nil {
+		rules = existing.SecurityRules
+	}
+	nsg := network.SecurityGroup{
+		ID:       to.StringPtr(p.networkID("networkSecurityGroups", nsgName)),
+		Name:     to.StringPtr(nsgName),
+		Location: to.StringPtr(p.config.GroupLocation),
+		SecurityGroupPropertiesFormat: &network.SecurityGroupPropertiesFormat{
+			SecurityRules:     rules,
+			ProvisioningState: succeeded(),
+		},
+	}
+	g.nsgs[key(nsgName)] = nsg
+	return nsg, nil
+}
+
+func (p *provider) DeleteNetworkSecurityGroup(ctx context.Context, nsgName string) error {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	g, err := p.start("DeleteNetworkSecurityGroup", nsgName)
+	if err != nil {
+		return err
+	}
+	delete(g.nsgs, key(nsgName))
+	return nil
+}
+
+func (p *provider) GetRouteTable(ctx context.Context, routeTableName string) (network.RouteTable, error) {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	g, err := p.get("GetRouteTable")
+	if err != nil {
+		return network.RouteTable{}, err
+	}
+	routeTable, ok := g.routeTables[key(routeTableName)]
+	if !ok {
+		return network.RouteTable{}, notFound("RouteTable", routeTableName)
+	}
+	return routeTable, nil
+}
+
+func (p *provider) CreateRouteTables(ctx context.Context, routeTableName string) (network.RouteTable, error) {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	return p.createRouteTable(routeTableName)
+}
+
+// createRouteTable creates or updates a route table preserving existing routes, the caller must hold the lock
+func (p *provider) createRouteTable(routeTableName string) (network.RouteTable, error) {
+	g, err := p.start("CreateRouteTables", routeTableName)
+	if err != nil {
+		return network.RouteTable{}, err
+	}
+	var routes *[]network.Route
+	if existing, ok := g.routeTables[key(routeTableName)]; ok {
+		routes = existing.Routes
+	}
+	routeTable := network.RouteTable{
+		ID:       to.StringPtr(p.networkID("routeTables", routeTableName)),
+		Name:     to.StringPtr(routeTableName),
+		Location: to.StringPtr(p.config.GroupLocation),
+		RouteTablePropertiesFormat: &network.RouteTablePropertiesFormat{
+			Routes:            routes,
+			ProvisioningState: succeeded(),
+		},
+	}
+	g.routeTables[key(routeTableName)] = routeTable
+	return routeTable, nil
+}
+
+func (p *provider) DeleteRouteTables(ctx context.Context, routeTableName string) error {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	g, err := p.start("DeleteRouteTables", routeTableName)
+	if err != nil {
+		return err
+	}
+	delete(g.routeTables, key(routeTableName))
+	return nil
+}
+
+func (p *provider) GetVirtualNetwork(ctx context.Context, vnetName string) (network.VirtualNetwork, error) {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	g, err := p.get("GetVirtualNetwork")
+	if err != nil {
+		return network.VirtualNetwork{}, err
+	}
+	vnet, ok := g.vnets[key(vnetName)]
+	if !ok {
+		return network.VirtualNetwork{}, notFound("VirtualNetwork", vnetName)
+	}
+	return vnet, nil
+}
+
+// CreateVirtualNetworkAndSubnets mirrors the azure implementation, creating the security
+// groups and route table the subnets are associated with
+func (p *provider) CreateVirtualNetworkAndSubnets(ctx context.Context, vnetName, vnetCIDR, masterSubnetCIDR, agentSubnetCIDR, natGatewayID string) error {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+
+	nsg, err := p.createNetworkSecurityGroup("CreateDefaultNetworkSecurityGroup", "azk-nsg", nil)
+	if err != nil {
+		return err
+	}
+	masterNSG, err := p.createNetworkSecurityGroup("CreateDefaultNetworkSecurityGroup", "azk-master-nsg", nil)
+	if err != nil {
+		return err
+	}
+	routeTable, err := p.createRouteTable("azk-routetable")
+	if err != nil {
+		return err
+	}
+
+	g, err := p.start("CreateVirtualNetworkAndSubnets", vnetName)
+	if err != nil {
+		return err
+	}
+	var natGateway *network.SubResource
+	if natGatewayID != "" {
+		natGateway = &network.SubResource{ID: to.StringPtr(natGatewayID)}
+	}
+	vnetID := p.networkID("virtualNetworks", vnetName)
+	g.vnets[key(vnetName)] = network.VirtualNetwork{
+		ID:       to.StringPtr(vnetID),
+		Name:     to.StringPtr(vnetName),
+		Location: to.StringPtr(p.config.GroupLocation),
+		VirtualNetworkPropertiesFormat: &network.VirtualNetworkPropertiesFormat{
+			AddressSpace: &network.AddressSpace{
+				AddressPrefixes: &[]string{vnetCIDR},
+			},
+			Subnets: &[]network.Subnet{
+				{
+					ID:   to.StringPtr(vnetID + "/subnets/master-subnet"),
+					Name: to.StringPtr("master-subnet"),
+					SubnetPropertiesFormat: &network.SubnetPropertiesFormat{
+						AddressPrefix:        to.StringPtr(masterSubnetCIDR),
+						NetworkSecurityGroup: &network.SecurityGroup{ID: masterNSG.ID},
+						NatGateway:           natGateway,
+						ProvisioningState:    succeeded(),
+					},
+				},
+				{
+					ID:   to.StringPtr(vnetID + "/subnets/agent-subnet"),
+					Name: to.StringPtr("agent-subnet"),
+					SubnetPropertiesFormat: &network.SubnetPropertiesFormat{
+						AddressPrefix:        to.StringPtr(agentSubnetCIDR),
+						NetworkSecurityGroup: &network.SecurityGroup{ID: nsg.ID},
+						RouteTable:           &network.RouteTable{ID: routeTable.ID},
+						NatGateway:           natGateway,
+						ProvisioningState:    succeeded(),
+					},
+				},
+			},
+			ProvisioningState: succeeded(),
+		},
+	}
+	return nil
+}
+
+// vnetByID returns a vnet of any resource group of the cloud, the caller must hold the lock
+func (p *provider) vnetByID(vnetID string) (network.VirtualNetwork, error) {
+	resource, err := azure.ParseResourceID(vnetID)
+	if err != nil {
+		return network.VirtualNetwork{}, err
+	}
+	g, ok := p.cloud.groups[key(resource.SubscriptionID+"/"+resource.ResourceGroup)]
+	if !ok {
+		return network.VirtualNetwork{}, notFound("ResourceGroup", resource.ResourceGroup)
+	}
+	vnet, ok := g.vnets[key(resource.ResourceName)]
+	if !ok {
+		return network.VirtualNetwork{}, notFound("VirtualNetwork", resource.ResourceName)
+	}
+	return vnet, nil
+}
+
+func (p *provider) GetSubnet(ctx context.Context, vnetName, subnetName string) (network.Subnet, error) {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	g, err := p.get("GetSubnet")
+	if err != nil {
+		return network.Subnet{}, err
+	}
+	return p.subnet(g, vnetName, subnetName)
+}
+
+func (p *provider) GetSubnetByID(ctx context.Context, subnetID string) (network.Subnet, error) {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	if err := p.cloud.injected("GetSubnetByID"); err != nil {
+		return network.Subnet{}, err
+	}
+	return p.subnetByID(subnetID)
+}
+
+// subnetByID returns a subnet of any resource group of the cloud, the caller must hold the lock
+func (p *provider) subnetByID(subnetID string) (network.Subnet, error) {
+	resource, err := azhelpers.ParseSubnetID(subnetID)
+	if err != nil {
+		return network.Subnet{}, err
+	}
+	g, ok := p.cloud.groups[key(resource.SubscriptionID+"/"+resource.ResourceGroup)]
+	if !ok {
+		return network.Subnet{}, notFound("ResourceGroup", resource.ResourceGroup)
+	}
+	return p.subnet(g, resource.VNetName, resource.Name)
+}
+
+func (p *provider) subnet(g *resourceGroup, vnetName, subnetName string) (network.Subnet, error) {
+	vnet, ok := g.vnets[key(vnetName)]
+	if !ok {
+		return network.Subnet{}, notFound("VirtualNetwork", vnetName)
+	}
+	if vnet.Subnets != nil {
+		for _, subnet := range *vnet.Subnets {
+			if subnet.Name != nil && strings.EqualFold(*subnet.Name, subnetName) {
+				return subnet, nil
+			}
+		}
+	}
+	return network.Subnet{}, notFound("Subnet", subnetName)
+}
+
+func (p *provider) GetPublicIP(ctx context.Context, ipName string) (network.PublicIPAddress, error) {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	g, err := p.get("GetPublicIP")
+	if err != nil {
+		return network.PublicIPAddress{}, err
+	}
+	pip, ok := g.pips[key(ipName)]
+	if !ok {
+		return network.PublicIPAddress{}, notFound("PublicIPAddress", ipName)
+	}
+	return pip, nil
+}
+
+func (p *provider) CreatePublicIP(ctx context.Context, ipName string) (network.PublicIPAddress, error) {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	return p.createPublicIP(ipName)
+}
+
+// createPublicIP allocates a static address once and keeps it across updates, the caller must hold the lock
+func (p *provider) createPublicIP(ipName string) (network.PublicIPAddress, error) {
+	g, err := p.start("CreatePublicIP", ipName)
+	if err != nil {
+		return network.PublicIPAddress{}, err
+	}
+	if pip, ok := g.pips[key(ipName)]; ok {
+		return pip, nil
+	}
+	p.cloud.addresses++
+	pip := network.PublicIPAddress{
+		ID:       to.StringPtr(p.networkID("publicIPAddresses", ipName)),
+		Name:     to.StringPtr(ipName),
+		Location: to.StringPtr(p.config.GroupLocation),
+		Sku:      &network.PublicIPAddressSku{Name: network.PublicIPAddressSkuNameStandard},
+		PublicIPAddressPropertiesFormat: &network.PublicIPAddressPropertiesFormat{
+			PublicIPAddressVersion:   network.IPv4,
+			PublicIPAllocationMethod: network.Static,
+			IPAddress:                to.StringPtr(fmt.Sprintf("20.0.%d.%d", p.cloud.addresses/256, p.cloud.addresses%256)),
+			DNSSettings: &network.PublicIPAddressDNSSettings{
+				DomainNameLabel: to.StringPtr(strings.ToLower(ipName)),
+				Fqdn:            to.StringPtr(p.config.PublicDNSName(ipName)),
+			},
+			ProvisioningState: succeeded(),
+		},
+	}
+	g.pips[key(ipName)] = pip
+	return pip, nil
+}
+
+func (p *provider) GetLoadBalancer(ctx context.Context, lbName string) (network.LoadBalancer, error) {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	g, err := p.get("GetLoadBalancer")
+	if err != nil {
+		return network.LoadBalancer{}, err
+	}
+	lb, ok := g.lbs[key(lbName)]
+	if !ok {
+		return network.LoadBalancer{}, notFound("LoadBalancer", lbName)
+	}
+	return lb, nil
+}
+
+func (p *provider) CreateLoadBalancer(ctx context.Context, lbName, pipName string) error {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+
+	pip, err := p.createPublicIP(pipName)
+	if err != nil {
+		return err
+	}
+	g, err := p.start("CreateLoadBalancer", lbName)
+	if err != nil {
+		return err
+	}
+
+	lbID := p.networkID("loadBalancers", lbName)
+	g.lbs[key(lbName)] = network.LoadBalancer{
+		ID:       to.StringPtr(lbID),
+		Name:     to.StringPtr(lbName),
+		Location: to.StringPtr(p.config.GroupLocation),
+		Sku:      &network.LoadBalancerSku{Name: network.LoadBalancerSkuNameStandard},
+		LoadBalancerPropertiesFormat: &network.LoadBalancerPropertiesFormat{
+			FrontendIPConfigurations: &[]network.FrontendIPConfiguration{
+				{
+					ID:   to.StringPtr(lbID + "/frontendIPConfigurations/master-lbFrontEnd"),
+					Name: to.StringPtr("master-lbFrontEnd"),
+					FrontendIPConfigurationPropertiesFormat: &network.FrontendIPConfigurationPropertiesFormat{
+						PrivateIPAllocationMethod: network.Dynamic,
+						PublicIPAddress:           &network.PublicIPAddress{ID: pip.ID},
+					},
+				},
+			},
+			BackendAddressPools: &[]network.BackendAddressPool{
+				{ID: to.StringPtr(lbID + "/backendAddressPools/master-backEndPool"), Name: to.StringPtr("master-backEndPool")},
+			},
+			Probes: &[]network.Probe{
+				{ID: to.StringPtr(lbID + "/probes/httpsProbe"), Name: to.StringPtr("httpsProbe")},
+			},
+			LoadBalancingRules: &[]network.LoadBalancingRule{
+				{ID: to.StringPtr(lbID + "/loadBalancingRules/LBRuleHTTPS"), Name: to.StringPtr("LBRuleHTTPS")},
+			},
+			InboundNatPools: &[]network.InboundNatPool{
+				{ID: to.StringPtr(lbID + "/inboundNatPools/natSSHPool"), Name: to.StringPtr("natSSHPool")},
+			},
+			ProvisioningState: succeeded(),
+		},
+	}
+	return nil
+}
+
+func (p *provider) CreateInternalLoadBalancer(ctx context.Context, subnetID, lbName, privateIPAddress string) error {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+
+	subnet, err := p.subnetByID(subnetID)
+	if err != nil {
+		return err
+	}
+	g, err := p.start("CreateInternalLoadBalancer", lbName)
+	if err != nil {
+		return err
+	}
+
+	lbID := p.networkID("loadBalancers", lbName)
+	g.lbs[key(lbName)] = network.LoadBalancer{
+		ID:       to.StringPtr(lbID),
+		Name:     to.StringPtr(lbName),
+		Location: to.StringPtr(p.config.GroupLocation),
+		Sku:      &network.LoadBalancerSku{Name: network.LoadBalancerSkuNameStandard},
+		LoadBalancerPropertiesFormat: &network.LoadBalancerPropertiesFormat{
+			FrontendIPConfigurations: &[]network.FrontendIPConfiguration{
+				{
+					ID:   to.StringPtr(lbID + "/frontendIPConfigurations/master-internal-lbFrontEnd"),
+					Name: to.StringPtr("master-internal-lbFrontEnd"),
+					FrontendIPConfigurationPropertiesFormat: &network.FrontendIPConfigurationPropertiesFormat{
+						PrivateIPAllocationMethod: network.Static,
+						PrivateIPAddress:          to.StringPtr(privateIPAddress),
+						Subnet:                    &network.Subnet{ID: subnet.ID},
+					},
+				},
+			},
+			BackendAddressPools: &[]network.BackendAddressPool{
+				{ID: to.StringPtr(lbID + "/backendAddressPools/master-internal-backEndPool"), Name: to.StringPtr("master-internal-backEndPool")},
+			},
+			Probes: &[]network.Probe{
+				{ID: to.StringPtr(lbID + "/probes/httpsProbe"), Name: to.StringPtr("httpsProbe")},
+			},
+			LoadBalancingRules: &[]network.LoadBalancingRule{
+				{ID: to.StringPtr(lbID + "/loadBalancingRules/LBRuleHTTPS"), Name: to.StringPtr("LBRuleHTTPS")},
+			},
+			ProvisioningState: succeeded(),
+		},
+	}
+	return nil
+}
+
+func (p *provider) DeleteLoadBalancer(ctx context.Context, lbName string) error {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	g, err := p.start("DeleteLoadBalancer", lbName)
+	if err != nil {
+		return err
+	}
+	delete(g.lbs, key(lbName))
+	return nil
+}
+
+func (p *provider) GetNatGateway(ctx context.Context, natGatewayName string) (network.NatGateway, error) {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	g, err := p.get("GetNatGateway")
+	if err != nil {
+		return network.NatGateway{}, err
+	}
+	natGateway, ok := g.natGateways[key(natGatewayName)]
+	if !ok {
+		return network.NatGateway{}, notFound("NatGateway", natGatewayName)
+	}
+	return natGateway, nil
+}
+
+func (p *provider) CreateNatGateway(ctx context.Context, natGatewayName, pipName string) (network.NatGateway, error) {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+
+	pip, err := p.createPublicIP(pipName)
+	if err != nil {
+		return network.NatGateway{}, err
+	}
+	g, err := p.start("CreateNatGateway", natGatewayName)
+	if err != nil {
+		return network.NatGateway{}, err
+	}
+	natGateway := network.NatGateway{
+		ID:       to.StringPtr(p.networkID("natGateways", natGatewayName)),
+		Name:     to.StringPtr(natGatewayName),
+		Location: to.StringPtr(p.config.GroupLocation),
+		Sku:      &network.NatGatewaySku{Name: network.Standard},
+		NatGatewayPropertiesFormat: &network.NatGatewayPropertiesFormat{
+			IdleTimeoutInMinutes: to.Int32Ptr(4),
+			PublicIPAddresses:    &[]network.SubResource{{ID: pip.ID}},
+			ProvisioningState:    succeeded(),
+		},
+	}
+	g.natGateways[key(natGatewayName)] = natGateway
+	return natGateway, nil
+}
+
+func (p *provider) ListPublicIPs(ctx context.Context) ([]network.PublicIPAddress, error) {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	g, err := p.get("ListPublicIPs")
+	if err != nil {
+		return nil, err
+	}
+	var pips []network.PublicIPAddress
+	for _, pip := range g.pips {
+		pips = append(pips, pip)
+	}
+	sort.Slice(pips, func(i, j int) bool { return *pips[i].Name < *pips[j].Name })
+	return pips, nil
+}
+
+func (p *provider) DeletePublicIP(ctx context.Context, ipName string) error {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	g, err := p.start("DeletePublicIP", ipName)
+	if err != nil {
+		return err
+	}
+	delete(g.pips, key(ipName))
+	return nil
+}
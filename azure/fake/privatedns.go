@@ -0,0 +1,142 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+type privateZone struct {
+	zone    privatedns.PrivateZone
+	links   map[string]privatedns.VirtualNetworkLink
+	records map[string]privatedns.RecordSet
+}
+
+func (p *provider) GetPrivateDNSZone(ctx context.Context, zoneName string) (privatedns.PrivateZone, error) {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	g, err := p.get("GetPrivateDNSZone")
+	if err != nil {
+		return privatedns.PrivateZone{}, err
+	}
+	zone, ok := g.zones[key(zoneName)]
+	if !ok {
+		return privatedns.PrivateZone{}, notFound("PrivateDnsZone", zoneName)
+	}
+	return zone.zone, nil
+}
+
+// CreatePrivateDNSZone mirrors the azure implementation, linking the zone to an existing vnet
+// of any resource group
+func (p *provider) CreatePrivateDNSZone(ctx context.Context, zoneName, vnetID string) error {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+
+	g, err := p.start("CreatePrivateDNSZone", zoneName)
+	if err != nil {
+		return err
+	}
+	vnet, err := p.vnetByID(vnetID)
+	if err != nil {
+		return err
+	}
+	vnetName := *vnet.Name
+	zone, ok := g.zones[key(zoneName)]
+	if !ok {
+		zoneID := p.networkID("privateDnsZones", zoneName)
+		zone = &privateZone{
+			zone: privatedns.PrivateZone{
+				ID:                    to.StringPtr(zoneID),
+				Name:                  to.StringPtr(zoneName),
+				Location:              to.StringPtr("global"),
+				PrivateZoneProperties: &privatedns.PrivateZoneProperties{ProvisioningState: privatedns.Succeeded},
+			},
+			links:   map[string]privatedns.VirtualNetworkLink{},
+			records: map[string]privatedns.RecordSet{},
+		}
+		g.zones[key(zoneName)] = zone
+	}
+	zone.links[key(vnetName)] = privatedns.VirtualNetworkLink{
+		ID:       to.StringPtr(*zone.zone.ID + "/virtualNetworkLinks/" + vnetName),
+		Name:     to.StringPtr(vnetName),
+		Location: to.StringPtr("global"),
+		VirtualNetworkLinkProperties: &privatedns.VirtualNetworkLinkProperties{
+			VirtualNetwork:          &privatedns.SubResource{ID: vnet.ID},
+			RegistrationEnabled:     to.BoolPtr(false),
+			VirtualNetworkLinkState: privatedns.Completed,
+			ProvisioningState:       privatedns.Succeeded,
+		},
+	}
+	return nil
+}
+
+func (p *provider) DeletePrivateDNSZone(ctx context.Context, zoneName, vnetName string) error {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	g, err := p.start("DeletePrivateDNSZone", zoneName)
+	if err != nil {
+		return err
+	}
+	delete(g.zones, key(zoneName))
+	return nil
+}
+
+func (p *provider) GetPrivateDNSZoneLink(ctx context.Context, zoneName, vnetName string) (privatedns.VirtualNetworkLink, error) {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	g, err := p.get("GetPrivateDNSZoneLink")
+	if err != nil {
+		return privatedns.VirtualNetworkLink{}, err
+	}
+	zone, ok := g.zones[key(zoneName)]
+	if !ok {
+		return privatedns.VirtualNetworkLink{}, notFound("PrivateDnsZone", zoneName)
+	}
+	link, ok := zone.links[key(vnetName)]
+	if !ok {
+		return privatedns.VirtualNetworkLink{}, notFound("VirtualNetworkLink", vnetName)
+	}
+	return link, nil
+}
+
+func (p *provider) GetPrivateDNSARecord(ctx context.Context, zoneName, recordName string) (privatedns.RecordSet, error) {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	g, err := p.get("GetPrivateDNSARecord")
+	if err != nil {
+		return privatedns.RecordSet{}, err
+	}
+	zone, ok := g.zones[key(zoneName)]
+	if !ok {
+		return privatedns.RecordSet{}, notFound("PrivateDnsZone", zoneName)
+	}
+	record, ok := zone.records[key(recordName)]
+	if !ok {
+		return privatedns.RecordSet{}, notFound("RecordSet", recordName)
+	}
+	return record, nil
+}
+
+func (p *provider) CreatePrivateDNSARecord(ctx context.Context, zoneName, recordName, ipAddress string) error {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	g, err := p.start("CreatePrivateDNSARecord", zoneName+"/"+recordName)
+	if err != nil {
+		return err
+	}
+	zone, ok := g.zones[key(zoneName)]
+	if !ok {
+		return notFound("PrivateDnsZone", zoneName)
+	}
+	zone.records[key(recordName)] = privatedns.RecordSet{
+		ID:   to.StringPtr(fmt.Sprintf("%s/A/%s", *zone.zone.ID, recordName)),
+		Name: to.StringPtr(recordName),
+		RecordSetProperties: &privatedns.RecordSetProperties{
+			TTL:      to.Int64Ptr(60),
+			ARecords: &[]privatedns.ARecord{{Ipv4Address: to.StringPtr(ipAddress)}},
+		},
+	}
+	return nil
+}
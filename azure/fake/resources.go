@@ -0,0 +1,72 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-02-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-03-01/resources"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+func (p *provider) GetResourceGroup(ctx context.Context) (resources.Group, error) {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	g, err := p.get("GetResourceGroup")
+	if err != nil {
+		return resources.Group{}, err
+	}
+	return g.group, nil
+}
+
+func newResourceGroup() *resourceGroup {
+	return &resourceGroup{
+		nsgs:        map[string]network.SecurityGroup{},
+		routeTables: map[string]network.RouteTable{},
+		vnets:       map[string]network.VirtualNetwork{},
+		pips:        map[string]network.PublicIPAddress{},
+		lbs:         map[string]network.LoadBalancer{},
+		natGateways: map[string]network.NatGateway{},
+		zones:       map[string]*privateZone{},
+		vmss:        map[string]*scaleSet{},
+	}
+}
+
+func (p *provider) CreateOrUpdateResourceGroup(ctx context.Context) error {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	if err := p.cloud.injected("CreateOrUpdateResourceGroup"); err != nil {
+		return err
+	}
+	g, ok := p.cloud.groups[p.groupKey()]
+	if !ok {
+		g = newResourceGroup()
+		p.cloud.groups[p.groupKey()] = g
+	}
+	g.group = resources.Group{
+		ID:         to.StringPtr("/subscriptions/" + p.config.SubscriptionID + "/resourceGroups/" + p.config.GroupName),
+		Name:       to.StringPtr(p.config.GroupName),
+		Location:   to.StringPtr(p.config.GroupLocation),
+		Properties: &resources.GroupProperties{ProvisioningState: succeeded()},
+	}
+	return nil
+}
+
+func (p *provider) DeleteResourceGroup(ctx context.Context) error {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	if _, err := p.start("DeleteResourceGroup", p.config.GroupName); err != nil {
+		return err
+	}
+	delete(p.cloud.groups, p.groupKey())
+	return nil
+}
+
+func (p *provider) ListResourceSKUs(ctx context.Context) ([]compute.ResourceSku, error) {
+	p.cloud.mu.Lock()
+	defer p.cloud.mu.Unlock()
+	if err := p.cloud.injected("ListResourceSKUs"); err != nil {
+		return nil, err
+	}
+	return append([]compute.ResourceSku(nil), p.cloud.SKUs...), nil
+}
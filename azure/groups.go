@@ -5,18 +5,30 @@ import (
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-03-01/resources"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/to"
 )
 
 func (c *CloudConfiguration) GetGroupsClient() (resources.GroupsClient, error) {
-	groupsClient := resources.NewGroupsClient(c.SubscriptionID)
-	a, err := c.getAuthorizerForResource()
+	client, err := c.getClient("GetGroupsClient", func(a autorest.Authorizer) interface{} {
+		groupsClient := resources.NewGroupsClientWithBaseURI(c.baseURI(), c.SubscriptionID)
+		groupsClient.Authorizer = a
+		groupsClient.AddToUserAgent(c.UserAgent)
+		return groupsClient
+	})
 	if err != nil {
-		return groupsClient, err
+		return resources.GroupsClient{}, err
 	}
-	groupsClient.Authorizer = a
-	groupsClient.AddToUserAgent(c.UserAgent)
-	return groupsClient, nil
+	return client.(resources.GroupsClient), nil
+}
+
+// GetResourceGroup gets info on the cluster resource group
+func (c *CloudConfiguration) GetResourceGroup(ctx context.Context) (resources.Group, error) {
+	groupsClient, err := c.GetGroupsClient()
+	if err != nil {
+		return resources.Group{}, err
+	}
+	return groupsClient.Get(ctx, c.GroupName)
 }
 
 func (c *CloudConfiguration) CreateOrUpdateResourceGroup(ctx context.Context) error {
@@ -1,26 +1,57 @@
 package azhelpers
 
 import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-03-01/resources"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/adal"
 	"github.com/Azure/go-autorest/autorest/azure"
 )
 
 const (
-	AzurePublicCloudName = "AzurePublicCloud"
+	AzurePublicCloudName       = "AzurePublicCloud"
+	AzureChinaCloudName        = "AzureChinaCloud"
+	AzureUSGovernmentCloudName = "AzureUSGovernmentCloud"
+	AzureGermanCloudName       = "AzureGermanCloud"
+	// AzureStackCloudName is the cloud name of custom environments, described by CloudEnvironment
+	AzureStackCloudName = "AzureStackCloud"
 )
 
+// publicIPDNSSuffixes are the public ip dns suffixes of clouds where the sdk environment is out of date
+var publicIPDNSSuffixes = map[string]string{
+	AzureChinaCloudName:        "cloudapp.chinacloudapi.cn",
+	AzureUSGovernmentCloudName: "cloudapp.usgovcloudapi.net",
+}
+
 type CloudConfiguration struct {
 	CloudName      string `json:"cloud,omitempty"`
 	SubscriptionID string `json:"subscriptionID,omitempty"`
 	ClientID       string `json:"clientID,omitempty"`
 	ClientSecret   string `json:"clientSecret,omitempty"`
 	TenantID       string `json:"tenantID,omitempty"`
-	GroupName      string `json:"groupName,omitempty"`
-	GroupLocation  string `json:"groupLocation,omitempty"`
-	UserAgent      string `json:"userAgent,omitempty"`
+	// ClientCertificate is the base64 encoded pkcs12 certificate of the service principal, used instead of ClientSecret
+	ClientCertificate         string `json:"clientCertificate,omitempty"`
+	ClientCertificatePassword string `json:"clientCertificatePassword,omitempty"`
+	// FederatedTokenFile is a file holding a workload identity token exchanged for an access token of ClientID
+	FederatedTokenFile string `json:"federatedTokenFile,omitempty"`
+	// UseManagedIdentity authenticates as the managed identity of the vm, UserAssignedIdentityID
+	// selects a user assigned identity, otherwise the system assigned identity is used
+	UseManagedIdentity     bool   `json:"useManagedIdentity,omitempty"`
+	UserAssignedIdentityID string `json:"userAssignedIdentityID,omitempty"`
+	// UserAssignedIdentityResourceID is the user assigned identity every scale set is created with
+	UserAssignedIdentityResourceID string `json:"userAssignedIdentityResourceID,omitempty"`
+	GroupName                      string `json:"groupName,omitempty"`
+	GroupLocation                  string `json:"groupLocation,omitempty"`
+	UserAgent                      string `json:"userAgent,omitempty"`
+	// CloudEnvironment is the json environment of a custom cloud like Azure Stack
+	CloudEnvironment string `json:"cloudEnvironment,omitempty"`
+	// ResourceManagerEndpoint overrides the resource manager endpoint of the cloud, e.g. a local stand-in
+	ResourceManagerEndpoint string `json:"resourceManagerEndpoint,omitempty"`
+	// ActiveDirectoryEndpoint overrides the active directory endpoint tokens are requested from
+	ActiveDirectoryEndpoint string `json:"activeDirectoryEndpoint,omitempty"`
 }
 
 // ResourceNotFound parses the error to check if its a resource not found
@@ -31,65 +62,103 @@ func ResourceNotFound(err error) bool {
 	return false
 }
 
-func (c *CloudConfiguration) getAuthorizerForResource() (autorest.Authorizer, error) {
-	env, err := azure.EnvironmentFromName(c.CloudName)
-	if err != nil {
-		return nil, err
+// Environment returns the azure environment of the cloud with endpoint overrides applied
+func (c *CloudConfiguration) Environment() (azure.Environment, error) {
+	var env azure.Environment
+	switch {
+	case c.CloudEnvironment != "":
+		if err := json.Unmarshal([]byte(c.CloudEnvironment), &env); err != nil {
+			return env, fmt.Errorf("cannot parse cloud environment: %v", err)
+		}
+		if env.ResourceManagerEndpoint == "" || env.ActiveDirectoryEndpoint == "" {
+			return env, fmt.Errorf("cloud environment requires resourceManagerEndpoint and activeDirectoryEndpoint")
+		}
+	case c.CloudName == "":
+		env = azure.PublicCloud
+	default:
+		var err error
+		if env, err = azure.EnvironmentFromName(c.CloudName); err != nil {
+			return env, err
+		}
 	}
-	oauthConfig, err := adal.NewOAuthConfig(
-		env.ActiveDirectoryEndpoint, c.TenantID)
-	if err != nil {
-		return nil, err
+	if c.ResourceManagerEndpoint != "" {
+		env.ResourceManagerEndpoint = c.ResourceManagerEndpoint
+	}
+	if c.ActiveDirectoryEndpoint != "" {
+		env.ActiveDirectoryEndpoint = c.ActiveDirectoryEndpoint
 	}
+	return env, nil
+}
 
-	token, err := adal.NewServicePrincipalToken(
-		*oauthConfig, c.ClientID, c.ClientSecret, env.ResourceManagerEndpoint)
+// baseURI is the resource manager endpoint every client is created against
+func (c *CloudConfiguration) baseURI() string {
+	if c.ResourceManagerEndpoint != "" {
+		return c.ResourceManagerEndpoint
+	}
+	env, err := c.Environment()
 	if err != nil {
-		return nil, err
+		return azure.PublicCloud.ResourceManagerEndpoint
+	}
+	return env.ResourceManagerEndpoint
+}
+
+// PublicDNSName is the fully qualified name azure assigns to a public ip with the dns label
+func (c *CloudConfiguration) PublicDNSName(label string) string {
+	suffix := azure.PublicCloud.ResourceManagerVMDNSSuffix
+	if env, err := c.Environment(); err == nil {
+		if s, ok := publicIPDNSSuffixes[env.Name]; ok {
+			suffix = s
+		} else if env.ResourceManagerVMDNSSuffix != "" {
+			suffix = env.ResourceManagerVMDNSSuffix
+		}
 	}
-	return autorest.NewBearerAuthorizer(token), nil
+	return fmt.Sprintf("%s.%s.%s", strings.ToLower(label), strings.ToLower(c.GroupLocation), suffix)
 }
 
 func (c *CloudConfiguration) IsValid() bool {
-	if c.CloudName != "" &&
+	if (c.CloudName != "" || c.CloudEnvironment != "") &&
 		c.SubscriptionID != "" &&
-		c.ClientID != "" &&
-		c.ClientSecret != "" &&
-		c.TenantID != "" {
+		c.hasCredentials() {
 		return true
 	}
 	return false
 }
 
 func (c *CloudConfiguration) GetResourcesClient() (resources.Client, error) {
-	resourcesClient := resources.NewClient(c.SubscriptionID)
-	a, err := c.getAuthorizerForResource()
+	client, err := c.getClient("GetResourcesClient", func(a autorest.Authorizer) interface{} {
+		resourcesClient := resources.NewClientWithBaseURI(c.baseURI(), c.SubscriptionID)
+		resourcesClient.Authorizer = a
+		resourcesClient.AddToUserAgent(c.UserAgent)
+		return resourcesClient
+	})
 	if err != nil {
-		return resourcesClient, err
+		return resources.Client{}, err
 	}
-	resourcesClient.Authorizer = a
-	resourcesClient.AddToUserAgent(c.UserAgent)
-	return resourcesClient, nil
+	return client.(resources.Client), nil
 }
 
 func (c *CloudConfiguration) GetDisksClient() (compute.DisksClient, error) {
-	disksClient := compute.NewDisksClient(c.SubscriptionID)
-	a, err := c.getAuthorizerForResource()
+	client, err := c.getClient("GetDisksClient", func(a autorest.Authorizer) interface{} {
+		disksClient := compute.NewDisksClientWithBaseURI(c.baseURI(), c.SubscriptionID)
+		disksClient.Authorizer = a
+		disksClient.AddToUserAgent(c.UserAgent)
+		return disksClient
+	})
 	if err != nil {
-		return disksClient, err
+		return compute.DisksClient{}, err
 	}
-	disksClient.Authorizer = a
-	disksClient.AddToUserAgent(c.UserAgent)
-	return disksClient, nil
+	return client.(compute.DisksClient), nil
 }
 
 func (c *CloudConfiguration) GetDeploymentsClient() (resources.DeploymentsClient, error) {
-	deploymentsClient := resources.NewDeploymentsClient(c.SubscriptionID)
-	a, err := c.getAuthorizerForResource()
+	client, err := c.getClient("GetDeploymentsClient", func(a autorest.Authorizer) interface{} {
+		deploymentsClient := resources.NewDeploymentsClientWithBaseURI(c.baseURI(), c.SubscriptionID)
+		deploymentsClient.Authorizer = a
+		deploymentsClient.AddToUserAgent(c.UserAgent)
+		return deploymentsClient
+	})
 	if err != nil {
-		return deploymentsClient, err
+		return resources.DeploymentsClient{}, err
 	}
-	deploymentsClient.Authorizer = a
-	deploymentsClient.AddToUserAgent(c.UserAgent)
-	return deploymentsClient, nil
+	return client.(resources.DeploymentsClient), nil
 }
@@ -0,0 +1,120 @@
+package azhelpers
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnvironment(t *testing.T) {
+	stack := `{
+"name": "AzureStackCloud",
+"resourceManagerEndpoint": "https://management.local.azurestack.external/",
+"activeDirectoryEndpoint": "https://login.microsoftonline.com/",
+"resourceManagerVMDNSSuffix": "cloudapp.local.azurestack.external"
+}`
+
+	tests := []struct {
+		config   CloudConfiguration
+		endpoint string
+		dnsName  string
+	}{
+		{CloudConfiguration{}, "https://management.azure.com/", "azk.westus2.cloudapp.azure.com"},
+		{CloudConfiguration{CloudName: AzurePublicCloudName}, "https://management.azure.com/", "azk.westus2.cloudapp.azure.com"},
+		{CloudConfiguration{CloudName: AzureChinaCloudName}, "https://management.chinacloudapi.cn/", "azk.westus2.cloudapp.chinacloudapi.cn"},
+		{CloudConfiguration{CloudName: AzureUSGovernmentCloudName}, "https://management.usgovcloudapi.net/", "azk.westus2.cloudapp.usgovcloudapi.net"},
+		{CloudConfiguration{CloudName: AzureStackCloudName, CloudEnvironment: stack}, "https://management.local.azurestack.external/", "azk.westus2.cloudapp.local.azurestack.external"},
+		{CloudConfiguration{CloudName: AzurePublicCloudName, ResourceManagerEndpoint: "http://localhost:8443"}, "http://localhost:8443", "azk.westus2.cloudapp.azure.com"},
+	}
+
+	for _, test := range tests {
+		test.config.GroupLocation = "WestUS2"
+		if endpoint := test.config.baseURI(); endpoint != test.endpoint {
+			t.Errorf("Expected %s endpoint %s, got %s", test.config.CloudName, test.endpoint, endpoint)
+		}
+		if dnsName := test.config.PublicDNSName("AZK"); dnsName != test.dnsName {
+			t.Errorf("Expected %s dns name %s, got %s", test.config.CloudName, test.dnsName, dnsName)
+		}
+	}
+
+	for _, config := range []CloudConfiguration{
+		{CloudName: "UnknownCloud"},
+		{CloudName: AzureStackCloudName, CloudEnvironment: "{}"},
+	} {
+		if _, err := config.Environment(); err == nil {
+			t.Errorf("Expected %s environment to be invalid", config.CloudName)
+		}
+	}
+}
+
+func TestGetAzureCloudProviderConfig(t *testing.T) {
+	config := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(GetAzureCloudProviderConfig(&CloudConfiguration{CloudName: AzureChinaCloudName}, CloudProviderNetwork{})), &config); err != nil {
+		t.Fatalf("Failed to parse cloud provider config: %v", err)
+	}
+	if config["cloud"] != AzureChinaCloudName {
+		t.Fatalf("Expected cloud %s, got %v", AzureChinaCloudName, config["cloud"])
+	}
+	if config["vnetName"] != "azk-vnet" || config["routeTableResourceGroup"] != "" {
+		t.Errorf("Expected the azk vnet in the cluster group, got %v %v", config["vnetName"], config["routeTableResourceGroup"])
+	}
+
+	config = map[string]interface{}{}
+	if err := json.Unmarshal([]byte(GetAzureCloudProviderConfig(&CloudConfiguration{GroupName: "cluster"}, CloudProviderNetwork{
+		VNetName:                "hub",
+		VNetResourceGroup:       "network",
+		SubnetName:              "nodes",
+		SecurityGroupName:       "nodes-nsg",
+		RouteTableName:          "nodes-routes",
+		RouteTableResourceGroup: "network",
+	})), &config); err != nil {
+		t.Fatalf("Failed to parse cloud provider config: %v", err)
+	}
+	for field, expected := range map[string]string{
+		"resourceGroup":           "cluster",
+		"vnetName":                "hub",
+		"vnetResourceGroup":       "network",
+		"subnetName":              "nodes",
+		"securityGroupName":       "nodes-nsg",
+		"routeTableName":          "nodes-routes",
+		"routeTableResourceGroup": "network",
+	} {
+		if config[field] != expected {
+			t.Errorf("Expected %s %s, got %v", field, expected, config[field])
+		}
+	}
+}
+
+func TestParseSubnetID(t *testing.T) {
+	subnet, err := ParseSubnetID("/subscriptions/sub/resourceGroups/network/providers/Microsoft.Network/virtualNetworks/hub/subnets/nodes")
+	if err != nil {
+		t.Fatalf("Failed to parse subnet id: %v", err)
+	}
+	if subnet != (SubnetResource{SubscriptionID: "sub", ResourceGroup: "network", VNetName: "hub", Name: "nodes"}) {
+		t.Errorf("Unexpected subnet %+v", subnet)
+	}
+	if vnetID := subnet.VNetID(); vnetID != "/subscriptions/sub/resourceGroups/network/providers/Microsoft.Network/virtualNetworks/hub" {
+		t.Errorf("Unexpected vnet id %s", vnetID)
+	}
+	for _, subnetID := range []string{
+		"",
+		"/subscriptions/sub/resourceGroups/network/providers/Microsoft.Network/virtualNetworks/hub",
+		"/subscriptions/sub/resourceGroups/network/providers/Microsoft.Network/networkSecurityGroups/hub/subnets/nodes",
+		"/subscriptions/sub/resourceGroups//providers/Microsoft.Network/virtualNetworks/hub/subnets/nodes",
+	} {
+		if _, err := ParseSubnetID(subnetID); err == nil {
+			t.Errorf("Expected %q to be invalid", subnetID)
+		}
+	}
+}
+
+func TestParseBlobURL(t *testing.T) {
+	account, container, name, err := ParseBlobURL("https://azkbackups.blob.core.windows.net/etcd/ns-daily-1570000000.db?sv=2019-02-02&sig=secret")
+	if err != nil || account != "azkbackups" || container != "etcd" || name != "ns-daily-1570000000.db" {
+		t.Fatalf("Unexpected %s %s %s: %v", account, container, name, err)
+	}
+	for _, blobURL := range []string{"snapshot.db", "https://azkbackups.blob.core.windows.net/etcd", "https://example.com/etcd/snapshot.db"} {
+		if _, _, _, err := ParseBlobURL(blobURL); err == nil {
+			t.Errorf("Expected %s to be invalid", blobURL)
+		}
+	}
+}
@@ -6,18 +6,21 @@ import (
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-02-01/network"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/to"
 )
 
 func (c *CloudConfiguration) GetIPClient() (network.PublicIPAddressesClient, error) {
-	ipClient := network.NewPublicIPAddressesClient(c.SubscriptionID)
-	auth, err := c.getAuthorizerForResource()
+	client, err := c.getClient("GetIPClient", func(a autorest.Authorizer) interface{} {
+		ipClient := network.NewPublicIPAddressesClientWithBaseURI(c.baseURI(), c.SubscriptionID)
+		ipClient.Authorizer = a
+		ipClient.AddToUserAgent(c.UserAgent)
+		return ipClient
+	})
 	if err != nil {
-		return ipClient, err
+		return network.PublicIPAddressesClient{}, err
 	}
-	ipClient.Authorizer = auth
-	ipClient.AddToUserAgent(c.UserAgent)
-	return ipClient, nil
+	return client.(network.PublicIPAddressesClient), nil
 }
 
 // GetPublicIP returns an existing public IP
@@ -34,7 +37,7 @@ func (c *CloudConfiguration) CreatePublicIP(ctx context.Context, ipName string)
 	if err != nil {
 		return network.PublicIPAddress{}, err
 	}
-	dnsName := fmt.Sprintf("%s.%s.cloudapp.azure.com", strings.ToLower(ipName), strings.ToLower(c.GroupLocation))
+	dnsName := c.PublicDNSName(ipName)
 	future, err := ipClient.CreateOrUpdate(
 		ctx,
 		c.GroupName,
@@ -65,3 +68,40 @@ func (c *CloudConfiguration) CreatePublicIP(ctx context.Context, ipName string)
 
 	return future.Result(ipClient)
 }
+
+// ListPublicIPs lists the public IPs of the cluster resource group
+func (c *CloudConfiguration) ListPublicIPs(ctx context.Context) ([]network.PublicIPAddress, error) {
+	ipClient, err := c.GetIPClient()
+	if err != nil {
+		return nil, err
+	}
+	iter, err := ipClient.ListComplete(ctx, c.GroupName)
+	if err != nil {
+		return nil, err
+	}
+	var pips []network.PublicIPAddress
+	for ; iter.NotDone(); err = iter.NextWithContext(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		pips = append(pips, iter.Value())
+	}
+	return pips, nil
+}
+
+// DeletePublicIP deletes a public IP of the cluster resource group
+func (c *CloudConfiguration) DeletePublicIP(ctx context.Context, ipName string) error {
+	ipClient, err := c.GetIPClient()
+	if err != nil {
+		return err
+	}
+	future, err := ipClient.Delete(ctx, c.GroupName, ipName)
+	if err != nil {
+		return fmt.Errorf("cannot delete public ip address: %v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, ipClient.Client); err != nil {
+		return fmt.Errorf("cannot get public ip address delete future response: %v", err)
+	}
+	_, err = future.Result(ipClient)
+	return err
+}
@@ -5,18 +5,21 @@ import (
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-02-01/network"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/to"
 )
 
 func (c *CloudConfiguration) GetLBClient() (network.LoadBalancersClient, error) {
-	lbClient := network.NewLoadBalancersClient(c.SubscriptionID)
-	auth, err := c.getAuthorizerForResource()
+	client, err := c.getClient("GetLBClient", func(a autorest.Authorizer) interface{} {
+		lbClient := network.NewLoadBalancersClientWithBaseURI(c.baseURI(), c.SubscriptionID)
+		lbClient.Authorizer = a
+		lbClient.AddToUserAgent(c.UserAgent)
+		return lbClient
+	})
 	if err != nil {
-		return lbClient, err
+		return network.LoadBalancersClient{}, err
 	}
-	lbClient.Authorizer = auth
-	lbClient.AddToUserAgent(c.UserAgent)
-	return lbClient, nil
+	return client.(network.LoadBalancersClient), nil
 }
 
 // GetLoadBalancer gets info on a loadbalancer
@@ -133,19 +136,15 @@ func (c *CloudConfiguration) CreateLoadBalancer(ctx context.Context, lbName, pip
 	return err
 }
 
-// CreateLoadBalancer creates a load balancer with 2 inbound NAT rules.
-func (c *CloudConfiguration) CreateInternalLoadBalancer(ctx context.Context, vnetName, subnetName, lbName string) error {
+// CreateInternalLoadBalancer creates a load balancer for the api server with a static frontend address in the subnet,
+// the subnet may belong to a vnet of another resource group
+func (c *CloudConfiguration) CreateInternalLoadBalancer(ctx context.Context, subnetID, lbName, privateIPAddress string) error {
 	probeName := "httpsProbe"
 	frontEndIPConfigName := "master-internal-lbFrontEnd"
 	backEndAddressPoolName := "master-internal-backEndPool"
 	idPrefix := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers", c.SubscriptionID, c.GroupName)
 
-	subnetClient, err := c.GetSubnetsClient()
-	if err != nil {
-		return err
-	}
-
-	subnet, err := subnetClient.Get(ctx, c.GroupName, vnetName, subnetName, "")
+	subnet, err := c.GetSubnetByID(ctx, subnetID)
 	if err != nil {
 		return err
 	}
@@ -167,7 +166,7 @@ func (c *CloudConfiguration) CreateInternalLoadBalancer(ctx context.Context, vne
 						FrontendIPConfigurationPropertiesFormat: &network.FrontendIPConfigurationPropertiesFormat{
 							PrivateIPAllocationMethod: network.Static,
 							Subnet:                    &subnet,
-							PrivateIPAddress:          to.StringPtr("10.0.0.100"),
+							PrivateIPAddress:          to.StringPtr(privateIPAddress),
 						},
 					},
 				},
@@ -226,3 +225,20 @@ func (c *CloudConfiguration) CreateInternalLoadBalancer(ctx context.Context, vne
 	_, err = future.Result(lbClient)
 	return err
 }
+
+// DeleteLoadBalancer deletes a load balancer of the cluster resource group
+func (c *CloudConfiguration) DeleteLoadBalancer(ctx context.Context, lbName string) error {
+	lbClient, err := c.GetLBClient()
+	if err != nil {
+		return err
+	}
+	future, err := lbClient.Delete(ctx, c.GroupName, lbName)
+	if err != nil {
+		return fmt.Errorf("cannot delete load balancer: %v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, lbClient.Client); err != nil {
+		return fmt.Errorf("cannot get load balancer delete future response: %v", err)
+	}
+	_, err = future.Result(lbClient)
+	return err
+}
@@ -0,0 +1,69 @@
+package azhelpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-02-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+func (c *CloudConfiguration) GetNatGatewaysClient() (network.NatGatewaysClient, error) {
+	client, err := c.getClient("GetNatGatewaysClient", func(a autorest.Authorizer) interface{} {
+		natGatewaysClient := network.NewNatGatewaysClientWithBaseURI(c.baseURI(), c.SubscriptionID)
+		natGatewaysClient.Authorizer = a
+		natGatewaysClient.AddToUserAgent(c.UserAgent)
+		return natGatewaysClient
+	})
+	if err != nil {
+		return network.NatGatewaysClient{}, err
+	}
+	return client.(network.NatGatewaysClient), nil
+}
+
+// GetNatGateway gets info on a nat gateway
+func (c *CloudConfiguration) GetNatGateway(ctx context.Context, natGatewayName string) (network.NatGateway, error) {
+	natGatewaysClient, err := c.GetNatGatewaysClient()
+	if err != nil {
+		return network.NatGateway{}, err
+	}
+	return natGatewaysClient.Get(ctx, c.GroupName, natGatewayName, "")
+}
+
+// CreateNatGateway creates a nat gateway egressing through a new public ip, subnets are associated
+// with it when the vnet is created
+func (c *CloudConfiguration) CreateNatGateway(ctx context.Context, natGatewayName, pipName string) (network.NatGateway, error) {
+	pip, err := c.CreatePublicIP(ctx, pipName)
+	if err != nil {
+		return network.NatGateway{}, err
+	}
+
+	natGatewaysClient, err := c.GetNatGatewaysClient()
+	if err != nil {
+		return network.NatGateway{}, err
+	}
+	future, err := natGatewaysClient.CreateOrUpdate(
+		ctx,
+		c.GroupName,
+		natGatewayName,
+		network.NatGateway{
+			Sku:      &network.NatGatewaySku{Name: network.Standard},
+			Location: to.StringPtr(c.GroupLocation),
+			NatGatewayPropertiesFormat: &network.NatGatewayPropertiesFormat{
+				IdleTimeoutInMinutes: to.Int32Ptr(4),
+				PublicIPAddresses:    &[]network.SubResource{{ID: pip.ID}},
+			},
+		},
+	)
+	if err != nil {
+		return network.NatGateway{}, fmt.Errorf("cannot create nat gateway: %v", err)
+	}
+
+	err = future.WaitForCompletionRef(ctx, natGatewaysClient.Client)
+	if err != nil {
+		return network.NatGateway{}, fmt.Errorf("cannot get nat gateway create or update future response: %v", err)
+	}
+
+	return future.Result(natGatewaysClient)
+}
@@ -5,18 +5,21 @@ import (
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-02-01/network"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/to"
 )
 
 func (c *CloudConfiguration) GetNICClient() (network.InterfacesClient, error) {
-	nicClient := network.NewInterfacesClient(c.SubscriptionID)
-	auth, err := c.getAuthorizerForResource()
+	client, err := c.getClient("GetNICClient", func(a autorest.Authorizer) interface{} {
+		nicClient := network.NewInterfacesClientWithBaseURI(c.baseURI(), c.SubscriptionID)
+		nicClient.Authorizer = a
+		nicClient.AddToUserAgent(c.UserAgent)
+		return nicClient
+	})
 	if err != nil {
-		return nicClient, err
+		return network.InterfacesClient{}, err
 	}
-	nicClient.Authorizer = auth
-	nicClient.AddToUserAgent(c.UserAgent)
-	return nicClient, nil
+	return client.(network.InterfacesClient), nil
 }
 
 func (c *CloudConfiguration) CreateNICWithLoadBalancer(ctx context.Context, lbName, internallbName, vnetName, subnetName, staticIPAddress, nicName string, natRule int) (nic network.Interface, err error) {
@@ -0,0 +1,190 @@
+package azhelpers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+func (c *CloudConfiguration) GetPrivateZonesClient() (privatedns.PrivateZonesClient, error) {
+	client, err := c.getClient("GetPrivateZonesClient", func(a autorest.Authorizer) interface{} {
+		zonesClient := privatedns.NewPrivateZonesClientWithBaseURI(c.baseURI(), c.SubscriptionID)
+		zonesClient.Authorizer = a
+		zonesClient.AddToUserAgent(c.UserAgent)
+		return zonesClient
+	})
+	if err != nil {
+		return privatedns.PrivateZonesClient{}, err
+	}
+	return client.(privatedns.PrivateZonesClient), nil
+}
+
+func (c *CloudConfiguration) GetVirtualNetworkLinksClient() (privatedns.VirtualNetworkLinksClient, error) {
+	client, err := c.getClient("GetVirtualNetworkLinksClient", func(a autorest.Authorizer) interface{} {
+		linksClient := privatedns.NewVirtualNetworkLinksClientWithBaseURI(c.baseURI(), c.SubscriptionID)
+		linksClient.Authorizer = a
+		linksClient.AddToUserAgent(c.UserAgent)
+		return linksClient
+	})
+	if err != nil {
+		return privatedns.VirtualNetworkLinksClient{}, err
+	}
+	return client.(privatedns.VirtualNetworkLinksClient), nil
+}
+
+func (c *CloudConfiguration) GetRecordSetsClient() (privatedns.RecordSetsClient, error) {
+	client, err := c.getClient("GetRecordSetsClient", func(a autorest.Authorizer) interface{} {
+		recordSetsClient := privatedns.NewRecordSetsClientWithBaseURI(c.baseURI(), c.SubscriptionID)
+		recordSetsClient.Authorizer = a
+		recordSetsClient.AddToUserAgent(c.UserAgent)
+		return recordSetsClient
+	})
+	if err != nil {
+		return privatedns.RecordSetsClient{}, err
+	}
+	return client.(privatedns.RecordSetsClient), nil
+}
+
+// GetPrivateDNSZone gets a private dns zone
+func (c *CloudConfiguration) GetPrivateDNSZone(ctx context.Context, zoneName string) (privatedns.PrivateZone, error) {
+	zonesClient, err := c.GetPrivateZonesClient()
+	if err != nil {
+		return privatedns.PrivateZone{}, err
+	}
+	return zonesClient.Get(ctx, c.GroupName, zoneName)
+}
+
+// CreatePrivateDNSZone creates a private dns zone linked to the vnet, so every machine in the vnet
+// resolves the records of the zone. The vnet may belong to another resource group, the link is
+// named after it.
+func (c *CloudConfiguration) CreatePrivateDNSZone(ctx context.Context, zoneName, vnetID string) error {
+	zonesClient, err := c.GetPrivateZonesClient()
+	if err != nil {
+		return err
+	}
+	future, err := zonesClient.CreateOrUpdate(ctx,
+		c.GroupName,
+		zoneName,
+		privatedns.PrivateZone{
+			Location: to.StringPtr("global"),
+		},
+		"",
+		"")
+	if err != nil {
+		return fmt.Errorf("cannot create private dns zone: %v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, zonesClient.Client); err != nil {
+		return fmt.Errorf("cannot get private dns zone create or update future response: %v", err)
+	}
+	if _, err := future.Result(zonesClient); err != nil {
+		return err
+	}
+
+	vnetName := vnetID[strings.LastIndex(vnetID, "/")+1:]
+	linksClient, err := c.GetVirtualNetworkLinksClient()
+	if err != nil {
+		return err
+	}
+	linkFuture, err := linksClient.CreateOrUpdate(ctx,
+		c.GroupName,
+		zoneName,
+		vnetName,
+		privatedns.VirtualNetworkLink{
+			Location: to.StringPtr("global"),
+			VirtualNetworkLinkProperties: &privatedns.VirtualNetworkLinkProperties{
+				VirtualNetwork:      &privatedns.SubResource{ID: to.StringPtr(vnetID)},
+				RegistrationEnabled: to.BoolPtr(false),
+			},
+		},
+		"",
+		"")
+	if err != nil {
+		return fmt.Errorf("cannot link private dns zone: %v", err)
+	}
+	if err := linkFuture.WaitForCompletionRef(ctx, linksClient.Client); err != nil {
+		return fmt.Errorf("cannot get private dns zone link create or update future response: %v", err)
+	}
+	_, err = linkFuture.Result(linksClient)
+	return err
+}
+
+// DeletePrivateDNSZone deletes the link of a private dns zone to a vnet and then the zone, azure
+// refuses to delete zones which are still linked
+func (c *CloudConfiguration) DeletePrivateDNSZone(ctx context.Context, zoneName, vnetName string) error {
+	linksClient, err := c.GetVirtualNetworkLinksClient()
+	if err != nil {
+		return err
+	}
+	linkFuture, err := linksClient.Delete(ctx, c.GroupName, zoneName, vnetName, "")
+	if err != nil && !ResourceNotFound(err) {
+		return fmt.Errorf("cannot delete private dns zone link: %v", err)
+	}
+	if err == nil {
+		if err := linkFuture.WaitForCompletionRef(ctx, linksClient.Client); err != nil {
+			return fmt.Errorf("cannot get private dns zone link delete future response: %v", err)
+		}
+	}
+
+	zonesClient, err := c.GetPrivateZonesClient()
+	if err != nil {
+		return err
+	}
+	future, err := zonesClient.Delete(ctx, c.GroupName, zoneName, "")
+	if err != nil {
+		return fmt.Errorf("cannot delete private dns zone: %v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, zonesClient.Client); err != nil {
+		return fmt.Errorf("cannot get private dns zone delete future response: %v", err)
+	}
+	_, err = future.Result(zonesClient)
+	return err
+}
+
+// GetPrivateDNSZoneLink gets the link of a private dns zone to a vnet, links are named after the vnet
+func (c *CloudConfiguration) GetPrivateDNSZoneLink(ctx context.Context, zoneName, vnetName string) (privatedns.VirtualNetworkLink, error) {
+	linksClient, err := c.GetVirtualNetworkLinksClient()
+	if err != nil {
+		return privatedns.VirtualNetworkLink{}, err
+	}
+	return linksClient.Get(ctx, c.GroupName, zoneName, vnetName)
+}
+
+// GetPrivateDNSARecord gets an A record set of a private dns zone, @ is the zone apex
+func (c *CloudConfiguration) GetPrivateDNSARecord(ctx context.Context, zoneName, recordName string) (privatedns.RecordSet, error) {
+	recordSetsClient, err := c.GetRecordSetsClient()
+	if err != nil {
+		return privatedns.RecordSet{}, err
+	}
+	return recordSetsClient.Get(ctx, c.GroupName, zoneName, privatedns.A, recordName)
+}
+
+// CreatePrivateDNSARecord creates or replaces an A record set of a private dns zone with a single address
+func (c *CloudConfiguration) CreatePrivateDNSARecord(ctx context.Context, zoneName, recordName, ipAddress string) error {
+	recordSetsClient, err := c.GetRecordSetsClient()
+	if err != nil {
+		return err
+	}
+	_, err = recordSetsClient.CreateOrUpdate(ctx,
+		c.GroupName,
+		zoneName,
+		privatedns.A,
+		recordName,
+		privatedns.RecordSet{
+			RecordSetProperties: &privatedns.RecordSetProperties{
+				TTL: to.Int64Ptr(60),
+				ARecords: &[]privatedns.ARecord{
+					{Ipv4Address: to.StringPtr(ipAddress)},
+				},
+			},
+		},
+		"",
+		"")
+	if err != nil {
+		return fmt.Errorf("cannot create private dns record: %v", err)
+	}
+	return nil
+}
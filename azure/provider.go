@@ -0,0 +1,120 @@
+package azhelpers
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-02-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-03-01/resources"
+)
+
+// ResourceGroups manages the resource group of the cloud configuration
+type ResourceGroups interface {
+	GetResourceGroup(ctx context.Context) (resources.Group, error)
+	CreateOrUpdateResourceGroup(ctx context.Context) error
+	DeleteResourceGroup(ctx context.Context) error
+}
+
+// VNets manages virtual networks and their subnets
+type VNets interface {
+	GetVirtualNetwork(ctx context.Context, vnetName string) (network.VirtualNetwork, error)
+	CreateVirtualNetworkAndSubnets(ctx context.Context, vnetName, vnetCIDR, masterSubnetCIDR, agentSubnetCIDR, natGatewayID string) error
+	GetSubnet(ctx context.Context, vnetName, subnetName string) (network.Subnet, error)
+	GetSubnetByID(ctx context.Context, subnetID string) (network.Subnet, error)
+}
+
+// NSGs manages network security groups and route tables
+type NSGs interface {
+	GetNetworkSecurityGroup(ctx context.Context, nsgName string) (network.SecurityGroup, error)
+	CreateNetworkSecurityGroup(ctx context.Context, nsgName string) (network.SecurityGroup, error)
+	CreateDefaultNetworkSecurityGroup(ctx context.Context, nsgName string) (network.SecurityGroup, error)
+	UpdateNetworkSecurityGroupRules(ctx context.Context, nsgName string, rules []network.SecurityRule) error
+	DeleteNetworkSecurityGroup(ctx context.Context, nsgName string) error
+	GetRouteTable(ctx context.Context, routeTableName string) (network.RouteTable, error)
+	CreateRouteTables(ctx context.Context, routeTableName string) (network.RouteTable, error)
+	DeleteRouteTables(ctx context.Context, routeTableName string) error
+}
+
+// LoadBalancers manages the public and internal load balancers
+type LoadBalancers interface {
+	GetLoadBalancer(ctx context.Context, lbName string) (network.LoadBalancer, error)
+	CreateLoadBalancer(ctx context.Context, lbName, pipName string) error
+	CreateInternalLoadBalancer(ctx context.Context, subnetID, lbName, privateIPAddress string) error
+	DeleteLoadBalancer(ctx context.Context, lbName string) error
+}
+
+// NATGateways manages the nat gateway private clusters egress through
+type NATGateways interface {
+	GetNatGateway(ctx context.Context, natGatewayName string) (network.NatGateway, error)
+	CreateNatGateway(ctx context.Context, natGatewayName, pipName string) (network.NatGateway, error)
+}
+
+// PrivateDNS manages private dns zones resolving cluster endpoints inside the vnet
+type PrivateDNS interface {
+	GetPrivateDNSZone(ctx context.Context, zoneName string) (privatedns.PrivateZone, error)
+	CreatePrivateDNSZone(ctx context.Context, zoneName, vnetID string) error
+	DeletePrivateDNSZone(ctx context.Context, zoneName, vnetName string) error
+	GetPrivateDNSZoneLink(ctx context.Context, zoneName, vnetName string) (privatedns.VirtualNetworkLink, error)
+	GetPrivateDNSARecord(ctx context.Context, zoneName, recordName string) (privatedns.RecordSet, error)
+	CreatePrivateDNSARecord(ctx context.Context, zoneName, recordName, ipAddress string) error
+}
+
+// PublicIPs manages public ip addresses
+type PublicIPs interface {
+	GetPublicIP(ctx context.Context, ipName string) (network.PublicIPAddress, error)
+	CreatePublicIP(ctx context.Context, ipName string) (network.PublicIPAddress, error)
+	ListPublicIPs(ctx context.Context) ([]network.PublicIPAddress, error)
+	DeletePublicIP(ctx context.Context, ipName string) error
+}
+
+// VMSS manages virtual machine scale sets
+type VMSS interface {
+	GetVMSS(ctx context.Context, vmssName string) (compute.VirtualMachineScaleSet, error)
+	ListVMSS(ctx context.Context) ([]compute.VirtualMachineScaleSet, error)
+	CreateVMSS(ctx context.Context, vmssName, subnetID string, loadbalancerIDs, natPoolIDs []string, customData, vmSKUType string, count, ipConfigurations int) error
+	ScaleVMSS(ctx context.Context, vmssName string, customData string, count int) error
+	DeleteVMSS(ctx context.Context, vmssName string) error
+}
+
+// VMSSVMs manages the instances of a virtual machine scale set
+type VMSSVMs interface {
+	ListVMSSVMs(ctx context.Context, vmssName string) ([]compute.VirtualMachineScaleSetVM, error)
+	DeleteVMSSVM(ctx context.Context, vmssName, instanceID string) error
+	ReimageVMSSVM(ctx context.Context, vmssName, instanceID string) error
+}
+
+// RunCommand runs scripts on scale set instances
+type RunCommand interface {
+	RunCommandVMSSVM(ctx context.Context, vmssName, instanceID string, input compute.RunCommandInput) (compute.RunCommandResult, error)
+}
+
+// SKUs lists the compute resource skus available to the subscription
+type SKUs interface {
+	ListResourceSKUs(ctx context.Context) ([]compute.ResourceSku, error)
+}
+
+// Provider is every azure operation the bootstrap package and controllers depend on
+type Provider interface {
+	ResourceGroups
+	VNets
+	NSGs
+	LoadBalancers
+	NATGateways
+	PrivateDNS
+	PublicIPs
+	VMSS
+	VMSSVMs
+	RunCommand
+	SKUs
+}
+
+// ProviderFactory returns a Provider operating on the given cloud configuration
+type ProviderFactory func(c *CloudConfiguration) (Provider, error)
+
+var _ Provider = &CloudConfiguration{}
+
+// NewProvider returns a Provider backed by the azure apis
+func NewProvider(c *CloudConfiguration) (Provider, error) {
+	return c, nil
+}
@@ -5,34 +5,56 @@ import (
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-02-01/network"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/to"
 )
 
 func (c *CloudConfiguration) GetRouteTablesClient() (network.RouteTablesClient, error) {
-	routeTableClient := network.NewRouteTablesClient(c.SubscriptionID)
-	auth, err := c.getAuthorizerForResource()
+	client, err := c.getClient("GetRouteTablesClient", func(a autorest.Authorizer) interface{} {
+		routeTableClient := network.NewRouteTablesClientWithBaseURI(c.baseURI(), c.SubscriptionID)
+		routeTableClient.Authorizer = a
+		routeTableClient.AddToUserAgent(c.UserAgent)
+		return routeTableClient
+	})
 	if err != nil {
-		return routeTableClient, err
+		return network.RouteTablesClient{}, err
 	}
-	routeTableClient.Authorizer = auth
-	routeTableClient.AddToUserAgent(c.UserAgent)
-	return routeTableClient, nil
+	return client.(network.RouteTablesClient), nil
 }
 
-// CreateRouteTables creates a new empty route tables
+// GetRouteTable gets info on a route table
+func (c *CloudConfiguration) GetRouteTable(ctx context.Context, routeTableName string) (network.RouteTable, error) {
+	routeTablesClient, err := c.GetRouteTablesClient()
+	if err != nil {
+		return network.RouteTable{}, err
+	}
+	return routeTablesClient.Get(ctx, c.GroupName, routeTableName, "")
+}
+
+// CreateRouteTables creates a new empty route tables, routes already present on an
+// existing table, e.g. pod routes added by the cloud provider, are preserved
 func (c *CloudConfiguration) CreateRouteTables(ctx context.Context, routeTableName string) (network.RouteTable, error) {
 	routeTablesClient, err := c.GetRouteTablesClient()
 	if err != nil {
 		return network.RouteTable{}, err
 	}
 
+	properties := &network.RouteTablePropertiesFormat{}
+	existing, err := routeTablesClient.Get(ctx, c.GroupName, routeTableName, "")
+	if err != nil && !ResourceNotFound(err) {
+		return network.RouteTable{}, err
+	}
+	if err == nil && existing.RouteTablePropertiesFormat != nil {
+		properties.Routes = existing.Routes
+	}
+
 	future, err := routeTablesClient.CreateOrUpdate(
 		ctx,
 		c.GroupName,
 		routeTableName,
 		network.RouteTable{
 			Location:                   to.StringPtr(c.GroupLocation),
-			RouteTablePropertiesFormat: &network.RouteTablePropertiesFormat{},
+			RouteTablePropertiesFormat: properties,
 		},
 	)
 
@@ -5,18 +5,30 @@ import (
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-02-01/network"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/to"
 )
 
 func (c *CloudConfiguration) GetNSGClient() (network.SecurityGroupsClient, error) {
-	nsgClient := network.NewSecurityGroupsClient(c.SubscriptionID)
-	auth, err := c.getAuthorizerForResource()
+	client, err := c.getClient("GetNSGClient", func(a autorest.Authorizer) interface{} {
+		nsgClient := network.NewSecurityGroupsClientWithBaseURI(c.baseURI(), c.SubscriptionID)
+		nsgClient.Authorizer = a
+		nsgClient.AddToUserAgent(c.UserAgent)
+		return nsgClient
+	})
 	if err != nil {
-		return nsgClient, err
+		return network.SecurityGroupsClient{}, err
 	}
-	nsgClient.Authorizer = auth
-	nsgClient.AddToUserAgent(c.UserAgent)
-	return nsgClient, nil
+	return client.(network.SecurityGroupsClient), nil
+}
+
+// GetNetworkSecurityGroup gets info on a network security group
+func (c *CloudConfiguration) GetNetworkSecurityGroup(ctx context.Context, nsgName string) (network.SecurityGroup, error) {
+	nsgClient, err := c.GetNSGClient()
+	if err != nil {
+		return network.SecurityGroup{}, err
+	}
+	return nsgClient.Get(ctx, c.GroupName, nsgName, "")
 }
 
 // CreateNetworkSecurityGroup creates a new network security group with rules set for allowing SSH and HTTPS use
@@ -90,19 +102,30 @@ func (c *CloudConfiguration) CreateNetworkSecurityGroup(ctx context.Context, nsg
 	return future.Result(nsgClient)
 }
 
-//CreateDefaultNetworkSecurityGroup creates a new network security group, without rules (rules can be set later)
+// CreateDefaultNetworkSecurityGroup creates a new network security group, without rules (rules can be set later)
+// rules already present on an existing group, e.g. added by the cloud provider, are preserved
 func (c *CloudConfiguration) CreateDefaultNetworkSecurityGroup(ctx context.Context, nsgName string) (network.SecurityGroup, error) {
 	nsgClient, err := c.GetNSGClient()
 	if err != nil {
 		return network.SecurityGroup{}, err
 	}
+
+	properties := &network.SecurityGroupPropertiesFormat{}
+	existing, err := nsgClient.Get(ctx, c.GroupName, nsgName, "")
+	if err != nil && !ResourceNotFound(err) {
+		return network.SecurityGroup{}, err
+	}
+	if err == nil && existing.SecurityGroupPropertiesFormat != nil {
+		properties.SecurityRules = existing.SecurityRules
+	}
+
 	future, err := nsgClient.CreateOrUpdate(
 		ctx,
 		c.GroupName,
 		nsgName,
 		network.SecurityGroup{
 			Location:                      to.StringPtr(c.GroupLocation),
-			SecurityGroupPropertiesFormat: &network.SecurityGroupPropertiesFormat{},
+			SecurityGroupPropertiesFormat: properties,
 		},
 	)
 
@@ -118,6 +141,35 @@ func (c *CloudConfiguration) CreateDefaultNetworkSecurityGroup(ctx context.Conte
 	return future.Result(nsgClient)
 }
 
+// UpdateNetworkSecurityGroupRules replaces the rules of an existing network security group
+func (c *CloudConfiguration) UpdateNetworkSecurityGroupRules(ctx context.Context, nsgName string, rules []network.SecurityRule) error {
+	nsgClient, err := c.GetNSGClient()
+	if err != nil {
+		return err
+	}
+	nsg, err := nsgClient.Get(ctx, c.GroupName, nsgName, "")
+	if err != nil {
+		return err
+	}
+	if nsg.SecurityGroupPropertiesFormat == nil {
+		nsg.SecurityGroupPropertiesFormat = &network.SecurityGroupPropertiesFormat{}
+	}
+	nsg.SecurityRules = &rules
+
+	future, err := nsgClient.CreateOrUpdate(ctx, c.GroupName, nsgName, nsg)
+	if err != nil {
+		return fmt.Errorf("cannot update nsg rules: %v", err)
+	}
+
+	err = future.WaitForCompletionRef(ctx, nsgClient.Client)
+	if err != nil {
+		return fmt.Errorf("cannot get nsg create or update future response: %v", err)
+	}
+
+	_, err = future.Result(nsgClient)
+	return err
+}
+
 // DeleteNetworkSecurityGroup deletes an existing network security group
 func (c *CloudConfiguration) DeleteNetworkSecurityGroup(ctx context.Context, nsgName string) error {
 	nsgClient, err := c.GetNSGClient()
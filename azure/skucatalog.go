@@ -0,0 +1,235 @@
+package azhelpers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
+)
+
+// DefaultSKUCatalogTTL is how long listed skus are served from the catalog
+const DefaultSKUCatalogTTL = time.Hour
+
+// VMSKU describes a virtual machine size offered in a location
+type VMSKU struct {
+	Name                  string
+	Zones                 []string
+	VCPUs                 int
+	MemoryGB              float64
+	PremiumIO             bool
+	AcceleratedNetworking bool
+	EphemeralOSDisk       bool
+	// Restrictions are the reasons the sku can not be used by the subscription in the location
+	Restrictions []string
+}
+
+// Available returns true if the subscription can create the sku in the location
+func (s VMSKU) Available() bool {
+	return len(s.Restrictions) == 0
+}
+
+func (s VMSKU) String() string {
+	return fmt.Sprintf("%s (%d vCPU, %g GiB)", s.Name, s.VCPUs, s.MemoryGB)
+}
+
+type skuCatalogEntry struct {
+	skus    map[string]VMSKU
+	expires time.Time
+}
+
+// SKUCatalog caches the virtual machine skus of every location, listing the skus of a
+// subscription is slow so a single listing is shared until the TTL expires
+type SKUCatalog struct {
+	TTL time.Duration
+	// SkipUnlistedLocations accepts any sku in locations without listed skus, set for local
+	// stand-ins of the resource manager which list none
+	SkipUnlistedLocations bool
+
+	list      func(context.Context) ([]compute.ResourceSku, error)
+	now       func() time.Time
+	mu        sync.Mutex
+	locations map[string]skuCatalogEntry
+}
+
+// NewSKUCatalog returns a catalog of the skus returned by list
+func NewSKUCatalog(list func(context.Context) ([]compute.ResourceSku, error), ttl time.Duration) *SKUCatalog {
+	return &SKUCatalog{
+		TTL:       ttl,
+		list:      list,
+		now:       time.Now,
+		locations: map[string]skuCatalogEntry{},
+	}
+}
+
+// SKUCatalog returns the sku catalog shared by configurations with the same credentials
+func (c *CloudConfiguration) SKUCatalog() *SKUCatalog {
+	cache := c.clientCache()
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	if cache.skus == nil {
+		cache.skus = NewSKUCatalog(c.ListResourceSKUs, DefaultSKUCatalogTTL)
+		cache.skus.SkipUnlistedLocations = c.ResourceManagerEndpoint != ""
+	}
+	return cache.skus
+}
+
+// entry returns the skus of location, refreshing every location once the cached listing expired
+func (catalog *SKUCatalog) entry(ctx context.Context, location string) (map[string]VMSKU, error) {
+	catalog.mu.Lock()
+	defer catalog.mu.Unlock()
+
+	location = normalizeLocation(location)
+	if entry, ok := catalog.locations[location]; ok && catalog.now().Before(entry.expires) {
+		return entry.skus, nil
+	}
+
+	skus, err := catalog.list(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list resource skus: %v", err)
+	}
+	expires := catalog.now().Add(catalog.TTL)
+	catalog.locations = map[string]skuCatalogEntry{}
+	for _, sku := range skus {
+		if sku.Name == nil || !strings.EqualFold(stringValue(sku.ResourceType), "virtualMachines") || sku.LocationInfo == nil {
+			continue
+		}
+		for _, locationInfo := range *sku.LocationInfo {
+			l := normalizeLocation(stringValue(locationInfo.Location))
+			entry, ok := catalog.locations[l]
+			if !ok {
+				entry = skuCatalogEntry{skus: map[string]VMSKU{}, expires: expires}
+				catalog.locations[l] = entry
+			}
+			entry.skus[strings.ToLower(*sku.Name)] = newVMSKU(sku, locationInfo)
+		}
+	}
+	if _, ok := catalog.locations[location]; !ok {
+		catalog.locations[location] = skuCatalogEntry{skus: map[string]VMSKU{}, expires: expires}
+	}
+	return catalog.locations[location].skus, nil
+}
+
+// SKUs returns the virtual machine skus offered in location sorted by name, including restricted ones
+func (catalog *SKUCatalog) SKUs(ctx context.Context, location string) ([]VMSKU, error) {
+	entry, err := catalog.entry(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+	skus := make([]VMSKU, 0, len(entry))
+	for _, sku := range entry {
+		skus = append(skus, sku)
+	}
+	sort.Slice(skus, func(i, j int) bool { return skus[i].Name < skus[j].Name })
+	return skus, nil
+}
+
+// AvailableSKUs returns the virtual machine skus the subscription can create in location
+func (catalog *SKUCatalog) AvailableSKUs(ctx context.Context, location string) ([]VMSKU, error) {
+	skus, err := catalog.SKUs(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+	available := skus[:0]
+	for _, sku := range skus {
+		if sku.Available() {
+			available = append(available, sku)
+		}
+	}
+	return available, nil
+}
+
+// Check returns the sku if the subscription can create it in location, locations without any
+// listed skus are only accepted with SkipUnlistedLocations
+func (catalog *SKUCatalog) Check(ctx context.Context, location, name string) (VMSKU, error) {
+	entry, err := catalog.entry(ctx, location)
+	if err != nil {
+		return VMSKU{}, err
+	}
+	if len(entry) == 0 && catalog.SkipUnlistedLocations {
+		return VMSKU{Name: name}, nil
+	}
+	sku, ok := entry[strings.ToLower(name)]
+	if !ok {
+		return VMSKU{}, fmt.Errorf("vm size %s is not offered in %s", name, location)
+	}
+	if !sku.Available() {
+		return sku, fmt.Errorf("vm size %s is not available in %s: %s", name, location, strings.Join(sku.Restrictions, ", "))
+	}
+	return sku, nil
+}
+
+func newVMSKU(sku compute.ResourceSku, locationInfo compute.ResourceSkuLocationInfo) VMSKU {
+	location := normalizeLocation(stringValue(locationInfo.Location))
+	vmSKU := VMSKU{Name: *sku.Name}
+
+	restrictedZones := map[string]bool{}
+	if sku.Restrictions != nil {
+		for _, restriction := range *sku.Restrictions {
+			switch restriction.Type {
+			case compute.Location:
+				if restriction.Values != nil && containsLocation(*restriction.Values, location) {
+					vmSKU.Restrictions = append(vmSKU.Restrictions, string(restriction.ReasonCode))
+				}
+			case compute.Zone:
+				if restriction.RestrictionInfo != nil && restriction.RestrictionInfo.Zones != nil &&
+					(restriction.RestrictionInfo.Locations == nil || containsLocation(*restriction.RestrictionInfo.Locations, location)) {
+					for _, zone := range *restriction.RestrictionInfo.Zones {
+						restrictedZones[zone] = true
+					}
+				}
+			}
+		}
+	}
+	if locationInfo.Zones != nil {
+		for _, zone := range *locationInfo.Zones {
+			if !restrictedZones[zone] {
+				vmSKU.Zones = append(vmSKU.Zones, zone)
+			}
+		}
+		sort.Strings(vmSKU.Zones)
+	}
+
+	if sku.Capabilities != nil {
+		for _, capability := range *sku.Capabilities {
+			value := stringValue(capability.Value)
+			switch stringValue(capability.Name) {
+			case "vCPUs":
+				vmSKU.VCPUs, _ = strconv.Atoi(value)
+			case "MemoryGB":
+				vmSKU.MemoryGB, _ = strconv.ParseFloat(value, 64)
+			case "PremiumIO":
+				vmSKU.PremiumIO = strings.EqualFold(value, "True")
+			case "AcceleratedNetworkingEnabled":
+				vmSKU.AcceleratedNetworking = strings.EqualFold(value, "True")
+			case "EphemeralOSDiskSupported":
+				vmSKU.EphemeralOSDisk = strings.EqualFold(value, "True")
+			}
+		}
+	}
+	return vmSKU
+}
+
+func normalizeLocation(location string) string {
+	return strings.Replace(strings.ToLower(location), " ", "", -1)
+}
+
+func containsLocation(locations []string, location string) bool {
+	for _, l := range locations {
+		if normalizeLocation(l) == location {
+			return true
+		}
+	}
+	return false
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
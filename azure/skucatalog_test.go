@@ -0,0 +1,108 @@
+package azhelpers
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+func TestSKUCatalog(t *testing.T) {
+	skus := []compute.ResourceSku{
+		{
+			Name:         to.StringPtr("Standard_D2s_v3"),
+			ResourceType: to.StringPtr("virtualMachines"),
+			LocationInfo: &[]compute.ResourceSkuLocationInfo{
+				{Location: to.StringPtr("westus2"), Zones: &[]string{"3", "1", "2"}},
+			},
+			Capabilities: &[]compute.ResourceSkuCapabilities{
+				{Name: to.StringPtr("vCPUs"), Value: to.StringPtr("2")},
+				{Name: to.StringPtr("MemoryGB"), Value: to.StringPtr("8")},
+				{Name: to.StringPtr("PremiumIO"), Value: to.StringPtr("True")},
+				{Name: to.StringPtr("AcceleratedNetworkingEnabled"), Value: to.StringPtr("False")},
+				{Name: to.StringPtr("EphemeralOSDiskSupported"), Value: to.StringPtr("True")},
+			},
+			Restrictions: &[]compute.ResourceSkuRestrictions{
+				{
+					Type:            compute.Zone,
+					Values:          &[]string{"westus2"},
+					RestrictionInfo: &compute.ResourceSkuRestrictionInfo{Locations: &[]string{"westus2"}, Zones: &[]string{"2"}},
+					ReasonCode:      compute.NotAvailableForSubscription,
+				},
+			},
+		},
+		{
+			Name:         to.StringPtr("Standard_M128s"),
+			ResourceType: to.StringPtr("virtualMachines"),
+			LocationInfo: &[]compute.ResourceSkuLocationInfo{{Location: to.StringPtr("westus2")}},
+			Restrictions: &[]compute.ResourceSkuRestrictions{
+				{
+					Type:       compute.Location,
+					Values:     &[]string{"westus2"},
+					ReasonCode: compute.NotAvailableForSubscription,
+				},
+			},
+		},
+		{
+			Name:         to.StringPtr("Premium_LRS"),
+			ResourceType: to.StringPtr("disks"),
+			LocationInfo: &[]compute.ResourceSkuLocationInfo{{Location: to.StringPtr("westus2")}},
+		},
+	}
+
+	lists := 0
+	now := time.Now()
+	catalog := NewSKUCatalog(func(context.Context) ([]compute.ResourceSku, error) {
+		lists++
+		return skus, nil
+	}, time.Hour)
+	catalog.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	sku, err := catalog.Check(ctx, "WestUS2", "standard_d2s_v3")
+	if err != nil {
+		t.Fatalf("Expected Standard_D2s_v3 to be available, got %v", err)
+	}
+	expected := VMSKU{
+		Name:            "Standard_D2s_v3",
+		Zones:           []string{"1", "3"},
+		VCPUs:           2,
+		MemoryGB:        8,
+		PremiumIO:       true,
+		EphemeralOSDisk: true,
+	}
+	if !reflect.DeepEqual(sku, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, sku)
+	}
+	if _, err := catalog.Check(ctx, "westus2", "Standard_M128s"); err == nil {
+		t.Fatalf("Expected restricted Standard_M128s to be rejected")
+	}
+	if _, err := catalog.Check(ctx, "westus2", "Premium_LRS"); err == nil {
+		t.Fatalf("Expected non virtual machine sku to be rejected")
+	}
+	if _, err := catalog.Check(ctx, "eastus", "Standard_D2s_v3"); err == nil {
+		t.Fatalf("Expected sku not offered in location without skus to be rejected")
+	}
+	catalog.SkipUnlistedLocations = true
+	if _, err := catalog.Check(ctx, "eastus", "Standard_D2s_v3"); err != nil {
+		t.Fatalf("Expected location without skus to not be validated, got %v", err)
+	}
+	available, err := catalog.AvailableSKUs(ctx, "westus2")
+	if err != nil || len(available) != 1 || available[0].Name != "Standard_D2s_v3" {
+		t.Fatalf("Expected only Standard_D2s_v3 to be available, got %v %v", available, err)
+	}
+	if lists != 2 {
+		t.Fatalf("Expected skus to be listed once per unknown location, got %d listings", lists)
+	}
+
+	now = now.Add(2 * time.Hour)
+	if _, err := catalog.SKUs(ctx, "westus2"); err != nil {
+		t.Fatalf("Failed to list skus: %v", err)
+	}
+	if lists != 3 {
+		t.Fatalf("Expected expired skus to be listed again, got %d listings", lists)
+	}
+}
@@ -0,0 +1,43 @@
+package azhelpers
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func (c *CloudConfiguration) GetResourceSkusClient() (compute.ResourceSkusClient, error) {
+	client, err := c.getClient("GetResourceSkusClient", func(a autorest.Authorizer) interface{} {
+		skusClient := compute.NewResourceSkusClientWithBaseURI(c.baseURI(), c.SubscriptionID)
+		skusClient.Authorizer = a
+		skusClient.AddToUserAgent(c.UserAgent)
+		return skusClient
+	})
+	if err != nil {
+		return compute.ResourceSkusClient{}, err
+	}
+	return client.(compute.ResourceSkusClient), nil
+}
+
+// ListResourceSKUs lists all compute resource skus available to the subscription
+func (c *CloudConfiguration) ListResourceSKUs(ctx context.Context) ([]compute.ResourceSku, error) {
+	skusClient, err := c.GetResourceSkusClient()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := skusClient.ListComplete(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var skus []compute.ResourceSku
+	for res.NotDone() {
+		skus = append(skus, res.Value())
+		if err := res.NextWithContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return skus, nil
+}
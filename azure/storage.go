@@ -0,0 +1,102 @@
+package azhelpers
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+func (c *CloudConfiguration) GetStorageAccountsClient() (storage.AccountsClient, error) {
+	client, err := c.getClient("GetStorageAccountsClient", func(a autorest.Authorizer) interface{} {
+		accountsClient := storage.NewAccountsClientWithBaseURI(c.baseURI(), c.SubscriptionID)
+		accountsClient.Authorizer = a
+		accountsClient.AddToUserAgent(c.UserAgent)
+		return accountsClient
+	})
+	if err != nil {
+		return storage.AccountsClient{}, err
+	}
+	return client.(storage.AccountsClient), nil
+}
+
+func (c *CloudConfiguration) GetBlobContainersClient() (storage.BlobContainersClient, error) {
+	client, err := c.getClient("GetBlobContainersClient", func(a autorest.Authorizer) interface{} {
+		containersClient := storage.NewBlobContainersClientWithBaseURI(c.baseURI(), c.SubscriptionID)
+		containersClient.Authorizer = a
+		containersClient.AddToUserAgent(c.UserAgent)
+		return containersClient
+	})
+	if err != nil {
+		return storage.BlobContainersClient{}, err
+	}
+	return client.(storage.BlobContainersClient), nil
+}
+
+// GetBlobContainerSAS creates the private blob container in an existing storage account if missing, and
+// returns its url with a shared access signature granting permissions on its blobs until expiry, e.g. "rwd"
+func (c *CloudConfiguration) GetBlobContainerSAS(ctx context.Context, groupName, accountName, containerName, permissions string, expiry time.Duration) (string, string, error) {
+	if groupName == "" {
+		groupName = c.GroupName
+	}
+	accountsClient, err := c.GetStorageAccountsClient()
+	if err != nil {
+		return "", "", err
+	}
+	containersClient, err := c.GetBlobContainersClient()
+	if err != nil {
+		return "", "", err
+	}
+
+	account, err := accountsClient.GetProperties(ctx, groupName, accountName, "")
+	if err != nil {
+		return "", "", fmt.Errorf("cannot get storage account %s: %v", accountName, err)
+	}
+	if account.AccountProperties == nil || account.PrimaryEndpoints == nil || account.PrimaryEndpoints.Blob == nil {
+		return "", "", fmt.Errorf("storage account %s has no blob endpoint", accountName)
+	}
+
+	if _, err := containersClient.Get(ctx, groupName, accountName, containerName); err != nil {
+		if !ResourceNotFound(err) {
+			return "", "", err
+		}
+		_, err = containersClient.Create(ctx, groupName, accountName, containerName, storage.BlobContainer{
+			ContainerProperties: &storage.ContainerProperties{PublicAccess: storage.PublicAccessNone},
+		})
+		if err != nil {
+			return "", "", fmt.Errorf("cannot create blob container %s: %v", containerName, err)
+		}
+	}
+
+	sas, err := accountsClient.ListServiceSAS(ctx, groupName, accountName, storage.ServiceSasParameters{
+		CanonicalizedResource:  to.StringPtr(fmt.Sprintf("/blob/%s/%s", accountName, containerName)),
+		Resource:               storage.SignedResourceC,
+		Permissions:            storage.Permissions(permissions),
+		Protocols:              storage.HTTPS,
+		SharedAccessExpiryTime: &date.Time{Time: time.Now().Add(expiry)},
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("cannot create shared access signature for %s: %v", containerName, err)
+	}
+	return strings.TrimSuffix(*account.PrimaryEndpoints.Blob, "/") + "/" + containerName, to.String(sas.ServiceSasToken), nil
+}
+
+// ParseBlobURL splits a blob url like https://account.blob.core.windows.net/container/name into
+// the storage account, container and blob name, the query holding a shared access signature is ignored
+func ParseBlobURL(blobURL string) (string, string, string, error) {
+	u, err := url.Parse(blobURL)
+	if err != nil {
+		return "", "", "", err
+	}
+	parts := strings.SplitN(strings.TrimPrefix(u.Path, "/"), "/", 2)
+	if u.Scheme != "https" || !strings.Contains(u.Host, ".blob.") || len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", "", fmt.Errorf("invalid blob url %s, expected https://<account>.blob.<suffix>/<container>/<name>", blobURL)
+	}
+	return strings.SplitN(u.Host, ".", 2)[0], parts[0], parts[1], nil
+}
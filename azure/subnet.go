@@ -2,19 +2,24 @@ package azhelpers
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-02-01/network"
+	"github.com/Azure/go-autorest/autorest"
 )
 
 func (c *CloudConfiguration) GetSubnetsClient() (network.SubnetsClient, error) {
-	subnetsClient := network.NewSubnetsClient(c.SubscriptionID)
-	auth, err := c.getAuthorizerForResource()
+	client, err := c.getClient("GetSubnetsClient", func(a autorest.Authorizer) interface{} {
+		subnetsClient := network.NewSubnetsClientWithBaseURI(c.baseURI(), c.SubscriptionID)
+		subnetsClient.Authorizer = a
+		subnetsClient.AddToUserAgent(c.UserAgent)
+		return subnetsClient
+	})
 	if err != nil {
-		return subnetsClient, err
+		return network.SubnetsClient{}, err
 	}
-	subnetsClient.Authorizer = auth
-	subnetsClient.AddToUserAgent(c.UserAgent)
-	return subnetsClient, nil
+	return client.(network.SubnetsClient), nil
 }
 
 // GetSubnet returns an existing subnet from a virtual network
@@ -25,3 +30,56 @@ func (c *CloudConfiguration) GetSubnet(ctx context.Context, vnetName, subnetName
 	}
 	return subnetsClient.Get(ctx, c.GroupName, vnetName, subnetName, "")
 }
+
+// GetSubnetByID returns an existing subnet of any virtual network of the subscription
+func (c *CloudConfiguration) GetSubnetByID(ctx context.Context, subnetID string) (network.Subnet, error) {
+	subnet, err := ParseSubnetID(subnetID)
+	if err != nil {
+		return network.Subnet{}, err
+	}
+	subnetsClient, err := c.GetSubnetsClient()
+	if err != nil {
+		return network.Subnet{}, err
+	}
+	return subnetsClient.Get(ctx, subnet.ResourceGroup, subnet.VNetName, subnet.Name, "")
+}
+
+// SubnetResource identifies a subnet by the parts of its resource id
+type SubnetResource struct {
+	SubscriptionID string
+	ResourceGroup  string
+	VNetName       string
+	Name           string
+}
+
+// ParseSubnetID parses a subnet resource id of the form
+// /subscriptions/<id>/resourceGroups/<group>/providers/Microsoft.Network/virtualNetworks/<vnet>/subnets/<subnet>
+func ParseSubnetID(subnetID string) (SubnetResource, error) {
+	parts := strings.Split(strings.Trim(subnetID, "/"), "/")
+	if len(parts) != 10 ||
+		!strings.EqualFold(parts[0], "subscriptions") ||
+		!strings.EqualFold(parts[2], "resourceGroups") ||
+		!strings.EqualFold(parts[4], "providers") ||
+		!strings.EqualFold(parts[5], "Microsoft.Network") ||
+		!strings.EqualFold(parts[6], "virtualNetworks") ||
+		!strings.EqualFold(parts[8], "subnets") {
+		return SubnetResource{}, fmt.Errorf("%q is not a subnet resource id", subnetID)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return SubnetResource{}, fmt.Errorf("%q is not a subnet resource id", subnetID)
+		}
+	}
+	return SubnetResource{
+		SubscriptionID: parts[1],
+		ResourceGroup:  parts[3],
+		VNetName:       parts[7],
+		Name:           parts[9],
+	}, nil
+}
+
+// VNetID returns the resource id of the virtual network of the subnet
+func (s SubnetResource) VNetID() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualNetworks/%s",
+		s.SubscriptionID, s.ResourceGroup, s.VNetName)
+}
@@ -4,92 +4,98 @@ import (
 	"context"
 	"crypto/rand"
 	"crypto/rsa"
-	"encoding/base64"
 	"fmt"
-	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/awesomenix/azk/helpers"
 	"golang.org/x/crypto/ssh"
 )
 
-func GetCustomData(customData map[string]string, customRunData map[string]string) string {
-	customDataStr := fmt.Sprintf(`
-#cloud-config
-write_files:
-`)
-	for customDataKey, customDataValue := range customData {
-		customDataStr += fmt.Sprintf(`
-- path: %s
-  permissions: "0644"
-  encoding: base64
-  owner: root
-  content: |
-    %s
-`,
-			customDataKey,
-			base64.StdEncoding.EncodeToString([]byte(customDataValue)))
-	}
-	for customDataKey, customDataValue := range customRunData {
-		customDataStr += fmt.Sprintf(`
-- path: %s
-  permissions: "0755"
-  encoding: base64
-  owner: root
-  content: |
-    %s
-`,
-			customDataKey,
-			base64.StdEncoding.EncodeToString([]byte(customDataValue)))
-	}
-	customDataStr += fmt.Sprintf(`
-runcmd:
-`)
-	for customDataKey := range customRunData {
-		customDataStr += fmt.Sprintf(`
- - sudo %s
-`, customDataKey)
-	}
-
-	return customDataStr
-}
-
 func (c *CloudConfiguration) GetVMSSExtensionsClient() (compute.VirtualMachineScaleSetExtensionsClient, error) {
-	extClient := compute.NewVirtualMachineScaleSetExtensionsClient(c.SubscriptionID)
-	a, err := c.getAuthorizerForResource()
+	client, err := c.getClient("GetVMSSExtensionsClient", func(a autorest.Authorizer) interface{} {
+		extClient := compute.NewVirtualMachineScaleSetExtensionsClientWithBaseURI(c.baseURI(), c.SubscriptionID)
+		extClient.Authorizer = a
+		extClient.AddToUserAgent(c.UserAgent)
+		return extClient
+	})
 	if err != nil {
-		return extClient, err
+		return compute.VirtualMachineScaleSetExtensionsClient{}, err
 	}
-	extClient.Authorizer = a
-	extClient.AddToUserAgent(c.UserAgent)
-	return extClient, nil
+	return client.(compute.VirtualMachineScaleSetExtensionsClient), nil
 }
 
 func (c *CloudConfiguration) GetVMSSClient() (compute.VirtualMachineScaleSetsClient, error) {
-	vmssClient := compute.NewVirtualMachineScaleSetsClient(c.SubscriptionID)
-	a, err := c.getAuthorizerForResource()
+	client, err := c.getClient("GetVMSSClient", func(a autorest.Authorizer) interface{} {
+		vmssClient := compute.NewVirtualMachineScaleSetsClientWithBaseURI(c.baseURI(), c.SubscriptionID)
+		vmssClient.Authorizer = a
+		vmssClient.AddToUserAgent(c.UserAgent)
+		return vmssClient
+	})
 	if err != nil {
-		return vmssClient, err
+		return compute.VirtualMachineScaleSetsClient{}, err
 	}
-	vmssClient.Authorizer = a
-	vmssClient.AddToUserAgent(c.UserAgent)
-	return vmssClient, nil
+	return client.(compute.VirtualMachineScaleSetsClient), nil
 }
 
 func (c *CloudConfiguration) GetVMSSVMsClient() (compute.VirtualMachineScaleSetVMsClient, error) {
-	vmssVMsClient := compute.NewVirtualMachineScaleSetVMsClient(c.SubscriptionID)
-	a, err := c.getAuthorizerForResource()
+	client, err := c.getClient("GetVMSSVMsClient", func(a autorest.Authorizer) interface{} {
+		vmssVMsClient := compute.NewVirtualMachineScaleSetVMsClientWithBaseURI(c.baseURI(), c.SubscriptionID)
+		vmssVMsClient.Authorizer = a
+		vmssVMsClient.AddToUserAgent(c.UserAgent)
+		return vmssVMsClient
+	})
 	if err != nil {
-		return vmssVMsClient, err
+		return compute.VirtualMachineScaleSetVMsClient{}, err
 	}
-	vmssVMsClient.Authorizer = a
-	vmssVMsClient.AddToUserAgent(c.UserAgent)
-	return vmssVMsClient, nil
+	return client.(compute.VirtualMachineScaleSetVMsClient), nil
+}
+
+// VMSSIPConfigurations returns the ip configurations of the network interface of every instance. The
+// primary one is in the load balancer pools, the secondary ones only take addresses of the subnet.
+func VMSSIPConfigurations(vmssName, subnetID string, loadbalancerIDs, natPoolIDs []string, count int) []compute.VirtualMachineScaleSetIPConfiguration {
+	var backendAddressPools []compute.SubResource
+	for _, loadBalancerID := range loadbalancerIDs {
+		backendAddressPools = append(backendAddressPools, compute.SubResource{ID: to.StringPtr(loadBalancerID)})
+	}
+
+	var inboundNatPools []compute.SubResource
+	for _, natPoolID := range natPoolIDs {
+		inboundNatPools = append(inboundNatPools, compute.SubResource{ID: to.StringPtr(natPoolID)})
+	}
+
+	ipConfigurations := []compute.VirtualMachineScaleSetIPConfiguration{
+		{
+			Name: to.StringPtr(vmssName),
+			VirtualMachineScaleSetIPConfigurationProperties: &compute.VirtualMachineScaleSetIPConfigurationProperties{
+				Subnet: &compute.APIEntityReference{
+					ID: to.StringPtr(subnetID),
+				},
+				LoadBalancerBackendAddressPools: &backendAddressPools,
+				LoadBalancerInboundNatPools:     &inboundNatPools,
+			},
+		},
+	}
+	if count > 1 {
+		ipConfigurations[0].Primary = to.BoolPtr(true)
+	}
+	for i := 1; i < count; i++ {
+		ipConfigurations = append(ipConfigurations, compute.VirtualMachineScaleSetIPConfiguration{
+			Name: to.StringPtr(fmt.Sprintf("ipconfig%d", i+1)),
+			VirtualMachineScaleSetIPConfigurationProperties: &compute.VirtualMachineScaleSetIPConfigurationProperties{
+				Subnet: &compute.APIEntityReference{
+					ID: to.StringPtr(subnetID),
+				},
+			},
+		})
+	}
+	return ipConfigurations
 }
 
 // CreateVMSS creates a new virtual machine scale set with the specified name using the specified vnet and subnet.
-// Username, password, and sshPublicKeyPath determine logon credentials.
+// Username, password, and sshPublicKeyPath determine logon credentials. ipConfigurations is the number of ip
+// configurations of the network interface of every instance.
 func (c *CloudConfiguration) CreateVMSS(ctx context.Context,
 	vmssName,
 	subnetID string,
@@ -98,17 +104,10 @@ func (c *CloudConfiguration) CreateVMSS(ctx context.Context,
 	//startupScript,
 	customData,
 	vmSKUType string,
-	count int) error {
+	count int,
+	ipConfigurations int) error {
 
-	var backendAddressPools []compute.SubResource
-	for _, loadBalancerID := range loadbalancerIDs {
-		backendAddressPools = append(backendAddressPools, compute.SubResource{ID: to.StringPtr(loadBalancerID)})
-	}
-
-	var inboundNatPools []compute.SubResource
-	for _, natPoolID := range natPoolIDs {
-		inboundNatPools = append(inboundNatPools, compute.SubResource{ID: to.StringPtr(natPoolID)})
-	}
+	ipConfigs := VMSSIPConfigurations(vmssName, subnetID, loadbalancerIDs, natPoolIDs, ipConfigurations)
 
 	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
 	if err != nil {
@@ -127,10 +126,11 @@ func (c *CloudConfiguration) CreateVMSS(ctx context.Context,
 		return err
 	}
 
-	zones, err := c.getZones(vmSKUType)
+	sku, err := c.SKUCatalog().Check(ctx, c.GroupLocation, vmSKUType)
 	if err != nil {
 		return err
 	}
+	zones := sku.Zones
 
 	virtualMachineScaleSet := compute.VirtualMachineScaleSet{
 		Location: to.StringPtr(c.GroupLocation),
@@ -186,18 +186,7 @@ func (c *CloudConfiguration) CreateVMSS(ctx context.Context,
 							VirtualMachineScaleSetNetworkConfigurationProperties: &compute.VirtualMachineScaleSetNetworkConfigurationProperties{
 								Primary:            to.BoolPtr(true),
 								EnableIPForwarding: to.BoolPtr(true),
-								IPConfigurations: &[]compute.VirtualMachineScaleSetIPConfiguration{
-									{
-										Name: to.StringPtr(vmssName),
-										VirtualMachineScaleSetIPConfigurationProperties: &compute.VirtualMachineScaleSetIPConfigurationProperties{
-											Subnet: &compute.APIEntityReference{
-												ID: to.StringPtr(subnetID),
-											},
-											LoadBalancerBackendAddressPools: &backendAddressPools,
-											LoadBalancerInboundNatPools:     &inboundNatPools,
-										},
-									},
-								},
+								IPConfigurations:   &ipConfigs,
 							},
 						},
 					},
@@ -226,6 +215,15 @@ func (c *CloudConfiguration) CreateVMSS(ctx context.Context,
 		virtualMachineScaleSet.VirtualMachineScaleSetProperties.ZoneBalance = to.BoolPtr(true)
 	}
 
+	if c.UserAssignedIdentityResourceID != "" {
+		virtualMachineScaleSet.Identity = &compute.VirtualMachineScaleSetIdentity{
+			Type: compute.ResourceIdentityTypeUserAssigned,
+			UserAssignedIdentities: map[string]*compute.VirtualMachineScaleSetIdentityUserAssignedIdentitiesValue{
+				c.UserAssignedIdentityResourceID: {},
+			},
+		}
+	}
+
 	future, err := vmssClient.CreateOrUpdate(
 		ctx,
 		c.GroupName,
@@ -254,6 +252,28 @@ func (c *CloudConfiguration) GetVMSS(ctx context.Context, vmssName string) (comp
 	return vmssClient.Get(ctx, c.GroupName, vmssName)
 }
 
+// ListVMSS lists the scale sets of the cluster resource group
+func (c *CloudConfiguration) ListVMSS(ctx context.Context) ([]compute.VirtualMachineScaleSet, error) {
+	vmssClient, err := c.GetVMSSClient()
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := vmssClient.ListComplete(ctx, c.GroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	var vmss []compute.VirtualMachineScaleSet
+	for ; result.NotDone(); err = result.NextWithContext(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		vmss = append(vmss, result.Value())
+	}
+	return vmss, nil
+}
+
 // UpdateVMSS modifies the VMSS resource by getting it, updating it locally, and
 // putting it back to the server.
 // func (c *CloudConfiguration) UpdateVMSS(ctx context.Context, vmssName string, tags map[string]*string) (vmss compute.VirtualMachineScaleSet, err error) {
@@ -326,6 +346,9 @@ func (c *CloudConfiguration) ScaleVMSS(ctx context.Context, vmssName string, cus
 // DeleteVMSS deallocates the selected VMSS
 func (c *CloudConfiguration) DeleteVMSS(ctx context.Context, vmssName string) error {
 	vmssClient, err := c.GetVMSSClient()
+	if err != nil {
+		return err
+	}
 	// passing nil instance ids will deallocate all VMs in the VMSS
 	future, err := vmssClient.Delete(ctx, c.GroupName, vmssName)
 	if err != nil {
@@ -341,36 +364,6 @@ func (c *CloudConfiguration) DeleteVMSS(ctx context.Context, vmssName string) er
 	return err
 }
 
-func (c *CloudConfiguration) getZones(vmSKU string) ([]string, error) {
-	resClient := compute.NewResourceSkusClient(c.SubscriptionID)
-	a, err := c.getAuthorizerForResource()
-	if err != nil {
-		return nil, err
-	}
-	resClient.Authorizer = a
-	resClient.AddToUserAgent(c.UserAgent)
-
-	res, err := resClient.ListComplete(context.TODO())
-	if err != nil {
-		return nil, err
-	}
-
-	var zones []string
-	for resSku := res.Value(); res.NotDone(); err = res.Next() {
-		if !strings.EqualFold(*resSku.Name, vmSKU) {
-			continue
-		}
-		for _, locationInfo := range *resSku.LocationInfo {
-			if !strings.EqualFold(*locationInfo.Location, c.GroupLocation) {
-				continue
-			}
-			zones = *locationInfo.Zones
-		}
-
-	}
-	return zones, nil
-}
-
 // StartVMSS starts the selected VMSS
 // func (c *CloudConfiguration) StartVMSS(ctx context.Context, vmssName string) (osr autorest.Response, err error) {
 // 	vmssClient := getVMSSClient()
@@ -0,0 +1,92 @@
+package azhelpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
+)
+
+// ListVMSSVMs lists the instances of a scale set along with their instance view
+func (c *CloudConfiguration) ListVMSSVMs(ctx context.Context, vmssName string) ([]compute.VirtualMachineScaleSetVM, error) {
+	vmssVMsClient, err := c.GetVMSSVMsClient()
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := vmssVMsClient.ListComplete(ctx, c.GroupName, vmssName, "", "", string(compute.InstanceView))
+	if err != nil {
+		return nil, err
+	}
+
+	var vms []compute.VirtualMachineScaleSetVM
+	for ; result.NotDone(); err = result.NextWithContext(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		vms = append(vms, result.Value())
+	}
+	return vms, nil
+}
+
+// DeleteVMSSVM deletes a single instance of a scale set
+func (c *CloudConfiguration) DeleteVMSSVM(ctx context.Context, vmssName, instanceID string) error {
+	vmssVMsClient, err := c.GetVMSSVMsClient()
+	if err != nil {
+		return err
+	}
+
+	future, err := vmssVMsClient.Delete(ctx, c.GroupName, vmssName, instanceID)
+	if err != nil {
+		return fmt.Errorf("cannot delete vmss vm: %v", err)
+	}
+
+	err = future.WaitForCompletionRef(ctx, vmssVMsClient.Client)
+	if err != nil {
+		return fmt.Errorf("cannot get the vmss vm delete future response: %v", err)
+	}
+
+	_, err = future.Result(vmssVMsClient)
+	return err
+}
+
+// ReimageVMSSVM reimages a single instance of a scale set with the current scale set model
+func (c *CloudConfiguration) ReimageVMSSVM(ctx context.Context, vmssName, instanceID string) error {
+	vmssVMsClient, err := c.GetVMSSVMsClient()
+	if err != nil {
+		return err
+	}
+
+	future, err := vmssVMsClient.Reimage(ctx, c.GroupName, vmssName, instanceID, nil)
+	if err != nil {
+		return fmt.Errorf("cannot reimage vmss vm: %v", err)
+	}
+
+	err = future.WaitForCompletionRef(ctx, vmssVMsClient.Client)
+	if err != nil {
+		return fmt.Errorf("cannot get the vmss vm reimage future response: %v", err)
+	}
+
+	_, err = future.Result(vmssVMsClient)
+	return err
+}
+
+// RunCommandVMSSVM runs a command on a single instance of a scale set and waits for its result
+func (c *CloudConfiguration) RunCommandVMSSVM(ctx context.Context, vmssName, instanceID string, input compute.RunCommandInput) (compute.RunCommandResult, error) {
+	vmssVMsClient, err := c.GetVMSSVMsClient()
+	if err != nil {
+		return compute.RunCommandResult{}, err
+	}
+
+	future, err := vmssVMsClient.RunCommand(ctx, c.GroupName, vmssName, instanceID, input)
+	if err != nil {
+		return compute.RunCommandResult{}, fmt.Errorf("cannot run command on vmss vm: %v", err)
+	}
+
+	err = future.WaitForCompletionRef(ctx, vmssVMsClient.Client)
+	if err != nil {
+		return compute.RunCommandResult{}, fmt.Errorf("cannot get the vmss vm run command future response: %v", err)
+	}
+
+	return future.Result(vmssVMsClient)
+}
@@ -5,32 +5,49 @@ import (
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-02-01/network"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/to"
 )
 
 func (c *CloudConfiguration) GetVNETPeeringsClient() (network.VirtualNetworkPeeringsClient, error) {
-	peeringsClient := network.NewVirtualNetworkPeeringsClient(c.SubscriptionID)
-	auth, err := c.getAuthorizerForResource()
+	client, err := c.getClient("GetVNETPeeringsClient", func(a autorest.Authorizer) interface{} {
+		peeringsClient := network.NewVirtualNetworkPeeringsClientWithBaseURI(c.baseURI(), c.SubscriptionID)
+		peeringsClient.Authorizer = a
+		peeringsClient.AddToUserAgent(c.UserAgent)
+		return peeringsClient
+	})
 	if err != nil {
-		return peeringsClient, err
+		return network.VirtualNetworkPeeringsClient{}, err
 	}
-	peeringsClient.Authorizer = auth
-	peeringsClient.AddToUserAgent(c.UserAgent)
-	return peeringsClient, nil
+	return client.(network.VirtualNetworkPeeringsClient), nil
 }
 
 func (c *CloudConfiguration) GetVNETClient() (network.VirtualNetworksClient, error) {
-	vnetClient := network.NewVirtualNetworksClient(c.SubscriptionID)
-	a, err := c.getAuthorizerForResource()
+	client, err := c.getClient("GetVNETClient", func(a autorest.Authorizer) interface{} {
+		vnetClient := network.NewVirtualNetworksClientWithBaseURI(c.baseURI(), c.SubscriptionID)
+		vnetClient.Authorizer = a
+		vnetClient.AddToUserAgent(c.UserAgent)
+		return vnetClient
+	})
 	if err != nil {
-		return vnetClient, err
+		return network.VirtualNetworksClient{}, err
 	}
-	vnetClient.Authorizer = a
-	vnetClient.AddToUserAgent(c.UserAgent)
-	return vnetClient, nil
+	return client.(network.VirtualNetworksClient), nil
 }
 
-func (c *CloudConfiguration) CreateVirtualNetworkAndSubnets(ctx context.Context, vnetName string) error {
+// GetVirtualNetwork gets info on a virtual network
+func (c *CloudConfiguration) GetVirtualNetwork(ctx context.Context, vnetName string) (network.VirtualNetwork, error) {
+	vnetClient, err := c.GetVNETClient()
+	if err != nil {
+		return network.VirtualNetwork{}, err
+	}
+	return vnetClient.Get(ctx, c.GroupName, vnetName, "")
+}
+
+// CreateVirtualNetworkAndSubnets creates the vnet with the master and agent subnets, and the security
+// groups and route table the subnets are associated with. Both subnets egress through the nat
+// gateway if one is given.
+func (c *CloudConfiguration) CreateVirtualNetworkAndSubnets(ctx context.Context, vnetName, vnetCIDR, masterSubnetCIDR, agentSubnetCIDR, natGatewayID string) error {
 	vnetClient, err := c.GetVNETClient()
 	if err != nil {
 		return err
@@ -41,7 +58,9 @@ func (c *CloudConfiguration) CreateVirtualNetworkAndSubnets(ctx context.Context,
 		return err
 	}
 
-	masterNetworkSecurityGroup, err := c.CreateNetworkSecurityGroup(context.TODO(), "azk-master-nsg")
+	// the rules of the master security group are reconciled by the cluster controller, existing
+	// rules are kept so recreating the vnet does not open the masters again
+	masterNetworkSecurityGroup, err := c.CreateDefaultNetworkSecurityGroup(context.TODO(), "azk-master-nsg")
 	if err != nil {
 		return err
 	}
@@ -51,6 +70,11 @@ func (c *CloudConfiguration) CreateVirtualNetworkAndSubnets(ctx context.Context,
 		return err
 	}
 
+	var natGateway *network.SubResource
+	if natGatewayID != "" {
+		natGateway = &network.SubResource{ID: to.StringPtr(natGatewayID)}
+	}
+
 	future, err := vnetClient.CreateOrUpdate(
 		ctx,
 		c.GroupName,
@@ -59,22 +83,24 @@ func (c *CloudConfiguration) CreateVirtualNetworkAndSubnets(ctx context.Context,
 			Location: to.StringPtr(c.GroupLocation),
 			VirtualNetworkPropertiesFormat: &network.VirtualNetworkPropertiesFormat{
 				AddressSpace: &network.AddressSpace{
-					AddressPrefixes: &[]string{"10.0.0.0/8"},
+					AddressPrefixes: &[]string{vnetCIDR},
 				},
 				Subnets: &[]network.Subnet{
 					{
 						Name: to.StringPtr("master-subnet"),
 						SubnetPropertiesFormat: &network.SubnetPropertiesFormat{
-							AddressPrefix:        to.StringPtr("10.0.0.0/16"),
+							AddressPrefix:        to.StringPtr(masterSubnetCIDR),
 							NetworkSecurityGroup: &masterNetworkSecurityGroup,
+							NatGateway:           natGateway,
 						},
 					},
 					{
 						Name: to.StringPtr("agent-subnet"),
 						SubnetPropertiesFormat: &network.SubnetPropertiesFormat{
-							AddressPrefix:        to.StringPtr("10.1.0.0/16"),
+							AddressPrefix:        to.StringPtr(agentSubnetCIDR),
 							NetworkSecurityGroup: &networkSecurityGroup,
 							RouteTable:           &routeTable,
+							NatGateway:           natGateway,
 						},
 					},
 				},
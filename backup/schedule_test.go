@@ -0,0 +1,37 @@
+package backup
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScheduleNext(t *testing.T) {
+	from := time.Date(2019, time.October, 14, 10, 30, 15, 0, time.UTC) // monday
+	tests := []struct {
+		spec string
+		next time.Time
+	}{
+		{"*/15 * * * *", time.Date(2019, time.October, 14, 10, 45, 0, 0, time.UTC)},
+		{"30 * * * *", time.Date(2019, time.October, 14, 11, 30, 0, 0, time.UTC)},
+		{"@daily", time.Date(2019, time.October, 15, 0, 0, 0, 0, time.UTC)},
+		{"0 2 * * 6,7", time.Date(2019, time.October, 19, 2, 0, 0, 0, time.UTC)},
+		{"0 0 1 * 1", time.Date(2019, time.October, 21, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1-3 2 *", time.Date(2020, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 30 2 *", time.Time{}},
+	}
+	for _, test := range tests {
+		schedule, err := ParseSchedule(test.spec)
+		if err != nil {
+			t.Fatalf("Failed to parse %q: %v", test.spec, err)
+		}
+		if next := schedule.Next(from); !next.Equal(test.next) {
+			t.Errorf("Expected %q to run at %v, got %v", test.spec, test.next, next)
+		}
+	}
+
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* * 0 * *", "*/0 * * * *", "5-1 * * * *", "@often"} {
+		if _, err := ParseSchedule(spec); err == nil {
+			t.Errorf("Expected %q to be invalid", spec)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Store keeps etcd snapshots by name
+type Store interface {
+	// Put stores size bytes read from r under name and returns the location of the snapshot
+	Put(ctx context.Context, name string, r io.Reader, size int64) (string, error)
+	// Delete removes the snapshot, deleting a missing snapshot is not an error
+	Delete(ctx context.Context, name string) error
+}
+
+// LocalStore keeps snapshots as files of a local directory
+type LocalStore struct {
+	Dir string
+}
+
+var _ Store = &LocalStore{}
+
+// Put writes the snapshot to a temporary file first, so a partial snapshot is never left under name
+func (s *LocalStore) Put(ctx context.Context, name string, r io.Reader, size int64) (string, error) {
+	if err := os.MkdirAll(s.Dir, 0700); err != nil {
+		return "", err
+	}
+	f, err := ioutil.TempFile(s.Dir, "."+name)
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+	path := filepath.Join(s.Dir, name)
+	if err := os.Rename(f.Name(), path); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+func (s *LocalStore) Delete(ctx context.Context, name string) error {
+	if err := os.Remove(filepath.Join(s.Dir, name)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// blobAPIVersion accepts single put blob requests of up to 5000 MiB
+const blobAPIVersion = "2019-02-02"
+
+// BlobStore keeps snapshots as block blobs of an azure storage container, authorizing requests
+// with a shared access signature of the container
+type BlobStore struct {
+	ContainerURL string
+	SASToken     string
+	httpClient   *http.Client
+}
+
+var _ Store = &BlobStore{}
+
+// NewBlobStore returns a BlobStore for the container, the token needs read, write and delete permissions
+func NewBlobStore(containerURL, sasToken string) *BlobStore {
+	return &BlobStore{
+		ContainerURL: strings.TrimSuffix(containerURL, "/"),
+		SASToken:     strings.TrimPrefix(sasToken, "?"),
+		httpClient:   &http.Client{},
+	}
+}
+
+func (s *BlobStore) blobURL(name string) string {
+	return s.ContainerURL + "/" + name
+}
+
+func (s *BlobStore) Put(ctx context.Context, name string, r io.Reader, size int64) (string, error) {
+	req, err := http.NewRequest(http.MethodPut, s.blobURL(name)+"?"+s.SASToken, r)
+	if err != nil {
+		return "", err
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Length", strconv.FormatInt(size, 10))
+	req.Header.Set("x-ms-blob-type", "BlockBlob")
+	req.Header.Set("x-ms-version", blobAPIVersion)
+
+	if err := s.do(ctx, req, http.StatusCreated); err != nil {
+		return "", fmt.Errorf("cannot upload snapshot %s: %v", name, err)
+	}
+	return s.blobURL(name), nil
+}
+
+func (s *BlobStore) Delete(ctx context.Context, name string) error {
+	req, err := http.NewRequest(http.MethodDelete, s.blobURL(name)+"?"+s.SASToken, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("x-ms-version", blobAPIVersion)
+
+	if err := s.do(ctx, req, http.StatusAccepted, http.StatusNotFound); err != nil {
+		return fmt.Errorf("cannot delete snapshot %s: %v", name, err)
+	}
+	return nil
+}
+
+func (s *BlobStore) do(ctx context.Context, req *http.Request, expected ...int) error {
+	resp, err := s.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	for _, code := range expected {
+		if resp.StatusCode == code {
+			return nil
+		}
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	return fmt.Errorf("%s returned %s: %s", req.URL.Path, resp.Status, strings.TrimSpace(string(body)))
+}
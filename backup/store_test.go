@@ -0,0 +1,86 @@
+package backup
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLocalStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "azk-backup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ctx := context.Background()
+	store := &LocalStore{Dir: filepath.Join(dir, "snapshots")}
+	location, err := store.Put(ctx, "snapshot.db", strings.NewReader("snapshot"), 8)
+	if err != nil {
+		t.Fatalf("Failed to put snapshot: %v", err)
+	}
+	if b, err := ioutil.ReadFile(location); err != nil || string(b) != "snapshot" {
+		t.Fatalf("Unexpected snapshot %q at %s: %v", b, location, err)
+	}
+	if err := store.Delete(ctx, "snapshot.db"); err != nil {
+		t.Fatalf("Failed to delete snapshot: %v", err)
+	}
+	if err := store.Delete(ctx, "snapshot.db"); err != nil {
+		t.Fatalf("Expected deleting a missing snapshot to succeed: %v", err)
+	}
+	if files, _ := ioutil.ReadDir(store.Dir); len(files) != 0 {
+		t.Fatalf("Expected no files left, got %d", len(files))
+	}
+}
+
+func TestBlobStore(t *testing.T) {
+	blobs := map[string]string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "sv=2019-02-02&sig=secret" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch r.Method {
+		case http.MethodPut:
+			if r.Header.Get("x-ms-blob-type") != "BlockBlob" || r.ContentLength != 8 {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			b, _ := ioutil.ReadAll(r.Body)
+			blobs[r.URL.Path] = string(b)
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodDelete:
+			if _, ok := blobs[r.URL.Path]; !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			delete(blobs, r.URL.Path)
+			w.WriteHeader(http.StatusAccepted)
+		}
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	store := NewBlobStore(ts.URL+"/backups/", "?sv=2019-02-02&sig=secret")
+	location, err := store.Put(ctx, "snapshot.db", strings.NewReader("snapshot"), 8)
+	if err != nil {
+		t.Fatalf("Failed to put snapshot: %v", err)
+	}
+	if location != ts.URL+"/backups/snapshot.db" || blobs["/backups/snapshot.db"] != "snapshot" {
+		t.Fatalf("Unexpected snapshot at %s, blobs %v", location, blobs)
+	}
+	if err := store.Delete(ctx, "snapshot.db"); err != nil || len(blobs) != 0 {
+		t.Fatalf("Failed to delete snapshot: %v", err)
+	}
+	if err := store.Delete(ctx, "snapshot.db"); err != nil {
+		t.Fatalf("Expected deleting a missing snapshot to succeed: %v", err)
+	}
+	if _, err := NewBlobStore(ts.URL+"/backups", "sig=wrong").Put(ctx, "snapshot.db", strings.NewReader("snapshot"), 8); err == nil {
+		t.Fatalf("Expected unauthorized upload to fail")
+	}
+}
@@ -1,18 +1,19 @@
 package bootstrap
 
 import (
+	azhelpers "github.com/awesomenix/azk/azure"
 	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
 )
 
 var log = logf.Log.WithName("bootstrap")
 
-func (spec *Spec) Bootstrap() error {
-	if err := spec.CreateBaseInfrastructure(); err != nil {
+func (spec *Spec) Bootstrap(provider azhelpers.Provider) error {
+	if err := spec.CreateBaseInfrastructure(provider); err != nil {
 		log.Error(err, "Error creating base bootstrap infrastructure")
 		return err
 	}
 
-	if err := spec.CreateInfrastructure(); err != nil {
+	if err := spec.CreateInfrastructure(provider); err != nil {
 		log.Error(err, "Error creating bootstrap infrastructure")
 		return err
 	}
@@ -0,0 +1,146 @@
+package bootstrap
+
+import (
+	"crypto/x509"
+	"fmt"
+	"strings"
+
+	"k8s.io/client-go/tools/clientcmd"
+	certutil "k8s.io/client-go/util/cert"
+	"k8s.io/client-go/util/keyutil"
+	"k8s.io/kubernetes/cmd/kubeadm/app/util/pkiutil"
+	"k8s.io/kubernetes/cmd/kubeadm/app/util/pubkeypin"
+)
+
+// NewCertificateAuthority creates a cluster CA like kubeadm does, returning the PEM encoded certificate and key
+func NewCertificateAuthority() (string, string, error) {
+	caCert, caKey, err := pkiutil.NewCertificateAuthority(&certutil.Config{CommonName: "kubernetes"})
+	if err != nil {
+		return "", "", fmt.Errorf("cannot create certificate authority: %v", err)
+	}
+	keyPEM, err := keyutil.MarshalPrivateKeyToPEM(caKey)
+	if err != nil {
+		return "", "", fmt.Errorf("cannot encode certificate authority key: %v", err)
+	}
+	return string(pkiutil.EncodeCertPEM(caCert)), string(keyPEM), nil
+}
+
+// IntroduceCA creates the CA the cluster rotates to, unless a rotation is already in progress
+func (spec *Spec) IntroduceCA() error {
+	if spec.NextCACertificate != "" || spec.PreviousCACertificate != "" {
+		return nil
+	}
+	caCert, caKey, err := NewCertificateAuthority()
+	if err != nil {
+		return err
+	}
+	spec.NextCACertificate = caCert
+	spec.NextCACertificateKey = caKey
+	return nil
+}
+
+// ActivateNextCA makes the next CA the cluster CA, the replaced CA stays trusted until it is retired
+func (spec *Spec) ActivateNextCA() {
+	if spec.NextCACertificate == "" {
+		return
+	}
+	spec.PreviousCACertificate = spec.CACertificate
+	spec.CACertificate = spec.NextCACertificate
+	spec.CACertificateKey = spec.NextCACertificateKey
+	spec.NextCACertificate = ""
+	spec.NextCACertificateKey = ""
+}
+
+// RetirePreviousCA stops trusting the CA replaced by ActivateNextCA
+func (spec *Spec) RetirePreviousCA() {
+	spec.PreviousCACertificate = ""
+}
+
+// CheckCARotationScope returns an error if a CA other than the cluster CA differs from the spec
+// before the rotation. Only the cluster CA is rotated, the front proxy and etcd CAs sign the
+// aggregation layer and etcd peer certificates, which a rotation does not reissue.
+func (spec *Spec) CheckCARotationScope(previous *Spec) error {
+	switch {
+	case spec.FrontProxyCACertificate != previous.FrontProxyCACertificate || spec.FrontProxyCACertificateKey != previous.FrontProxyCACertificateKey:
+		return fmt.Errorf("CA rotation cannot change the front proxy CA, only the cluster CA is rotated")
+	case spec.EtcdCACertificate != previous.EtcdCACertificate || spec.EtcdCACertificateKey != previous.EtcdCACertificateKey:
+		return fmt.Errorf("CA rotation cannot change the etcd CA, only the cluster CA is rotated")
+	}
+	return nil
+}
+
+// CATrustBundle returns the CAs the cluster trusts, the cluster CA first followed by the CA being
+// introduced or retired during a rotation
+func (spec *Spec) CATrustBundle() string {
+	var bundle []string
+	for _, ca := range []string{spec.CACertificate, spec.NextCACertificate, spec.PreviousCACertificate} {
+		if ca = strings.TrimSpace(ca); ca != "" {
+			bundle = append(bundle, ca+"\n")
+		}
+	}
+	return strings.Join(bundle, "")
+}
+
+// CAHashes returns the discovery hashes of every CA in the trust bundle
+func (spec *Spec) CAHashes() ([]string, error) {
+	caCerts, err := certutil.ParseCertsPEM([]byte(spec.CATrustBundle()))
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse CA trust bundle: %v", err)
+	}
+	hashes := make([]string, 0, len(caCerts))
+	for _, caCert := range caCerts {
+		hashes = append(hashes, pubkeypin.Hash(caCert))
+	}
+	return hashes, nil
+}
+
+// SetKubeConfigCA replaces the certificate authority data of every cluster in the kubeconfig
+func SetKubeConfigCA(kubeconfig, caBundle string) (string, error) {
+	config, err := clientcmd.Load([]byte(kubeconfig))
+	if err != nil {
+		return "", fmt.Errorf("cannot load kubeconfig: %v", err)
+	}
+	for _, cluster := range config.Clusters {
+		cluster.CertificateAuthority = ""
+		cluster.CertificateAuthorityData = []byte(caBundle)
+	}
+	buf, err := clientcmd.Write(*config)
+	if err != nil {
+		return "", fmt.Errorf("cannot write kubeconfig: %v", err)
+	}
+	return string(buf), nil
+}
+
+// KubeConfigSignedBy returns true if the client certificate of the current context of the
+// kubeconfig is valid and signed by the CA
+func KubeConfigSignedBy(kubeconfig, caCert string) (bool, error) {
+	if kubeconfig == "" {
+		return false, nil
+	}
+	config, err := clientcmd.Load([]byte(kubeconfig))
+	if err != nil {
+		return false, fmt.Errorf("cannot load kubeconfig: %v", err)
+	}
+	context, ok := config.Contexts[config.CurrentContext]
+	if !ok {
+		return false, nil
+	}
+	authInfo, ok := config.AuthInfos[context.AuthInfo]
+	if !ok || len(authInfo.ClientCertificateData) == 0 {
+		return false, nil
+	}
+	clientCerts, err := certutil.ParseCertsPEM(authInfo.ClientCertificateData)
+	if err != nil {
+		return false, fmt.Errorf("cannot parse kubeconfig client certificate: %v", err)
+	}
+	caCerts, err := certutil.ParseCertsPEM([]byte(caCert))
+	if err != nil {
+		return false, fmt.Errorf("cannot parse CA certificate: %v", err)
+	}
+	roots := x509.NewCertPool()
+	for _, ca := range caCerts {
+		roots.AddCert(ca)
+	}
+	_, err = clientCerts[0].Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}})
+	return err == nil, nil
+}
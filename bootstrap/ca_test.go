@@ -0,0 +1,108 @@
+package bootstrap
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	certutil "k8s.io/client-go/util/cert"
+	"k8s.io/kubernetes/cmd/kubeadm/app/util/pkiutil"
+)
+
+func TestCARotationScope(t *testing.T) {
+	caCert, caKey, err := NewCertificateAuthority()
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := &Spec{
+		CACertificate:              caCert,
+		CACertificateKey:           caKey,
+		FrontProxyCACertificate:    "front-proxy-ca",
+		FrontProxyCACertificateKey: "front-proxy-ca-key",
+		EtcdCACertificate:          "etcd-ca",
+		EtcdCACertificateKey:       "etcd-ca-key",
+	}
+	previous := *spec
+
+	if err := spec.IntroduceCA(); err != nil {
+		t.Fatal(err)
+	}
+	spec.ActivateNextCA()
+	spec.RetirePreviousCA()
+	if spec.CACertificate == caCert {
+		t.Fatalf("Expected the cluster CA to be rotated")
+	}
+	if err := spec.CheckCARotationScope(&previous); err != nil {
+		t.Errorf("Expected rotating the cluster CA to be allowed, got %v", err)
+	}
+
+	frontProxy := *spec
+	frontProxy.FrontProxyCACertificateKey = "rotated"
+	if err := frontProxy.CheckCARotationScope(&previous); err == nil {
+		t.Errorf("Expected rotating the front proxy CA to be refused")
+	}
+	etcd := *spec
+	etcd.EtcdCACertificate = "rotated"
+	if err := etcd.CheckCARotationScope(&previous); err == nil {
+		t.Errorf("Expected rotating the etcd CA to be refused")
+	}
+}
+
+func TestKubeConfigSignedBy(t *testing.T) {
+	caCert, caKey, err := pkiutil.NewCertificateAuthority(&certutil.Config{CommonName: "kubernetes"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherCACert, _, err := NewCertificateAuthority()
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientCert, _, err := pkiutil.NewCertAndKey(caCert, caKey, &certutil.Config{
+		CommonName:   "kubernetes-admin",
+		Organization: []string{"system:masters"},
+		Usages:       []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	kubeconfig := fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: azk
+  cluster:
+    server: https://azk.westus2.cloudapp.azure.com:6443
+contexts:
+- name: admin@azk
+  context:
+    cluster: azk
+    user: admin
+current-context: admin@azk
+users:
+- name: admin
+  user:
+    client-certificate-data: %s
+`, base64.StdEncoding.EncodeToString(pkiutil.EncodeCertPEM(clientCert)))
+
+	tests := []struct {
+		name       string
+		kubeconfig string
+		caCert     string
+		want       bool
+	}{
+		{name: "signed by the CA", kubeconfig: kubeconfig, caCert: string(pkiutil.EncodeCertPEM(caCert)), want: true},
+		{name: "signed by another CA", kubeconfig: kubeconfig, caCert: otherCACert},
+		{name: "missing kubeconfig", caCert: otherCACert},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signed, err := KubeConfigSignedBy(tt.kubeconfig, tt.caCert)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if signed != tt.want {
+				t.Errorf("KubeConfigSignedBy() = %v, want %v", signed, tt.want)
+			}
+		})
+	}
+}
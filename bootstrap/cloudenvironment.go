@@ -0,0 +1,77 @@
+package bootstrap
+
+import (
+	"fmt"
+
+	kubeadmv1beta2 "k8s.io/kubernetes/cmd/kubeadm/app/apis/kubeadm/v1beta2"
+
+	azhelpers "github.com/awesomenix/azk/azure"
+)
+
+const (
+	// cloudEnvironmentVariable names the environment file of a custom cloud to the azure cloud provider
+	cloudEnvironmentVariable = "AZURE_ENVIRONMENT_FILEPATH"
+	cloudEnvironmentVolume   = "cloud-environment"
+	cloudEnvironmentUnit     = "azk-cloud-environment"
+	cloudEnvironmentScript   = "/etc/kubernetes/azk-cloud-environment.sh"
+	cloudEnvironmentEnable   = "/etc/kubernetes/enable-azk-cloud-environment.sh"
+)
+
+// cloudEnvironmentComponent mounts the environment file of a custom cloud into a control plane
+// component running the azure cloud provider
+func (spec *Spec) cloudEnvironmentComponent(component kubeadmv1beta2.ControlPlaneComponent) kubeadmv1beta2.ControlPlaneComponent {
+	if spec.CloudEnvironment != "" {
+		component.ExtraVolumes = append(component.ExtraVolumes, kubeadmv1beta2.HostPathMount{
+			Name:      cloudEnvironmentVolume,
+			HostPath:  azhelpers.CloudEnvironmentPath,
+			MountPath: azhelpers.CloudEnvironmentPath,
+			ReadOnly:  true,
+		})
+	}
+	return component
+}
+
+// cloudEnvironmentFiles returns the files and scripts cloud-init writes on every master of a custom
+// cloud to pass the environment file to the api server and controller manager. kubeadm configures
+// no environment of control plane components before v1beta4, a systemd path unit adds the variable
+// to the manifests whenever kubeadm writes them on init, join and upgrade.
+func (spec *Spec) cloudEnvironmentFiles() (files map[string]string, scripts map[string]string) {
+	files, scripts = map[string]string{}, map[string]string{}
+	if spec.CloudEnvironment == "" {
+		return files, scripts
+	}
+	files[cloudEnvironmentScript] = fmt.Sprintf(`set -e
+mkdir -p /etc/kubernetes/tmp
+for f in /etc/kubernetes/manifests/kube-apiserver.yaml /etc/kubernetes/manifests/kube-controller-manager.yaml; do
+	if [ ! -f $f ] || grep -q %[1]s $f; then
+		continue
+	fi
+	# the manifest is replaced at once, the kubelet reads every file of the manifest directory
+	sed 's#^    image: #    env:\n    - name: %[1]s\n      value: %[2]s\n    image: #' $f > /etc/kubernetes/tmp/$(basename $f)
+	mv -f /etc/kubernetes/tmp/$(basename $f) $f
+done
+`, cloudEnvironmentVariable, azhelpers.CloudEnvironmentPath)
+	files["/etc/systemd/system/"+cloudEnvironmentUnit+".service"] = fmt.Sprintf(`[Unit]
+Description=Pass the azure environment of a custom cloud to the control plane
+
+[Service]
+Type=oneshot
+ExecStart=/bin/sh %s
+`, cloudEnvironmentScript)
+	files["/etc/systemd/system/"+cloudEnvironmentUnit+".path"] = `[Unit]
+Description=Watch the control plane manifests for the azure environment of a custom cloud
+
+[Path]
+PathChanged=/etc/kubernetes/manifests
+MakeDirectory=yes
+
+[Install]
+WantedBy=multi-user.target
+`
+	scripts[cloudEnvironmentEnable] = fmt.Sprintf(`
+set -eux
+sudo systemctl daemon-reload
+sudo systemctl enable --now %s.path
+`, cloudEnvironmentUnit)
+	return files, scripts
+}
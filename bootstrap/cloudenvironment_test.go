@@ -0,0 +1,99 @@
+package bootstrap
+
+import (
+	"strings"
+	"testing"
+
+	kubeadmv1beta2 "k8s.io/kubernetes/cmd/kubeadm/app/apis/kubeadm/v1beta2"
+	sigsyaml "sigs.k8s.io/yaml"
+
+	azhelpers "github.com/awesomenix/azk/azure"
+	"github.com/awesomenix/azk/cloudinit"
+)
+
+func hasVolume(component kubeadmv1beta2.ControlPlaneComponent, name string) bool {
+	for _, volume := range component.ExtraVolumes {
+		if volume.Name == name && volume.HostPath == azhelpers.CloudEnvironmentPath && volume.ReadOnly {
+			return true
+		}
+	}
+	return false
+}
+
+func writesFile(config *cloudinit.Config, path string) bool {
+	for _, file := range config.WriteFiles {
+		if file.Path == path {
+			return true
+		}
+	}
+	return false
+}
+
+func TestCloudEnvironment(t *testing.T) {
+	for _, custom := range []bool{false, true} {
+		spec, _, _ := newFakeSpec(t)
+		if custom {
+			spec.CloudName = azhelpers.AzureStackCloudName
+			spec.CloudEnvironment = `{"name": "AzureStackCloud"}`
+		}
+
+		script, err := spec.kubeadmInitConfig("1.15.3")
+		if err != nil {
+			t.Fatalf("Failed to get kubeadm init config: %v", err)
+		}
+		clusterConfig := kubeadmv1beta2.ClusterConfiguration{}
+		if err := sigsyaml.UnmarshalStrict([]byte(kubeadmDocuments(t, script)[1]), &clusterConfig); err != nil {
+			t.Fatalf("Failed to unmarshal cluster configuration: %v", err)
+		}
+		if hasVolume(clusterConfig.APIServer.ControlPlaneComponent, cloudEnvironmentVolume) != custom ||
+			hasVolume(clusterConfig.ControllerManager, cloudEnvironmentVolume) != custom {
+			t.Errorf("Expected the cloud environment mounted into the api server and controller manager %v, got %+v", custom, clusterConfig)
+		}
+		if hasVolume(clusterConfig.Scheduler, cloudEnvironmentVolume) {
+			t.Errorf("Expected no cloud environment in the scheduler, got %+v", clusterConfig.Scheduler)
+		}
+
+		config, err := spec.MasterCloudConfig("echo init")
+		if err != nil {
+			t.Fatalf("Failed to get master cloud config: %v", err)
+		}
+		for _, path := range []string{cloudEnvironmentScript, cloudEnvironmentEnable, "/etc/systemd/system/" + cloudEnvironmentUnit + ".path"} {
+			if writesFile(config, path) != custom {
+				t.Errorf("Expected %s to be written on masters %v", path, custom)
+			}
+		}
+		var commands []string
+		for _, command := range config.RunCmd {
+			commands = append(commands, strings.Join(command, " "))
+		}
+		if want := "bash " + cloudEnvironmentEnable + ",bash " + StartupScriptPath; custom && strings.Join(commands, ",") != want {
+			t.Errorf("Expected the cloud environment enabled before the startup script, got %v", commands)
+		}
+
+		config, err = spec.NodeCloudConfig("echo join")
+		if err != nil {
+			t.Fatalf("Failed to get node cloud config: %v", err)
+		}
+		if writesFile(config, cloudEnvironmentScript) || writesFile(config, azhelpers.CloudEnvironmentPath) != custom {
+			t.Errorf("Expected nodes to only get the cloud environment file %v", custom)
+		}
+	}
+}
+
+func TestCloudEnvironmentScript(t *testing.T) {
+	spec, _, _ := newFakeSpec(t)
+	spec.CloudEnvironment = `{"name": "AzureStackCloud"}`
+	files, _ := spec.cloudEnvironmentFiles()
+	script := files[cloudEnvironmentScript]
+	for _, want := range []string{
+		"/etc/kubernetes/manifests/kube-apiserver.yaml /etc/kubernetes/manifests/kube-controller-manager.yaml",
+		`    env:\n    - name: AZURE_ENVIRONMENT_FILEPATH\n      value: ` + azhelpers.CloudEnvironmentPath + `\n    image: `,
+	} {
+		if !strings.Contains(script, want) {
+			t.Errorf("Expected the script to contain %q, got\n%s", want, script)
+		}
+	}
+	if service := files["/etc/systemd/system/"+cloudEnvironmentUnit+".service"]; !strings.Contains(service, "ExecStart=/bin/sh "+cloudEnvironmentScript) {
+		t.Errorf("Expected the service to run the script, got\n%s", service)
+	}
+}
@@ -0,0 +1,75 @@
+package bootstrap
+
+import (
+	azhelpers "github.com/awesomenix/azk/azure"
+	"github.com/awesomenix/azk/cloudinit"
+)
+
+// StartupScriptPath is where cloud-init writes the startup script of masters and nodes before running it
+const StartupScriptPath = "/etc/kubernetes/init-azure-bootstrap.sh"
+
+// MasterCloudConfig writes the cluster CAs, the cloud provider configuration and the startup
+// script of a master, then installs azure-cni if needed, passes the environment of a custom cloud
+// to the control plane and runs the script. The CA and service account keys are only readable by
+// root.
+func (spec *Spec) MasterCloudConfig(startupScript string) (*cloudinit.Config, error) {
+	files := map[string]string{
+		"/etc/kubernetes/pki/ca.crt":             spec.CACertificate,
+		"/etc/kubernetes/pki/sa.pub":             spec.ServiceAccountPub,
+		"/etc/kubernetes/pki/front-proxy-ca.crt": spec.FrontProxyCACertificate,
+		"/etc/kubernetes/pki/etcd/ca.crt":        spec.EtcdCACertificate,
+	}
+	secrets := map[string]string{
+		"/etc/kubernetes/pki/ca.key":             spec.CACertificateKey,
+		"/etc/kubernetes/pki/sa.key":             spec.ServiceAccountKey,
+		"/etc/kubernetes/pki/front-proxy-ca.key": spec.FrontProxyCACertificateKey,
+		"/etc/kubernetes/pki/etcd/ca.key":        spec.EtcdCACertificateKey,
+	}
+	environmentFiles, scripts := spec.cloudEnvironmentFiles()
+	for path, content := range environmentFiles {
+		files[path] = content
+	}
+	return spec.startupCloudConfig(files, secrets, scripts, startupScript)
+}
+
+// NodeCloudConfig writes the cloud provider configuration and the startup script of a node, then
+// installs azure-cni if needed and runs the script
+func (spec *Spec) NodeCloudConfig(startupScript string) (*cloudinit.Config, error) {
+	return spec.startupCloudConfig(map[string]string{}, map[string]string{}, map[string]string{}, startupScript)
+}
+
+// startupCloudConfig writes files readable by everyone and secrets only readable by root, azure.json
+// holds the credentials of the cloud provider. The scripts run before the startup script.
+func (spec *Spec) startupCloudConfig(files, secrets, scripts map[string]string, startupScript string) (*cloudinit.Config, error) {
+	secrets["/etc/kubernetes/azure.json"] = spec.AzureCloudProviderConfig
+	providerFiles, providerSecrets := azhelpers.GetCloudProviderFiles(&spec.CloudConfiguration)
+	for path, content := range providerFiles {
+		files[path] = content
+	}
+	for path, content := range providerSecrets {
+		secrets[path] = content
+	}
+	pluginFiles, pluginScripts, err := spec.networkPluginFiles()
+	if err != nil {
+		return nil, err
+	}
+	for path, content := range pluginFiles {
+		files[path] = content
+	}
+	for path, content := range pluginScripts {
+		scripts[path] = content
+	}
+	var runCmd []cloudinit.Command
+	for _, file := range cloudinit.Files(scripts, "0755") {
+		runCmd = append(runCmd, cloudinit.Command{"bash", file.Path})
+	}
+	scripts[StartupScriptPath] = startupScript
+	return &cloudinit.Config{
+		WriteFiles: append(append(
+			cloudinit.Files(files, "0644"),
+			cloudinit.Files(secrets, "0600")...),
+			cloudinit.Files(scripts, "0755")...,
+		),
+		RunCmd: append(runCmd, cloudinit.Command{"bash", StartupScriptPath}),
+	}, nil
+}
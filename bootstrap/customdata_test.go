@@ -0,0 +1,26 @@
+package bootstrap
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMasterCloudConfigPermissions(t *testing.T) {
+	spec, _, _ := newFakeSpec(t)
+	config, err := spec.MasterCloudConfig("echo init")
+	if err != nil {
+		t.Fatalf("Failed to get master cloud config: %v", err)
+	}
+	for _, file := range config.WriteFiles {
+		expected := "0644"
+		switch {
+		case strings.HasSuffix(file.Path, ".key") || file.Path == "/etc/kubernetes/azure.json":
+			expected = "0600"
+		case file.Path == StartupScriptPath:
+			expected = "0755"
+		}
+		if file.Permissions != expected {
+			t.Errorf("Expected %s to be written with %s, got %s", file.Path, expected, file.Permissions)
+		}
+	}
+}
@@ -0,0 +1,319 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-02-01/network"
+	azhelpers "github.com/awesomenix/azk/azure"
+)
+
+const (
+	azkVNetName       = "azk-vnet"
+	azkNSGName        = "azk-nsg"
+	azkMasterNSGName  = "azk-master-nsg"
+	azkRouteTableName = "azk-routetable"
+	masterSubnetName  = "master-subnet"
+	agentSubnetName   = "agent-subnet"
+)
+
+// ResourceState is the observed state of a base infrastructure resource, Err describes
+// why the resource is missing or does not match what CreateBaseInfrastructure provisions
+type ResourceState struct {
+	Type string
+	Name string
+	Err  error
+}
+
+// CheckBaseInfrastructure compares the azure resources against the base infrastructure
+// created by CreateBaseInfrastructure, errors other than missing resources are returned
+func (spec *Spec) CheckBaseInfrastructure(ctx context.Context, provider azhelpers.Provider) ([]ResourceState, error) {
+	type resourceCheck struct {
+		resourceType string
+		name         string
+		check        func(context.Context, azhelpers.Provider) error
+	}
+	networking := spec.Networking.WithDefaults()
+	checks := []resourceCheck{
+		{"ResourceGroup", spec.GroupName, spec.checkResourceGroup},
+	}
+	if networking.OutboundType == OutboundTypeNATGateway {
+		checks = append(checks, resourceCheck{"NatGateway", azkNATGatewayName, spec.checkNatGateway})
+	}
+	if networking.ExistingVNet() {
+		checks = append(checks, resourceCheck{"VirtualNetwork", spec.VNetID(), spec.checkExistingSubnets})
+	} else {
+		checks = append(checks,
+			resourceCheck{"NetworkSecurityGroup", azkNSGName, spec.checkNetworkSecurityGroup(azkNSGName)},
+			resourceCheck{"NetworkSecurityGroup", azkMasterNSGName, spec.checkNetworkSecurityGroup(azkMasterNSGName)},
+			resourceCheck{"RouteTable", azkRouteTableName, spec.checkRouteTable},
+			resourceCheck{"VirtualNetwork", azkVNetName, spec.checkVirtualNetwork},
+		)
+	}
+	checks = append(checks, []resourceCheck{
+		{"LoadBalancer", azkInternalLoadBalancerName, spec.checkInternalLoadBalancer},
+		{"PrivateDnsZone", spec.InternalDNSName, spec.checkPrivateDNSZone},
+	}...)
+	if !networking.PrivateCluster {
+		checks = append(checks, []resourceCheck{
+			{"PublicIPAddress", spec.publicIPName(), spec.checkPublicIP(spec.publicIPName())},
+			{"LoadBalancer", azkLoadBalancerName, spec.checkLoadBalancer},
+		}...)
+	}
+
+	states := []ResourceState{}
+	for _, c := range checks {
+		err := c.check(ctx, provider)
+		if azhelpers.ResourceNotFound(err) {
+			err = fmt.Errorf("not found")
+		} else if _, drifted := err.(driftError); err != nil && !drifted {
+			return nil, fmt.Errorf("cannot get %s %s: %v", c.resourceType, c.name, err)
+		}
+		states = append(states, ResourceState{Type: c.resourceType, Name: c.name, Err: err})
+	}
+	return states, nil
+}
+
+// driftError reports an existing resource which does not match the expected configuration
+type driftError string
+
+func (e driftError) Error() string {
+	return string(e)
+}
+
+func driftf(format string, args ...interface{}) error {
+	return driftError(fmt.Sprintf(format, args...))
+}
+
+// hasSuffixFold returns true if the resource id references the named resource
+func hasSuffixFold(id *string, name string) bool {
+	return id != nil && strings.HasSuffix(strings.ToLower(*id), "/"+strings.ToLower(name))
+}
+
+func (spec *Spec) checkResourceGroup(ctx context.Context, provider azhelpers.Provider) error {
+	group, err := provider.GetResourceGroup(ctx)
+	if err != nil {
+		return err
+	}
+	if group.Location != nil && !strings.EqualFold(strings.Replace(*group.Location, " ", "", -1), strings.Replace(spec.GroupLocation, " ", "", -1)) {
+		return driftf("location %s, expected %s", *group.Location, spec.GroupLocation)
+	}
+	return nil
+}
+
+func (spec *Spec) checkRouteTable(ctx context.Context, provider azhelpers.Provider) error {
+	_, err := provider.GetRouteTable(ctx, azkRouteTableName)
+	return err
+}
+
+func (spec *Spec) checkVirtualNetwork(ctx context.Context, provider azhelpers.Provider) error {
+	vnet, err := provider.GetVirtualNetwork(ctx, azkVNetName)
+	if err != nil {
+		return err
+	}
+	if vnet.VirtualNetworkPropertiesFormat == nil {
+		return driftf("missing properties")
+	}
+
+	networking := spec.Networking.WithDefaults()
+	found := false
+	if vnet.AddressSpace != nil && vnet.AddressSpace.AddressPrefixes != nil {
+		for _, prefix := range *vnet.AddressSpace.AddressPrefixes {
+			if prefix == networking.VNetCIDR {
+				found = true
+			}
+		}
+	}
+	if !found {
+		return driftf("address space %s missing", networking.VNetCIDR)
+	}
+
+	natGateway := ""
+	if networking.OutboundType == OutboundTypeNATGateway {
+		natGateway = azkNATGatewayName
+	}
+	expected := []struct {
+		name          string
+		addressPrefix string
+		nsgName       string
+		routeTable    string
+	}{
+		{masterSubnetName, networking.MasterSubnetCIDR, azkMasterNSGName, ""},
+		{agentSubnetName, networking.AgentSubnetCIDR, azkNSGName, azkRouteTableName},
+	}
+	for _, e := range expected {
+		var subnet *network.Subnet
+		if vnet.Subnets != nil {
+			for i := range *vnet.Subnets {
+				if s := &(*vnet.Subnets)[i]; s.Name != nil && *s.Name == e.name {
+					subnet = s
+				}
+			}
+		}
+		switch {
+		case subnet == nil || subnet.SubnetPropertiesFormat == nil:
+			return driftf("subnet %s missing", e.name)
+		case subnet.AddressPrefix == nil || *subnet.AddressPrefix != e.addressPrefix:
+			return driftf("subnet %s address prefix is not %s", e.name, e.addressPrefix)
+		case subnet.NetworkSecurityGroup == nil || !hasSuffixFold(subnet.NetworkSecurityGroup.ID, e.nsgName):
+			return driftf("subnet %s is not associated with %s", e.name, e.nsgName)
+		case e.routeTable != "" && (subnet.RouteTable == nil || !hasSuffixFold(subnet.RouteTable.ID, e.routeTable)):
+			return driftf("subnet %s is not associated with %s", e.name, e.routeTable)
+		case natGateway != "" && (subnet.NatGateway == nil || !hasSuffixFold(subnet.NatGateway.ID, natGateway)):
+			return driftf("subnet %s is not associated with %s", e.name, natGateway)
+		}
+	}
+	return nil
+}
+
+// checkLoadBalancerPools verifies the load balancer has the named frontend and backend pool
+func checkLoadBalancerPools(lb network.LoadBalancer, frontEndName, backEndPoolName string) (*network.FrontendIPConfiguration, error) {
+	if lb.LoadBalancerPropertiesFormat == nil {
+		return nil, driftf("missing properties")
+	}
+
+	var frontEnd *network.FrontendIPConfiguration
+	if lb.FrontendIPConfigurations != nil {
+		for i := range *lb.FrontendIPConfigurations {
+			if f := &(*lb.FrontendIPConfigurations)[i]; f.Name != nil && *f.Name == frontEndName {
+				frontEnd = f
+			}
+		}
+	}
+	if frontEnd == nil || frontEnd.FrontendIPConfigurationPropertiesFormat == nil {
+		return nil, driftf("frontend %s missing", frontEndName)
+	}
+
+	found := false
+	if lb.BackendAddressPools != nil {
+		for _, pool := range *lb.BackendAddressPools {
+			if pool.Name != nil && *pool.Name == backEndPoolName {
+				found = true
+			}
+		}
+	}
+	if !found {
+		return nil, driftf("backend pool %s missing", backEndPoolName)
+	}
+	return frontEnd, nil
+}
+
+func (spec *Spec) checkInternalLoadBalancer(ctx context.Context, provider azhelpers.Provider) error {
+	lb, err := provider.GetLoadBalancer(ctx, azkInternalLoadBalancerName)
+	if err != nil {
+		return err
+	}
+	frontEnd, err := checkLoadBalancerPools(lb, "master-internal-lbFrontEnd", "master-internal-backEndPool")
+	if err != nil {
+		return err
+	}
+	internalIP := spec.Networking.WithDefaults().InternalLoadBalancerIP
+	if frontEnd.PrivateIPAddress == nil || *frontEnd.PrivateIPAddress != internalIP {
+		return driftf("frontend private ip is not %s", internalIP)
+	}
+	if frontEnd.Subnet == nil || frontEnd.Subnet.ID == nil || !strings.EqualFold(*frontEnd.Subnet.ID, spec.MasterSubnetID()) {
+		return driftf("frontend is not in %s", spec.MasterSubnetID())
+	}
+	return nil
+}
+
+func (spec *Spec) checkPrivateDNSZone(ctx context.Context, provider azhelpers.Provider) error {
+	if _, err := provider.GetPrivateDNSZone(ctx, spec.InternalDNSName); err != nil {
+		return err
+	}
+	link, err := provider.GetPrivateDNSZoneLink(ctx, spec.InternalDNSName, spec.vnetName())
+	if azhelpers.ResourceNotFound(err) {
+		return driftf("not linked to %s", spec.vnetName())
+	} else if err != nil {
+		return err
+	}
+	if link.VirtualNetworkLinkProperties == nil || link.VirtualNetwork == nil || link.VirtualNetwork.ID == nil ||
+		!strings.EqualFold(*link.VirtualNetwork.ID, spec.VNetID()) {
+		return driftf("not linked to %s", spec.vnetName())
+	}
+
+	internalIP, err := spec.internalLoadBalancerIP(ctx, provider)
+	if err != nil {
+		return driftf("cannot determine internal load balancer address: %v", err)
+	}
+	record, err := provider.GetPrivateDNSARecord(ctx, spec.InternalDNSName, privateDNSApexRecord)
+	if azhelpers.ResourceNotFound(err) {
+		return driftf("A record missing")
+	} else if err != nil {
+		return err
+	}
+	if record.RecordSetProperties == nil || record.ARecords == nil || len(*record.ARecords) != 1 ||
+		(*record.ARecords)[0].Ipv4Address == nil || *(*record.ARecords)[0].Ipv4Address != internalIP {
+		return driftf("A record does not resolve to %s", internalIP)
+	}
+	return nil
+}
+
+func (spec *Spec) checkPublicIP(pipName string) func(context.Context, azhelpers.Provider) error {
+	return func(ctx context.Context, provider azhelpers.Provider) error {
+		pip, err := provider.GetPublicIP(ctx, pipName)
+		if err != nil {
+			return err
+		}
+		if pip.PublicIPAddressPropertiesFormat == nil || pip.IPAddress == nil {
+			return driftf("no address allocated")
+		}
+		return nil
+	}
+}
+
+func (spec *Spec) checkNatGateway(ctx context.Context, provider azhelpers.Provider) error {
+	natGateway, err := provider.GetNatGateway(ctx, azkNATGatewayName)
+	if err != nil {
+		return err
+	}
+	if natGateway.NatGatewayPropertiesFormat == nil || natGateway.PublicIPAddresses == nil || len(*natGateway.PublicIPAddresses) == 0 ||
+		!hasSuffixFold((*natGateway.PublicIPAddresses)[0].ID, spec.egressPublicIPName()) {
+		return driftf("not bound to %s", spec.egressPublicIPName())
+	}
+	err = spec.checkPublicIP(spec.egressPublicIPName())(ctx, provider)
+	if azhelpers.ResourceNotFound(err) {
+		return driftf("public ip %s missing", spec.egressPublicIPName())
+	}
+	return err
+}
+
+func (spec *Spec) checkLoadBalancer(ctx context.Context, provider azhelpers.Provider) error {
+	lb, err := provider.GetLoadBalancer(ctx, azkLoadBalancerName)
+	if err != nil {
+		return err
+	}
+	frontEnd, err := checkLoadBalancerPools(lb, "master-lbFrontEnd", "master-backEndPool")
+	if err != nil {
+		return err
+	}
+	if frontEnd.PublicIPAddress == nil || !hasSuffixFold(frontEnd.PublicIPAddress.ID, spec.publicIPName()) {
+		return driftf("frontend is not bound to %s", spec.publicIPName())
+	}
+
+	found := false
+	if lb.LoadBalancingRules != nil {
+		for _, rule := range *lb.LoadBalancingRules {
+			if rule.Name != nil && *rule.Name == "LBRuleHTTPS" {
+				found = true
+			}
+		}
+	}
+	if !found {
+		return driftf("load balancing rule LBRuleHTTPS missing")
+	}
+
+	found = false
+	if lb.InboundNatPools != nil {
+		for _, pool := range *lb.InboundNatPools {
+			if pool.Name != nil && *pool.Name == "natSSHPool" {
+				found = true
+			}
+		}
+	}
+	if !found {
+		return driftf("inbound nat pool natSSHPool missing")
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	azhelpers "github.com/awesomenix/azk/azure"
+	"github.com/awesomenix/azk/azure/fake"
+)
+
+func newFakeSpec(t *testing.T) (*Spec, *fake.Cloud, azhelpers.Provider) {
+	spec := &Spec{
+		CloudConfiguration: azhelpers.CloudConfiguration{
+			CloudName:      azhelpers.AzurePublicCloudName,
+			SubscriptionID: "subscription",
+			GroupName:      "group",
+			GroupLocation:  "westus2",
+		},
+		ClusterName:     "cluster",
+		DNSPrefix:       "azk",
+		InternalDNSName: "azk0123abcd.internal",
+	}
+	cloud := fake.NewCloud()
+	provider, err := cloud.Provider(&spec.CloudConfiguration)
+	if err != nil {
+		t.Fatalf("Failed to create fake provider: %v", err)
+	}
+	return spec, cloud, provider
+}
+
+func notReady(states []ResourceState) []string {
+	var names []string
+	for _, state := range states {
+		if state.Err != nil {
+			names = append(names, state.Name)
+		}
+	}
+	return names
+}
+
+func TestCheckBaseInfrastructure(t *testing.T) {
+	ctx := context.Background()
+	spec, _, provider := newFakeSpec(t)
+
+	states, err := spec.CheckBaseInfrastructure(ctx, provider)
+	if err != nil {
+		t.Fatalf("Failed to check base infrastructure: %v", err)
+	}
+	if len(notReady(states)) != len(states) {
+		t.Fatalf("Expected all resources missing before bootstrap, got %v", notReady(states))
+	}
+
+	if err := spec.CreateBaseInfrastructure(provider); err != nil {
+		t.Fatalf("Failed to create base infrastructure: %v", err)
+	}
+	if spec.PublicIPAdress == "" {
+		t.Fatalf("Expected public ip address to be set")
+	}
+
+	states, err = spec.CheckBaseInfrastructure(ctx, provider)
+	if err != nil {
+		t.Fatalf("Failed to check base infrastructure: %v", err)
+	}
+	if names := notReady(states); len(names) > 0 {
+		t.Fatalf("Expected all resources ready, got drift in %v", names)
+	}
+
+	if err := provider.DeleteNetworkSecurityGroup(ctx, azkMasterNSGName); err != nil {
+		t.Fatalf("Failed to delete master nsg: %v", err)
+	}
+	states, err = spec.CheckBaseInfrastructure(ctx, provider)
+	if err != nil {
+		t.Fatalf("Failed to check base infrastructure: %v", err)
+	}
+	if names := notReady(states); len(names) != 1 || names[0] != azkMasterNSGName {
+		t.Fatalf("Expected only %s to drift, got %v", azkMasterNSGName, names)
+	}
+
+	if err := provider.CreatePrivateDNSARecord(ctx, spec.InternalDNSName, privateDNSApexRecord, "10.0.0.4"); err != nil {
+		t.Fatalf("Failed to replace private dns record: %v", err)
+	}
+	states, err = spec.CheckBaseInfrastructure(ctx, provider)
+	if err != nil {
+		t.Fatalf("Failed to check base infrastructure: %v", err)
+	}
+	if names := notReady(states); len(names) != 2 || names[1] != spec.InternalDNSName {
+		t.Fatalf("Expected %s to drift once its record is stale, got %v", spec.InternalDNSName, names)
+	}
+
+	address := spec.PublicIPAdress
+	if err := spec.CreateBaseInfrastructure(provider); err != nil {
+		t.Fatalf("Failed to repair base infrastructure: %v", err)
+	}
+	if spec.PublicIPAdress != address {
+		t.Fatalf("Expected public ip address %s to be kept, got %s", address, spec.PublicIPAdress)
+	}
+	states, err = spec.CheckBaseInfrastructure(ctx, provider)
+	if err != nil {
+		t.Fatalf("Failed to check base infrastructure: %v", err)
+	}
+	if names := notReady(states); len(names) > 0 {
+		t.Fatalf("Expected all resources repaired, got drift in %v", names)
+	}
+}
+
+func TestCheckBaseInfrastructureErrors(t *testing.T) {
+	ctx := context.Background()
+	spec, cloud, provider := newFakeSpec(t)
+
+	cloud.FailNext("CreateLoadBalancer", fmt.Errorf("quota exceeded"))
+	if err := spec.CreateBaseInfrastructure(provider); err == nil {
+		t.Fatalf("Expected create base infrastructure to fail")
+	}
+
+	cloud.FailNext("GetVirtualNetwork", fmt.Errorf("throttled"))
+	if _, err := spec.CheckBaseInfrastructure(ctx, provider); err == nil {
+		t.Fatalf("Expected check to fail on errors other than missing resources")
+	}
+
+	failed := 0
+	for _, op := range cloud.Operations() {
+		if op.Status == fake.OperationFailed {
+			failed++
+		}
+	}
+	if failed != 1 {
+		t.Fatalf("Expected exactly one failed operation, got %d", failed)
+	}
+}
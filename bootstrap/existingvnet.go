@@ -0,0 +1,218 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-02-01/network"
+	"github.com/Azure/go-autorest/autorest/azure"
+	azhelpers "github.com/awesomenix/azk/azure"
+)
+
+const (
+	// agentVMSSSuffix is appended to the node set name by the nodeset controller
+	agentVMSSSuffix = "-agentvmss"
+	// the azure cloud provider names its load balancers after the default cluster name and tags the
+	// public ips of services
+	cloudProviderLoadBalancerName         = "kubernetes"
+	cloudProviderInternalLoadBalancerName = "kubernetes-internal"
+	cloudProviderServiceTag               = "service"
+)
+
+// ResolveExistingSubnets reads the existing subnets the cluster is deployed into, and returns the
+// topology with their address prefixes and the security group and route table of the agent subnet.
+// Both subnets must be associated with a security group, the agent subnet also with a route table,
+// as must the master subnet if egress is routed by the vnet.
+// The azure cloud provider only manages security groups of the cluster resource group, so the
+// security group of the agent subnet must be part of it.
+func (n Networking) ResolveExistingSubnets(ctx context.Context, provider azhelpers.Provider, groupName string) (Networking, error) {
+	if !n.ExistingVNet() {
+		return n, nil
+	}
+	if n.MasterSubnetID == "" || n.AgentSubnetID == "" {
+		return n, fmt.Errorf("existing vnet requires both master and agent subnet")
+	}
+
+	for _, s := range []struct {
+		role       string
+		id         string
+		cidr       *string
+		routeTable bool
+	}{
+		{"master", n.MasterSubnetID, &n.MasterSubnetCIDR, n.userDefinedRouting()},
+		{"agent", n.AgentSubnetID, &n.AgentSubnetCIDR, true},
+	} {
+		subnet, err := provider.GetSubnetByID(ctx, s.id)
+		if err != nil {
+			return n, fmt.Errorf("cannot get %s subnet %s: %v", s.role, s.id, err)
+		}
+		if err := checkExistingSubnet(subnet, *s.cidr, s.routeTable); err != nil {
+			return n, fmt.Errorf("%s subnet %s %v", s.role, s.id, err)
+		}
+		*s.cidr = *subnet.AddressPrefix
+		if s.role == "agent" {
+			n.AgentSecurityGroupID = *subnet.NetworkSecurityGroup.ID
+			n.AgentRouteTableID = *subnet.RouteTable.ID
+		}
+	}
+
+	nsg, err := azure.ParseResourceID(n.AgentSecurityGroupID)
+	if err != nil {
+		return n, err
+	}
+	if !strings.EqualFold(nsg.ResourceGroup, groupName) {
+		return n, fmt.Errorf("security group %s of agent subnet must be in resource group %s, the azure cloud provider manages service rules in it", n.AgentSecurityGroupID, groupName)
+	}
+	return n, nil
+}
+
+// userDefinedRouting returns true if masters and nodes egress through the routes of the vnet
+func (n Networking) userDefinedRouting() bool {
+	return n.WithDefaults().OutboundType == OutboundTypeUserDefinedRouting
+}
+
+// checkExistingSubnet verifies the subnet is associated with a security group, and a route table if
+// required, and matches the address prefix if one is given
+func checkExistingSubnet(subnet network.Subnet, addressPrefix string, routeTable bool) error {
+	switch {
+	case subnet.SubnetPropertiesFormat == nil || subnet.AddressPrefix == nil:
+		return driftf("has no address prefix")
+	case addressPrefix != "" && *subnet.AddressPrefix != addressPrefix:
+		return driftf("address prefix %s is not %s", *subnet.AddressPrefix, addressPrefix)
+	case subnet.NetworkSecurityGroup == nil || subnet.NetworkSecurityGroup.ID == nil:
+		return driftf("is not associated with a network security group")
+	case routeTable && (subnet.RouteTable == nil || subnet.RouteTable.ID == nil):
+		return driftf("is not associated with a route table")
+	}
+	return nil
+}
+
+// checkExistingSubnets verifies the existing subnets still match the topology they were resolved to
+func (spec *Spec) checkExistingSubnets(ctx context.Context, provider azhelpers.Provider) error {
+	networking := spec.Networking
+	master, err := provider.GetSubnetByID(ctx, networking.MasterSubnetID)
+	if err != nil {
+		return err
+	}
+	if err := checkExistingSubnet(master, networking.MasterSubnetCIDR, networking.userDefinedRouting()); err != nil {
+		return driftf("master subnet %v", err)
+	}
+	agent, err := provider.GetSubnetByID(ctx, networking.AgentSubnetID)
+	if err != nil {
+		return err
+	}
+	if err := checkExistingSubnet(agent, networking.AgentSubnetCIDR, true); err != nil {
+		return driftf("agent subnet %v", err)
+	}
+	if !strings.EqualFold(*agent.NetworkSecurityGroup.ID, networking.AgentSecurityGroupID) {
+		return driftf("agent subnet is not associated with %s", networking.AgentSecurityGroupID)
+	}
+	if !strings.EqualFold(*agent.RouteTable.ID, networking.AgentRouteTableID) {
+		return driftf("agent subnet is not associated with %s", networking.AgentRouteTableID)
+	}
+	return nil
+}
+
+// VNetID returns the resource id of the vnet of the cluster
+func (spec *Spec) VNetID() string {
+	if spec.Networking.ExistingVNet() {
+		subnet, _ := azhelpers.ParseSubnetID(spec.Networking.MasterSubnetID)
+		return subnet.VNetID()
+	}
+	return azhelpers.SubnetResource{
+		SubscriptionID: spec.SubscriptionID,
+		ResourceGroup:  spec.GroupName,
+		VNetName:       azkVNetName,
+	}.VNetID()
+}
+
+// vnetName returns the name of the vnet of the cluster, private dns zone links are named after it
+func (spec *Spec) vnetName() string {
+	vnetID := spec.VNetID()
+	return vnetID[strings.LastIndex(vnetID, "/")+1:]
+}
+
+// MasterSubnetID returns the resource id of the subnet masters are deployed into
+func (spec *Spec) MasterSubnetID() string {
+	if spec.Networking.ExistingVNet() {
+		return spec.Networking.MasterSubnetID
+	}
+	return spec.VNetID() + "/subnets/" + masterSubnetName
+}
+
+// AgentSubnetID returns the resource id of the subnet nodes are deployed into
+func (spec *Spec) AgentSubnetID() string {
+	if spec.Networking.ExistingVNet() {
+		return spec.Networking.AgentSubnetID
+	}
+	return spec.VNetID() + "/subnets/" + agentSubnetName
+}
+
+// cloudProviderNetwork returns the network the azure cloud provider of the cluster manages
+func (spec *Spec) cloudProviderNetwork() azhelpers.CloudProviderNetwork {
+	if !spec.Networking.ExistingVNet() {
+		return azhelpers.CloudProviderNetwork{}
+	}
+	agent, _ := azhelpers.ParseSubnetID(spec.Networking.AgentSubnetID)
+	nsg, _ := azure.ParseResourceID(spec.Networking.AgentSecurityGroupID)
+	routeTable, _ := azure.ParseResourceID(spec.Networking.AgentRouteTableID)
+	return azhelpers.CloudProviderNetwork{
+		VNetName:                agent.VNetName,
+		VNetResourceGroup:       agent.ResourceGroup,
+		SubnetName:              agent.Name,
+		SecurityGroupName:       nsg.ResourceName,
+		RouteTableName:          routeTable.ResourceName,
+		RouteTableResourceGroup: routeTable.ResourceGroup,
+	}
+}
+
+// cleanupClusterResources deletes the scale sets, load balancers, public ips and private dns zone of
+// the cluster, including those the azure cloud provider created for services, and keeps the resource
+// group along with the existing network
+func (spec *Spec) cleanupClusterResources(ctx context.Context, provider azhelpers.Provider) error {
+	vmss, err := provider.ListVMSS(ctx)
+	if err != nil {
+		return err
+	}
+	for _, set := range vmss {
+		if set.Name == nil || (*set.Name != masterVmssName && !strings.HasSuffix(*set.Name, agentVMSSSuffix)) {
+			continue
+		}
+		log.Info("Deleting", "VMSS", *set.Name)
+		if err := provider.DeleteVMSS(ctx, *set.Name); err != nil && !azhelpers.ResourceNotFound(err) {
+			return err
+		}
+	}
+
+	for _, lbName := range []string{azkLoadBalancerName, azkInternalLoadBalancerName, cloudProviderLoadBalancerName, cloudProviderInternalLoadBalancerName} {
+		log.Info("Deleting", "LoadBalancer", lbName)
+		if err := provider.DeleteLoadBalancer(ctx, lbName); err != nil && !azhelpers.ResourceNotFound(err) {
+			return err
+		}
+	}
+
+	pips, err := provider.ListPublicIPs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, pip := range pips {
+		if pip.Name == nil {
+			continue
+		}
+		if _, service := pip.Tags[cloudProviderServiceTag]; !service && *pip.Name != spec.publicIPName() {
+			continue
+		}
+		log.Info("Deleting", "PublicIP", *pip.Name)
+		if err := provider.DeletePublicIP(ctx, *pip.Name); err != nil && !azhelpers.ResourceNotFound(err) {
+			return err
+		}
+	}
+
+	log.Info("Deleting", "PrivateDnsZone", spec.InternalDNSName)
+	if err := provider.DeletePrivateDNSZone(ctx, spec.InternalDNSName, spec.vnetName()); err != nil && !azhelpers.ResourceNotFound(err) {
+		return err
+	}
+	log.Info("Kept existing network and resource group", "VNET", spec.VNetID(), "ResourceGroup", spec.GroupName)
+	return nil
+}
@@ -0,0 +1,137 @@
+package bootstrap
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-02-01/network"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/awesomenix/azk/azure/fake"
+)
+
+const hubVNetID = "/subscriptions/subscription/resourceGroups/network/providers/Microsoft.Network/virtualNetworks/hub"
+
+// putHubVNet stores an existing vnet in the network resource group, its agent subnet is associated
+// with the security group nsgID
+func putHubVNet(cloud *fake.Cloud, nsgID string) {
+	subnet := func(name, prefix string, nsg string, routeTable *network.RouteTable) network.Subnet {
+		return network.Subnet{
+			ID:   to.StringPtr(hubVNetID + "/subnets/" + name),
+			Name: to.StringPtr(name),
+			SubnetPropertiesFormat: &network.SubnetPropertiesFormat{
+				AddressPrefix:        to.StringPtr(prefix),
+				NetworkSecurityGroup: &network.SecurityGroup{ID: to.StringPtr(nsg)},
+				RouteTable:           routeTable,
+			},
+		}
+	}
+	cloud.PutVirtualNetwork("subscription", "network", network.VirtualNetwork{
+		ID:   to.StringPtr(hubVNetID),
+		Name: to.StringPtr("hub"),
+		VirtualNetworkPropertiesFormat: &network.VirtualNetworkPropertiesFormat{
+			AddressSpace: &network.AddressSpace{AddressPrefixes: &[]string{"172.16.0.0/16"}},
+			Subnets: &[]network.Subnet{
+				subnet("masters", "172.16.0.0/24", "/subscriptions/subscription/resourceGroups/network/providers/Microsoft.Network/networkSecurityGroups/masters-nsg", nil),
+				subnet("nodes", "172.16.16.0/20", nsgID, &network.RouteTable{
+					ID: to.StringPtr("/subscriptions/subscription/resourceGroups/network/providers/Microsoft.Network/routeTables/nodes-routes"),
+				}),
+			},
+		},
+	})
+}
+
+func TestExistingVNet(t *testing.T) {
+	ctx := context.Background()
+	spec, cloud, provider := newFakeSpec(t)
+	if err := provider.CreateOrUpdateResourceGroup(ctx); err != nil {
+		t.Fatalf("Failed to create resource group: %v", err)
+	}
+	putHubVNet(cloud, "/subscriptions/subscription/resourceGroups/group/providers/Microsoft.Network/networkSecurityGroups/nodes-nsg")
+
+	networking, err := Networking{
+		MasterSubnetID: hubVNetID + "/subnets/masters",
+		AgentSubnetID:  hubVNetID + "/subnets/nodes",
+	}.ResolveExistingSubnets(ctx, provider, spec.GroupName)
+	if err != nil {
+		t.Fatalf("Failed to resolve existing subnets: %v", err)
+	}
+	if networking.MasterSubnetCIDR != "172.16.0.0/24" || networking.AgentSubnetCIDR != "172.16.16.0/20" {
+		t.Fatalf("Expected the address prefixes of the existing subnets, got %s %s", networking.MasterSubnetCIDR, networking.AgentSubnetCIDR)
+	}
+	if err := networking.Validate(); err != nil {
+		t.Fatalf("Expected resolved networking to be valid: %v", err)
+	}
+	spec.Networking = networking.WithDefaults()
+	if spec.Networking.InternalLoadBalancerIP != "172.16.0.254" {
+		t.Fatalf("Expected the internal load balancer at the last usable master address, got %s", spec.Networking.InternalLoadBalancerIP)
+	}
+
+	cloudProviderNetwork := spec.cloudProviderNetwork()
+	if cloudProviderNetwork.VNetName != "hub" || cloudProviderNetwork.VNetResourceGroup != "network" || cloudProviderNetwork.SubnetName != "nodes" ||
+		cloudProviderNetwork.SecurityGroupName != "nodes-nsg" || cloudProviderNetwork.RouteTableName != "nodes-routes" || cloudProviderNetwork.RouteTableResourceGroup != "network" {
+		t.Fatalf("Unexpected cloud provider network %+v", cloudProviderNetwork)
+	}
+
+	if err := spec.CreateBaseInfrastructure(provider); err != nil {
+		t.Fatalf("Failed to create base infrastructure: %v", err)
+	}
+	if _, err := provider.GetVirtualNetwork(ctx, azkVNetName); err == nil {
+		t.Fatalf("Expected no %s to be created in an existing vnet", azkVNetName)
+	}
+	lb, err := provider.GetLoadBalancer(ctx, azkInternalLoadBalancerName)
+	if err != nil {
+		t.Fatalf("Failed to get internal load balancer: %v", err)
+	}
+	if frontEnd := (*lb.FrontendIPConfigurations)[0]; !strings.EqualFold(*frontEnd.Subnet.ID, spec.MasterSubnetID()) {
+		t.Fatalf("Expected internal load balancer in %s, got %s", spec.MasterSubnetID(), *frontEnd.Subnet.ID)
+	}
+	states, err := spec.CheckBaseInfrastructure(ctx, provider)
+	if err != nil {
+		t.Fatalf("Failed to check base infrastructure: %v", err)
+	}
+	if names := notReady(states); len(names) > 0 {
+		t.Fatalf("Expected all resources ready, got drift in %v", names)
+	}
+
+	if err := spec.CleanupInfrastructure(provider); err != nil {
+		t.Fatalf("Failed to cleanup infrastructure: %v", err)
+	}
+	if _, err := provider.GetResourceGroup(ctx); err != nil {
+		t.Fatalf("Expected the resource group to be kept: %v", err)
+	}
+	if _, err := provider.GetSubnetByID(ctx, spec.AgentSubnetID()); err != nil {
+		t.Fatalf("Expected the existing vnet to be kept: %v", err)
+	}
+	for _, lbName := range []string{azkLoadBalancerName, azkInternalLoadBalancerName} {
+		if _, err := provider.GetLoadBalancer(ctx, lbName); err == nil {
+			t.Errorf("Expected %s to be deleted", lbName)
+		}
+	}
+	if _, err := provider.GetPrivateDNSZone(ctx, spec.InternalDNSName); err == nil {
+		t.Errorf("Expected private dns zone %s to be deleted", spec.InternalDNSName)
+	}
+}
+
+func TestResolveExistingSubnetsErrors(t *testing.T) {
+	ctx := context.Background()
+	spec, cloud, provider := newFakeSpec(t)
+	putHubVNet(cloud, "/subscriptions/subscription/resourceGroups/network/providers/Microsoft.Network/networkSecurityGroups/nodes-nsg")
+
+	for _, test := range []struct {
+		name       string
+		networking Networking
+		err        string
+	}{
+		{"agent subnet missing", Networking{MasterSubnetID: hubVNetID + "/subnets/masters"}, "both master and agent subnet"},
+		{"unknown subnet", Networking{MasterSubnetID: hubVNetID + "/subnets/masters", AgentSubnetID: hubVNetID + "/subnets/unknown"}, "cannot get agent subnet"},
+		{"no route table", Networking{MasterSubnetID: hubVNetID + "/subnets/nodes", AgentSubnetID: hubVNetID + "/subnets/masters"}, "route table"},
+		{"prefix mismatch", Networking{MasterSubnetID: hubVNetID + "/subnets/masters", AgentSubnetID: hubVNetID + "/subnets/nodes", MasterSubnetCIDR: "172.16.1.0/24"}, "is not 172.16.1.0/24"},
+		{"security group of another group", Networking{MasterSubnetID: hubVNetID + "/subnets/masters", AgentSubnetID: hubVNetID + "/subnets/nodes"}, "must be in resource group group"},
+	} {
+		_, err := test.networking.ResolveExistingSubnets(ctx, provider, spec.GroupName)
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: expected error containing %q, got %v", test.name, test.err, err)
+		}
+	}
+}
@@ -14,73 +14,37 @@ const (
 	azkLoadBalancerName         = "azk-lb"
 	azkInternalLoadBalancerName = "azk-internal-lb"
 	azkPublicIPName             = "azk-publicip"
+	azkNATGatewayName           = "azk-natgateway"
 	masterVmssName              = "azk-master-vmss"
+	privateDNSApexRecord        = "@"
 )
 
+// preRequisites installs the packages of the first master and redirects the internal api server
+// endpoint to the api server kubeadm init starts, the internal load balancer has no other backend
 func (spec *Spec) preRequisites(kubernetesVersion string) string {
 	return fmt.Sprintf(`
 %[1]s
-sudo cp -f /etc/hosts /tmp/hostsupdate
-sudo chown $(id -u):$(id -g) /tmp/hostsupdate
-echo '10.0.0.4 %[2]s' >> /tmp/hostsupdate
-sudo mv /etc/hosts /etc/hosts.bak
-sudo mv /tmp/hostsupdate /etc/hosts
-`, helpers.PreRequisitesInstallScript(kubernetesVersion), spec.InternalDNSName)
-}
-
-func (spec *Spec) kubeadmInitConfig(kubernetesVersion string) string {
-	return fmt.Sprintf(`
-cat <<EOF >/tmp/kubeadm-config.yaml
-apiVersion: kubeadm.k8s.io/v1beta1
-nodeRegistration:
-  kubeletExtraArgs:
-    cloud-provider: azure
-    cloud-config: /etc/kubernetes/azure.json
-kind: InitConfiguration
----
-apiVersion: kubeadm.k8s.io/v1beta1
-kind: ClusterConfiguration
-apiServer:
-  certSANs:
-  - "%[1]s"
-  - "%[2]s"
-  - "10.0.0.100"
-  extraArgs:
-    cloud-config: /etc/kubernetes/azure.json
-    cloud-provider: azure
-  extraVolumes:
-  - hostPath: /etc/kubernetes/azure.json
-    mountPath: /etc/kubernetes/azure.json
-    name: cloud-config
-    readOnly: true
-controllerManager:
-  extraArgs:
-    cloud-config: /etc/kubernetes/azure.json
-    cloud-provider: azure
-  extraVolumes:
-  - hostPath: /etc/kubernetes/azure.json
-    mountPath: /etc/kubernetes/azure.json
-    name: cloud-config
-    readOnly: true
-kubernetesVersion: %[3]s
-controlPlaneEndpoint: "%[2]s:6443"
-networking:
-  podSubnet: "10.244.0.0/16"
-etcd:
-  local:
-    imageRepository: gcr.io/etcd-development
-    imageTag: v3.4.1
-EOF
-`, spec.PublicDNSName,
-		spec.InternalDNSName,
-		kubernetesVersion)
+%[2]s
+`, helpers.PreRequisitesInstallScript(kubernetesVersion), helpers.APIServerHairpinScript(spec.Networking.WithDefaults().InternalLoadBalancerIP))
 }
 
-func (spec *Spec) GetEncodedBootstrapStartupScript(kubernetesVersion string) string {
-	return base64.StdEncoding.EncodeToString([]byte(spec.GetBootstrapStartupScript(kubernetesVersion)))
+func (spec *Spec) GetEncodedBootstrapStartupScript(kubernetesVersion string) (string, error) {
+	script, err := spec.GetBootstrapStartupScript(kubernetesVersion)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString([]byte(script)), nil
 }
 
-func (spec *Spec) GetBootstrapStartupScript(kubernetesVersion string) string {
+func (spec *Spec) GetBootstrapStartupScript(kubernetesVersion string) (string, error) {
+	kubeadmConfig, err := spec.kubeadmInitConfig(kubernetesVersion)
+	if err != nil {
+		return "", err
+	}
+	networkPlugin, err := spec.networkPluginScript()
+	if err != nil {
+		return "", err
+	}
 	return fmt.Sprintf(`
 set -eux
 %[1]s
@@ -92,43 +56,149 @@ mkdir -p $HOME/.kube
 sudo cp -f /etc/kubernetes/admin.conf $HOME/.kube/config
 sudo chown $(id -u):$(id -g) $HOME/.kube/config
 %[3]s
-`, spec.kubeadmInitConfig(kubernetesVersion),
+`, kubeadmConfig,
 		spec.preRequisites(kubernetesVersion),
-		helpers.CanalCNI())
+		networkPlugin), nil
+}
+
+// internalLoadBalancerIP returns the frontend address of the internal load balancer, the private dns
+// zone resolves the internal endpoint to it
+func (spec *Spec) internalLoadBalancerIP(ctx context.Context, provider azhelpers.Provider) (string, error) {
+	lb, err := provider.GetLoadBalancer(ctx, azkInternalLoadBalancerName)
+	if err != nil {
+		return "", err
+	}
+	if lb.LoadBalancerPropertiesFormat != nil && lb.FrontendIPConfigurations != nil {
+		for _, frontEnd := range *lb.FrontendIPConfigurations {
+			if frontEnd.FrontendIPConfigurationPropertiesFormat != nil && frontEnd.PrivateIPAddress != nil {
+				return *frontEnd.PrivateIPAddress, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("internal load balancer %s has no frontend address", azkInternalLoadBalancerName)
+}
+
+// publicIPName is the name of the public ip fronting the public load balancer
+func (spec *Spec) publicIPName() string {
+	h := fnv.New32a()
+	h.Write([]byte(fmt.Sprintf("%s-%s", azkPublicIPName, spec.ClusterName)))
+	return spec.DNSPrefix + fmt.Sprintf("%x", h.Sum32())
+}
+
+// egressPublicIPName is the name of the public ip of the nat gateway
+func (spec *Spec) egressPublicIPName() string {
+	return spec.publicIPName() + "-egress"
+}
+
+// apiServerCertSANs are the names and addresses the api server certificate is valid for. Private
+// clusters have no public name, instead the loopback address a local tunnel to the api server
+// listens on is added.
+func (spec *Spec) apiServerCertSANs() []string {
+	sans := []string{spec.Networking.WithDefaults().InternalLoadBalancerIP, spec.InternalDNSName}
+	if spec.Networking.PrivateCluster {
+		return append(sans, "127.0.0.1", "localhost")
+	}
+	return append(sans, spec.PublicDNSName)
+}
+
+// customerAPIServerHost is the api server host of the customer kubeconfig, private clusters are
+// only reachable through the internal endpoint
+func (spec *Spec) customerAPIServerHost() string {
+	if spec.Networking.PrivateCluster {
+		return spec.InternalDNSName
+	}
+	return spec.PublicDNSName
+}
+
+// MasterLoadBalancerPools returns the backend pools and inbound nat pools the master scale set is
+// part of, masters of private clusters are only behind the internal load balancer
+func (spec *Spec) MasterLoadBalancerPools() ([]string, []string) {
+	prefix := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers", spec.SubscriptionID, spec.GroupName)
+	loadbalancerIDs := []string{
+		prefix + "/" + azkInternalLoadBalancerName + "/backendAddressPools/master-internal-backEndPool",
+	}
+	if spec.Networking.PrivateCluster {
+		return loadbalancerIDs, nil
+	}
+	loadbalancerIDs = append([]string{prefix + "/" + azkLoadBalancerName + "/backendAddressPools/master-backEndPool"}, loadbalancerIDs...)
+	natPoolIDs := []string{
+		prefix + "/" + azkLoadBalancerName + "/inboundNatPools/natSSHPool",
+	}
+	return loadbalancerIDs, natPoolIDs
 }
 
-func (spec *Spec) CreateBaseInfrastructure() error {
+func (spec *Spec) CreateBaseInfrastructure(provider azhelpers.Provider) error {
 	log.Info("Creating", "ResourceGroup", spec.GroupName, "Location", spec.GroupLocation)
-	err := spec.CreateOrUpdateResourceGroup(context.TODO())
+	err := provider.CreateOrUpdateResourceGroup(context.TODO())
 	if err != nil {
 		return err
 	}
 	log.Info("Successfully Created", "ResourceGroup", spec.GroupName, "Location", spec.GroupLocation)
 
-	log.Info("Creating", "VNET", "azk-vnet", "Location", spec.GroupLocation)
-	err = spec.CreateVirtualNetworkAndSubnets(context.TODO(), "azk-vnet")
-	if err != nil {
+	networking := spec.Networking.WithDefaults()
+	if err := spec.NetworkSecurity.Validate(networking); err != nil {
 		return err
 	}
-	log.Info("Successfully Created", "VNET", "azk-vnet", "Location", spec.GroupLocation)
+	if networking.ExistingVNet() {
+		log.Info("Using existing", "VNET", spec.VNetID())
+		if err := spec.checkExistingSubnets(context.TODO(), provider); err != nil {
+			return fmt.Errorf("cannot use existing vnet %s: %v", spec.VNetID(), err)
+		}
+	} else {
+		natGatewayID := ""
+		if networking.OutboundType == OutboundTypeNATGateway {
+			log.Info("Creating", "NatGateway", azkNATGatewayName, "PublicIPName", spec.egressPublicIPName())
+			natGateway, err := provider.CreateNatGateway(context.TODO(), azkNATGatewayName, spec.egressPublicIPName())
+			if err != nil {
+				return err
+			}
+			natGatewayID = *natGateway.ID
+			log.Info("Successfully Created", "NatGateway", azkNATGatewayName, "PublicIPName", spec.egressPublicIPName())
+		}
+
+		log.Info("Creating", "VNET", azkVNetName, "Location", spec.GroupLocation)
+		err = provider.CreateVirtualNetworkAndSubnets(context.TODO(), azkVNetName, networking.VNetCIDR, networking.MasterSubnetCIDR, networking.AgentSubnetCIDR, natGatewayID)
+		if err != nil {
+			return err
+		}
+		log.Info("Successfully Created", "VNET", azkVNetName, "Location", spec.GroupLocation)
+
+		if err := spec.reconcileNetworkSecurityGroups(context.TODO(), provider); err != nil {
+			return err
+		}
+	}
 
 	log.Info("Creating Internal Load Balancer", "Name", azkInternalLoadBalancerName)
-	if err := spec.CreateInternalLoadBalancer(
+	if err := provider.CreateInternalLoadBalancer(
 		context.TODO(),
-		"azk-vnet",
-		"master-subnet",
-		azkInternalLoadBalancerName); err != nil {
+		spec.MasterSubnetID(),
+		azkInternalLoadBalancerName,
+		networking.InternalLoadBalancerIP); err != nil {
 		return err
 	}
 	log.Info("Successfully Created Internal Load Balancer", "Name", azkInternalLoadBalancerName)
 
-	h := fnv.New32a()
-	h.Write([]byte(fmt.Sprintf("%s-%s", azkPublicIPName, spec.ClusterName)))
-	publicIPName := fmt.Sprintf("%x", h.Sum32())
-	publicIPName = spec.DNSPrefix + publicIPName
+	internalIP, err := spec.internalLoadBalancerIP(context.TODO(), provider)
+	if err != nil {
+		return err
+	}
+	log.Info("Creating Private DNS Zone", "Name", spec.InternalDNSName, "Address", internalIP)
+	if err := provider.CreatePrivateDNSZone(context.TODO(), spec.InternalDNSName, spec.VNetID()); err != nil {
+		return err
+	}
+	if err := provider.CreatePrivateDNSARecord(context.TODO(), spec.InternalDNSName, privateDNSApexRecord, internalIP); err != nil {
+		return err
+	}
+	log.Info("Successfully Created Private DNS Zone", "Name", spec.InternalDNSName, "Address", internalIP)
 
+	if networking.PrivateCluster {
+		log.Info("Skipping Public Load Balancer of private cluster", "Name", azkLoadBalancerName)
+		return nil
+	}
+
+	publicIPName := spec.publicIPName()
 	log.Info("Creating Public Load Balancer", "Name", azkLoadBalancerName, "PublicIPName", publicIPName)
-	if err := spec.CreateLoadBalancer(
+	if err := provider.CreateLoadBalancer(
 		context.TODO(),
 		azkLoadBalancerName,
 		publicIPName); err != nil {
@@ -136,7 +206,7 @@ func (spec *Spec) CreateBaseInfrastructure() error {
 	}
 	log.Info("Successfully Created Public Load Balancer", "Name", azkLoadBalancerName, "PublicIPName", publicIPName)
 
-	pip, err := spec.GetPublicIP(context.TODO(), publicIPName)
+	pip, err := provider.GetPublicIP(context.TODO(), publicIPName)
 	if err != nil {
 		return err
 	}
@@ -147,56 +217,47 @@ func (spec *Spec) CreateBaseInfrastructure() error {
 	return nil
 }
 
-func (spec *Spec) CreateInfrastructure() error {
-	if _, err := spec.GetVMSS(context.TODO(), masterVmssName); err != nil && !azhelpers.ResourceNotFound(err) {
+func (spec *Spec) CreateInfrastructure(provider azhelpers.Provider) error {
+	if _, err := provider.GetVMSS(context.TODO(), masterVmssName); err != nil && !azhelpers.ResourceNotFound(err) {
 		return err
 	}
 
+	startupScript, err := spec.GetBootstrapStartupScript(spec.BootstrapKubernetesVersion)
+	if err != nil {
+		return err
+	}
+	return spec.createMasterVMSS(provider, startupScript)
+}
+
+// createMasterVMSS creates the master scale set with a single instance running the startup script
+func (spec *Spec) createMasterVMSS(provider azhelpers.Provider, startupScript string) error {
 	vmSKUType := spec.BootstrapVMSKUType
 	if vmSKUType == "" {
 		vmSKUType = "Standard_DS2_v2"
 	}
 
-	customData := map[string]string{
-		"/etc/kubernetes/pki/ca.crt":             spec.CACertificate,
-		"/etc/kubernetes/pki/ca.key":             spec.CACertificateKey,
-		"/etc/kubernetes/pki/sa.key":             spec.ServiceAccountKey,
-		"/etc/kubernetes/pki/sa.pub":             spec.ServiceAccountPub,
-		"/etc/kubernetes/pki/front-proxy-ca.crt": spec.FrontProxyCACertificate,
-		"/etc/kubernetes/pki/front-proxy-ca.key": spec.FrontProxyCACertificateKey,
-		"/etc/kubernetes/pki/etcd/ca.crt":        spec.EtcdCACertificate,
-		"/etc/kubernetes/pki/etcd/ca.key":        spec.EtcdCACertificateKey,
-		"/etc/kubernetes/azure.json":             spec.AzureCloudProviderConfig,
-		//"/etc/kubernetes/admin.conf":             status.AdminKubeConfig,
-	}
-
-	customRunData := map[string]string{
-		"/etc/kubernetes/init-azure-bootstrap.sh": spec.GetBootstrapStartupScript(spec.BootstrapKubernetesVersion),
+	cloudConfig, err := spec.MasterCloudConfig(startupScript)
+	if err != nil {
+		return err
 	}
-
-	prefix := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers", spec.SubscriptionID, spec.GroupName)
-
-	subnetID := prefix + "/Microsoft.Network/virtualNetworks/azk-vnet/subnets/master-subnet"
-
-	loadbalancerIDs := []string{
-		prefix + "/Microsoft.Network/loadBalancers/azk-lb/backendAddressPools/master-backEndPool",
-		prefix + "/Microsoft.Network/loadBalancers/azk-internal-lb/backendAddressPools/master-internal-backEndPool",
+	customData, err := cloudConfig.CustomData()
+	if err != nil {
+		return err
 	}
 
-	natPoolIDs := []string{
-		prefix + "/Microsoft.Network/loadBalancers/azk-lb/inboundNatPools/natSSHPool",
-	}
+	loadbalancerIDs, natPoolIDs := spec.MasterLoadBalancerPools()
 
 	log.Info("Creating", "VMSS", masterVmssName)
-	if err := spec.CreateVMSS(
+	if err := provider.CreateVMSS(
 		context.TODO(),
 		masterVmssName,
-		subnetID,
+		spec.MasterSubnetID(),
 		loadbalancerIDs,
 		natPoolIDs,
-		base64.StdEncoding.EncodeToString([]byte(azhelpers.GetCustomData(customData, customRunData))),
+		customData,
 		spec.BootstrapVMSKUType,
-		1); err != nil {
+		1,
+		spec.Networking.IPConfigurations()); err != nil {
 		return err
 	}
 	log.Info("Successfully Created", "VMSS", masterVmssName)
@@ -204,6 +265,12 @@ func (spec *Spec) CreateInfrastructure() error {
 	return nil
 }
 
-func (spec *Spec) CleanupInfrastructure() error {
-	return spec.DeleteResourceGroup(context.TODO())
+// CleanupInfrastructure deletes the cluster along with its resource group, unless the cluster was
+// deployed into an existing vnet. The resource group may then hold resources azk does not own, so
+// only the resources of the cluster are deleted.
+func (spec *Spec) CleanupInfrastructure(provider azhelpers.Provider) error {
+	if spec.Networking.ExistingVNet() {
+		return spec.cleanupClusterResources(context.TODO(), provider)
+	}
+	return provider.DeleteResourceGroup(context.TODO())
 }
@@ -0,0 +1,62 @@
+package bootstrap
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestPrivateClusterBaseInfrastructure(t *testing.T) {
+	ctx := context.Background()
+	spec, _, provider := newFakeSpec(t)
+	spec.Networking = Networking{PrivateCluster: true}.WithDefaults()
+
+	if err := spec.CreateBaseInfrastructure(provider); err != nil {
+		t.Fatalf("Failed to create base infrastructure: %v", err)
+	}
+	if _, err := provider.GetLoadBalancer(ctx, azkLoadBalancerName); err == nil {
+		t.Errorf("Expected no public load balancer %s for a private cluster", azkLoadBalancerName)
+	}
+	if _, err := provider.GetPublicIP(ctx, spec.publicIPName()); err == nil {
+		t.Errorf("Expected no public ip %s for a private cluster", spec.publicIPName())
+	}
+	if _, err := provider.GetLoadBalancer(ctx, azkInternalLoadBalancerName); err != nil {
+		t.Errorf("Failed to get internal load balancer: %v", err)
+	}
+
+	natGateway, err := provider.GetNatGateway(ctx, azkNATGatewayName)
+	if err != nil {
+		t.Fatalf("Failed to get nat gateway: %v", err)
+	}
+	for _, subnetID := range []string{spec.MasterSubnetID(), spec.AgentSubnetID()} {
+		subnet, err := provider.GetSubnetByID(ctx, subnetID)
+		if err != nil {
+			t.Fatalf("Failed to get subnet %s: %v", subnetID, err)
+		}
+		if subnet.NatGateway == nil || subnet.NatGateway.ID == nil || !strings.EqualFold(*subnet.NatGateway.ID, *natGateway.ID) {
+			t.Errorf("Expected subnet %s to egress through %s", subnetID, azkNATGatewayName)
+		}
+	}
+
+	states, err := spec.CheckBaseInfrastructure(ctx, provider)
+	if err != nil {
+		t.Fatalf("Failed to check base infrastructure: %v", err)
+	}
+	if names := notReady(states); len(names) > 0 {
+		t.Fatalf("Expected all resources ready, got drift in %v", names)
+	}
+	for _, state := range states {
+		if state.Name == azkLoadBalancerName || state.Name == spec.publicIPName() {
+			t.Errorf("Expected no check of public %s %s", state.Type, state.Name)
+		}
+	}
+
+	loadbalancerIDs, natPoolIDs := spec.MasterLoadBalancerPools()
+	if len(loadbalancerIDs) != 1 || !strings.Contains(loadbalancerIDs[0], azkInternalLoadBalancerName) || len(natPoolIDs) != 0 {
+		t.Errorf("Expected masters only behind %s, got %v %v", azkInternalLoadBalancerName, loadbalancerIDs, natPoolIDs)
+	}
+	sans := strings.Join(spec.apiServerCertSANs(), ",")
+	if spec.customerAPIServerHost() != spec.InternalDNSName || !strings.Contains(sans, "127.0.0.1") {
+		t.Errorf("Expected the customer kubeconfig to target %s through tunnels, got %s with SANs %s", spec.InternalDNSName, spec.customerAPIServerHost(), sans)
+	}
+}
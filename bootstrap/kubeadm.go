@@ -0,0 +1,305 @@
+package bootstrap
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/version"
+	kubeadmv1beta2 "k8s.io/kubernetes/cmd/kubeadm/app/apis/kubeadm/v1beta2"
+	sigsyaml "sigs.k8s.io/yaml"
+)
+
+const (
+	kubeadmV1beta1 = "kubeadm.k8s.io/v1beta1"
+	kubeadmV1beta2 = "kubeadm.k8s.io/v1beta2"
+	kubeadmV1beta3 = "kubeadm.k8s.io/v1beta3"
+
+	cloudConfigPath     = "/etc/kubernetes/azure.json"
+	etcdImageRepository = "gcr.io/etcd-development"
+	etcdImageTag        = "v3.4.1"
+	// etcdImage is the etcd image kubeadmInitConfig runs, its etcdctl restores snapshots
+	etcdImage = etcdImageRepository + "/etcd:" + etcdImageTag
+)
+
+// KubeadmPatches are merged into the kubeadm configuration azk generates. The control plane
+// components are configured when the first master initializes the cluster, masters joining later
+// use the configuration stored in the cluster.
+type KubeadmPatches struct {
+	APIServer         ControlPlaneComponentPatch `json:"apiServer,omitempty"`
+	ControllerManager ControlPlaneComponentPatch `json:"controllerManager,omitempty"`
+	Scheduler         ControlPlaneComponentPatch `json:"scheduler,omitempty"`
+	// KubeletExtraArgs are passed to the kubelet of every master and node
+	KubeletExtraArgs map[string]string `json:"kubeletExtraArgs,omitempty"`
+}
+
+// ControlPlaneComponentPatch adds flags and host path volumes to a control plane component
+type ControlPlaneComponentPatch struct {
+	ExtraArgs    map[string]string `json:"extraArgs,omitempty"`
+	ExtraVolumes []HostPathMount   `json:"extraVolumes,omitempty"`
+}
+
+// HostPathMount mounts a path of the master into the static pod of a control plane component
+type HostPathMount struct {
+	Name      string `json:"name"`
+	HostPath  string `json:"hostPath"`
+	MountPath string `json:"mountPath"`
+	ReadOnly  bool   `json:"readOnly,omitempty"`
+	// PathType is a HostPathType such as DirectoryOrCreate or File, unchecked when empty
+	PathType string `json:"pathType,omitempty"`
+}
+
+// DeepCopyInto copies the args and volumes, they are not shared with the copy
+func (in *KubeadmPatches) DeepCopyInto(out *KubeadmPatches) {
+	*out = *in
+	out.APIServer = in.APIServer.deepCopy()
+	out.ControllerManager = in.ControllerManager.deepCopy()
+	out.Scheduler = in.Scheduler.deepCopy()
+	out.KubeletExtraArgs = copyArgs(in.KubeletExtraArgs)
+}
+
+func (in ControlPlaneComponentPatch) deepCopy() ControlPlaneComponentPatch {
+	return ControlPlaneComponentPatch{
+		ExtraArgs:    copyArgs(in.ExtraArgs),
+		ExtraVolumes: append([]HostPathMount(nil), in.ExtraVolumes...),
+	}
+}
+
+func copyArgs(in map[string]string) map[string]string {
+	if in == nil {
+		return nil
+	}
+	out := make(map[string]string, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
+
+// Validate refuses patches of the flags and volumes azk configures the azure cloud provider with
+func (p KubeadmPatches) Validate() error {
+	for _, component := range []struct {
+		name  string
+		patch ControlPlaneComponentPatch
+	}{
+		{"apiServer", p.APIServer},
+		{"controllerManager", p.ControllerManager},
+		{"scheduler", p.Scheduler},
+	} {
+		if err := validateExtraArgs(component.name, component.patch.ExtraArgs); err != nil {
+			return err
+		}
+		names := map[string]bool{"cloud-config": true, cloudEnvironmentVolume: true}
+		for _, volume := range component.patch.ExtraVolumes {
+			switch {
+			case volume.Name == "" || volume.HostPath == "" || volume.MountPath == "":
+				return fmt.Errorf("extra volume %q of %s requires a name, host path and mount path", volume.Name, component.name)
+			case names[volume.Name]:
+				return fmt.Errorf("extra volume %s of %s is not unique", volume.Name, component.name)
+			}
+			switch corev1.HostPathType(volume.PathType) {
+			case corev1.HostPathUnset, corev1.HostPathDirectoryOrCreate, corev1.HostPathDirectory, corev1.HostPathFileOrCreate,
+				corev1.HostPathFile, corev1.HostPathSocket, corev1.HostPathCharDev, corev1.HostPathBlockDev:
+			default:
+				return fmt.Errorf("extra volume %s of %s has unknown path type %s", volume.Name, component.name, volume.PathType)
+			}
+			names[volume.Name] = true
+		}
+	}
+	return validateExtraArgs("kubelet", p.KubeletExtraArgs)
+}
+
+func validateExtraArgs(component string, args map[string]string) error {
+	for _, reserved := range []string{"cloud-provider", "cloud-config"} {
+		if _, ok := args[reserved]; ok {
+			return fmt.Errorf("extra arg %s of %s is managed by azk", reserved, component)
+		}
+	}
+	return nil
+}
+
+// KubeadmAPIVersion returns the kubeadm configuration api version the kubeadm of the kubernetes
+// version reads, v1beta2 from 1.15 and v1beta3 from 1.22 on
+func KubeadmAPIVersion(kubernetesVersion string) (string, error) {
+	v, err := version.ParseGeneric(kubernetesVersion)
+	if err != nil {
+		return "", fmt.Errorf("cannot parse kubernetes version %s: %v", kubernetesVersion, err)
+	}
+	switch {
+	case v.LessThan(version.MustParseGeneric("1.15.0")):
+		return kubeadmV1beta1, nil
+	case v.LessThan(version.MustParseGeneric("1.22.0")):
+		return kubeadmV1beta2, nil
+	default:
+		return kubeadmV1beta3, nil
+	}
+}
+
+// KubeadmCertsRenewCommand returns the kubeadm command renewing certificates of the kubernetes version,
+// kubeadm 1.20 moved it out of alpha and later releases dropped the alpha command
+func KubeadmCertsRenewCommand(kubernetesVersion string) (string, error) {
+	v, err := version.ParseGeneric(kubernetesVersion)
+	if err != nil {
+		return "", fmt.Errorf("cannot parse kubernetes version %s: %v", kubernetesVersion, err)
+	}
+	if v.LessThan(version.MustParseGeneric("1.20.0")) {
+		return "kubeadm alpha certs renew", nil
+	}
+	return "kubeadm certs renew", nil
+}
+
+// cloudProviderComponent configures a control plane component for the azure cloud provider
+func cloudProviderComponent() kubeadmv1beta2.ControlPlaneComponent {
+	return kubeadmv1beta2.ControlPlaneComponent{
+		ExtraArgs: map[string]string{
+			"cloud-provider": "azure",
+			"cloud-config":   cloudConfigPath,
+		},
+		ExtraVolumes: []kubeadmv1beta2.HostPathMount{{
+			Name:      "cloud-config",
+			HostPath:  cloudConfigPath,
+			MountPath: cloudConfigPath,
+			ReadOnly:  true,
+		}},
+	}
+}
+
+// apply adds the args and volumes of the patch to the component
+func (patch ControlPlaneComponentPatch) apply(component kubeadmv1beta2.ControlPlaneComponent) kubeadmv1beta2.ControlPlaneComponent {
+	if component.ExtraArgs == nil && len(patch.ExtraArgs) > 0 {
+		component.ExtraArgs = map[string]string{}
+	}
+	for k, v := range patch.ExtraArgs {
+		component.ExtraArgs[k] = v
+	}
+	for _, volume := range patch.ExtraVolumes {
+		component.ExtraVolumes = append(component.ExtraVolumes, kubeadmv1beta2.HostPathMount{
+			Name:      volume.Name,
+			HostPath:  volume.HostPath,
+			MountPath: volume.MountPath,
+			ReadOnly:  volume.ReadOnly,
+			PathType:  corev1.HostPathType(volume.PathType),
+		})
+	}
+	return component
+}
+
+// nodeRegistration registers masters and nodes with the azure cloud provider
+func (spec *Spec) nodeRegistration() kubeadmv1beta2.NodeRegistrationOptions {
+	args := map[string]string{
+		"cloud-provider": "azure",
+		"cloud-config":   cloudConfigPath,
+	}
+	spec.networkPluginKubeletArgs(args)
+	for k, v := range spec.KubeadmPatches.KubeletExtraArgs {
+		args[k] = v
+	}
+	return kubeadmv1beta2.NodeRegistrationOptions{KubeletExtraArgs: args}
+}
+
+// kubeadmInitConfig returns the script writing the kubeadm configuration of the first master
+func (spec *Spec) kubeadmInitConfig(kubernetesVersion string) (string, error) {
+	if err := spec.KubeadmPatches.Validate(); err != nil {
+		return "", err
+	}
+	apiVersion, err := KubeadmAPIVersion(kubernetesVersion)
+	if err != nil {
+		return "", err
+	}
+	networking := spec.Networking.WithDefaults()
+	initConfig := &kubeadmv1beta2.InitConfiguration{
+		TypeMeta:         metav1.TypeMeta{APIVersion: apiVersion, Kind: "InitConfiguration"},
+		NodeRegistration: spec.nodeRegistration(),
+	}
+	clusterConfig := &kubeadmv1beta2.ClusterConfiguration{
+		TypeMeta: metav1.TypeMeta{APIVersion: apiVersion, Kind: "ClusterConfiguration"},
+		APIServer: kubeadmv1beta2.APIServer{
+			ControlPlaneComponent: spec.KubeadmPatches.APIServer.apply(spec.cloudEnvironmentComponent(cloudProviderComponent())),
+			CertSANs:              spec.apiServerCertSANs(),
+		},
+		ControllerManager:    spec.KubeadmPatches.ControllerManager.apply(spec.networkPluginControllerManager(spec.cloudEnvironmentComponent(cloudProviderComponent()))),
+		Scheduler:            spec.KubeadmPatches.Scheduler.apply(kubeadmv1beta2.ControlPlaneComponent{}),
+		KubernetesVersion:    kubernetesVersion,
+		ControlPlaneEndpoint: spec.InternalDNSName + ":6443",
+		Networking: kubeadmv1beta2.Networking{
+			PodSubnet:     networking.PodCIDR,
+			ServiceSubnet: networking.ServiceCIDR,
+			DNSDomain:     networking.DNSDomain,
+		},
+		Etcd: kubeadmv1beta2.Etcd{
+			Local: &kubeadmv1beta2.LocalEtcd{
+				ImageMeta: kubeadmv1beta2.ImageMeta{ImageRepository: etcdImageRepository, ImageTag: etcdImageTag},
+			},
+		},
+	}
+	return kubeadmConfigScript(apiVersion, initConfig, clusterConfig)
+}
+
+// KubeadmJoinConfig returns the script writing the kubeadm configuration joining a master or node
+// through the internal endpoint, every CA trusted during a CA rotation is accepted
+func (spec *Spec) KubeadmJoinConfig(kubernetesVersion, bootstrapToken string, controlPlane bool) (string, error) {
+	if err := spec.KubeadmPatches.Validate(); err != nil {
+		return "", err
+	}
+	apiVersion, err := KubeadmAPIVersion(kubernetesVersion)
+	if err != nil {
+		return "", err
+	}
+	joinConfig := &kubeadmv1beta2.JoinConfiguration{
+		TypeMeta:         metav1.TypeMeta{APIVersion: apiVersion, Kind: "JoinConfiguration"},
+		NodeRegistration: spec.nodeRegistration(),
+		Discovery: kubeadmv1beta2.Discovery{
+			BootstrapToken: &kubeadmv1beta2.BootstrapTokenDiscovery{
+				Token:             bootstrapToken,
+				APIServerEndpoint: spec.InternalDNSName + ":6443",
+				CACertHashes:      spec.DiscoveryHashes,
+			},
+		},
+	}
+	if controlPlane {
+		joinConfig.ControlPlane = &kubeadmv1beta2.JoinControlPlane{}
+	}
+	return kubeadmConfigScript(apiVersion, joinConfig)
+}
+
+// kubeadmConfigScript writes the kubeadm configuration documents to /tmp/kubeadm-config.yaml, the
+// heredoc is quoted so extra args are written as they are
+func kubeadmConfigScript(apiVersion string, objects ...interface{}) (string, error) {
+	var documents []string
+	for _, object := range objects {
+		out, err := marshalKubeadmConfig(apiVersion, object)
+		if err != nil {
+			return "", err
+		}
+		documents = append(documents, string(out))
+	}
+	return fmt.Sprintf(`
+cat <<'EOF' >/tmp/kubeadm-config.yaml
+%sEOF
+`, strings.Join(documents, "---\n")), nil
+}
+
+// marshalKubeadmConfig marshals a v1beta2 object as the api version. The fields azk sets have the
+// same schema in v1beta1, v1beta2 and v1beta3, v1beta3 only dropped the dns type which v1beta2 always
+// marshals.
+func marshalKubeadmConfig(apiVersion string, object interface{}) ([]byte, error) {
+	out, err := sigsyaml.Marshal(object)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal kubeadm configuration: %v", err)
+	}
+	if apiVersion != kubeadmV1beta3 {
+		return out, nil
+	}
+	fields := map[string]interface{}{}
+	if err := sigsyaml.Unmarshal(out, &fields); err != nil {
+		return nil, fmt.Errorf("cannot convert kubeadm configuration to %s: %v", apiVersion, err)
+	}
+	if dns, ok := fields["dns"].(map[string]interface{}); ok {
+		delete(dns, "type")
+		if len(dns) == 0 {
+			delete(fields, "dns")
+		}
+	}
+	return sigsyaml.Marshal(fields)
+}
@@ -0,0 +1,150 @@
+package bootstrap
+
+import (
+	"strings"
+	"testing"
+
+	kubeadmv1beta2 "k8s.io/kubernetes/cmd/kubeadm/app/apis/kubeadm/v1beta2"
+	sigsyaml "sigs.k8s.io/yaml"
+)
+
+// kubeadmDocuments returns the yaml documents the kubeadm config script writes
+func kubeadmDocuments(t *testing.T, script string) []string {
+	start := strings.Index(script, "cat <<'EOF' >/tmp/kubeadm-config.yaml\n")
+	end := strings.LastIndex(script, "EOF\n")
+	if start < 0 || end < start {
+		t.Fatalf("Expected a quoted kubeadm config heredoc, got %s", script)
+	}
+	return strings.Split(script[start+len("cat <<'EOF' >/tmp/kubeadm-config.yaml\n"):end], "---\n")
+}
+
+func TestKubeadmAPIVersion(t *testing.T) {
+	for _, test := range []struct {
+		kubernetesVersion string
+		apiVersion        string
+	}{
+		{"1.14.6", kubeadmV1beta1},
+		{"1.15.3", kubeadmV1beta2},
+		{"v1.21.14", kubeadmV1beta2},
+		{"1.22.0", kubeadmV1beta3},
+		{"1.25.2", kubeadmV1beta3},
+	} {
+		apiVersion, err := KubeadmAPIVersion(test.kubernetesVersion)
+		if err != nil || apiVersion != test.apiVersion {
+			t.Errorf("Expected %s for kubernetes %s, got %s %v", test.apiVersion, test.kubernetesVersion, apiVersion, err)
+		}
+	}
+	if _, err := KubeadmAPIVersion("stable"); err == nil {
+		t.Errorf("Expected unparsable kubernetes version to fail")
+	}
+}
+
+func TestKubeadmInitConfig(t *testing.T) {
+	spec, _, _ := newFakeSpec(t)
+	spec.PublicDNSName = "azk.westus2.cloudapp.azure.com"
+	spec.KubeadmPatches = KubeadmPatches{
+		APIServer: ControlPlaneComponentPatch{
+			ExtraArgs:    map[string]string{"audit-log-path": "/var/log/kubernetes/audit.log"},
+			ExtraVolumes: []HostPathMount{{Name: "audit", HostPath: "/var/log/kubernetes", MountPath: "/var/log/kubernetes", PathType: "DirectoryOrCreate"}},
+		},
+		Scheduler:        ControlPlaneComponentPatch{ExtraArgs: map[string]string{"v": "2"}},
+		KubeletExtraArgs: map[string]string{"max-pods": "50"},
+	}
+
+	script, err := spec.kubeadmInitConfig("1.15.3")
+	if err != nil {
+		t.Fatalf("Failed to get kubeadm init config: %v", err)
+	}
+	documents := kubeadmDocuments(t, script)
+	if len(documents) != 2 {
+		t.Fatalf("Expected init and cluster configuration, got %d documents", len(documents))
+	}
+	initConfig := kubeadmv1beta2.InitConfiguration{}
+	if err := sigsyaml.UnmarshalStrict([]byte(documents[0]), &initConfig); err != nil {
+		t.Fatalf("Failed to unmarshal init configuration: %v", err)
+	}
+	if initConfig.APIVersion != kubeadmV1beta2 || initConfig.Kind != "InitConfiguration" {
+		t.Errorf("Unexpected init configuration %s %s", initConfig.APIVersion, initConfig.Kind)
+	}
+	if args := initConfig.NodeRegistration.KubeletExtraArgs; args["cloud-provider"] != "azure" || args["max-pods"] != "50" {
+		t.Errorf("Expected cloud provider and patched kubelet args, got %v", args)
+	}
+
+	clusterConfig := kubeadmv1beta2.ClusterConfiguration{}
+	if err := sigsyaml.UnmarshalStrict([]byte(documents[1]), &clusterConfig); err != nil {
+		t.Fatalf("Failed to unmarshal cluster configuration: %v", err)
+	}
+	if clusterConfig.ControlPlaneEndpoint != "azk0123abcd.internal:6443" || clusterConfig.KubernetesVersion != "1.15.3" ||
+		clusterConfig.Networking.PodSubnet != DefaultPodCIDR || clusterConfig.Networking.ServiceSubnet != DefaultServiceCIDR {
+		t.Errorf("Unexpected cluster configuration %+v", clusterConfig)
+	}
+	if sans := strings.Join(clusterConfig.APIServer.CertSANs, ","); !strings.Contains(sans, spec.PublicDNSName) {
+		t.Errorf("Expected the public dns name in the cert sans, got %s", sans)
+	}
+	apiServer := clusterConfig.APIServer.ControlPlaneComponent
+	if apiServer.ExtraArgs["cloud-config"] != cloudConfigPath || apiServer.ExtraArgs["audit-log-path"] == "" {
+		t.Errorf("Expected cloud provider and patched api server args, got %v", apiServer.ExtraArgs)
+	}
+	if len(apiServer.ExtraVolumes) != 2 || apiServer.ExtraVolumes[1].Name != "audit" || apiServer.ExtraVolumes[1].PathType != "DirectoryOrCreate" {
+		t.Errorf("Expected the cloud config and audit volume, got %+v", apiServer.ExtraVolumes)
+	}
+	if clusterConfig.Scheduler.ExtraArgs["v"] != "2" || len(clusterConfig.Scheduler.ExtraVolumes) != 0 {
+		t.Errorf("Expected only the patched scheduler args, got %+v", clusterConfig.Scheduler)
+	}
+	if clusterConfig.Etcd.Local == nil || clusterConfig.Etcd.Local.ImageRepository+"/etcd:"+clusterConfig.Etcd.Local.ImageTag != etcdImage {
+		t.Errorf("Expected local etcd %s, got %+v", etcdImage, clusterConfig.Etcd)
+	}
+
+	script, err = spec.kubeadmInitConfig("1.22.4")
+	if err != nil {
+		t.Fatalf("Failed to get kubeadm init config: %v", err)
+	}
+	if documents := kubeadmDocuments(t, script); !strings.Contains(documents[1], "apiVersion: "+kubeadmV1beta3) || strings.Contains(documents[1], "dns:") {
+		t.Errorf("Expected v1beta3 cluster configuration without the dns type, got %s", documents[1])
+	}
+}
+
+func TestKubeadmJoinConfig(t *testing.T) {
+	spec := &Spec{InternalDNSName: "azk0123abcd.internal", DiscoveryHashes: []string{"sha256:old", "sha256:new"}}
+	for _, controlPlane := range []bool{false, true} {
+		script, err := spec.KubeadmJoinConfig("1.15.3", "abcdef.0123456789abcdef", controlPlane)
+		if err != nil {
+			t.Fatalf("Failed to get kubeadm join config: %v", err)
+		}
+		joinConfig := kubeadmv1beta2.JoinConfiguration{}
+		if err := sigsyaml.UnmarshalStrict([]byte(kubeadmDocuments(t, script)[0]), &joinConfig); err != nil {
+			t.Fatalf("Failed to unmarshal join configuration: %v", err)
+		}
+		discovery := joinConfig.Discovery.BootstrapToken
+		if discovery == nil || discovery.Token != "abcdef.0123456789abcdef" || discovery.APIServerEndpoint != "azk0123abcd.internal:6443" ||
+			strings.Join(discovery.CACertHashes, ",") != "sha256:old,sha256:new" {
+			t.Errorf("Unexpected discovery %+v", discovery)
+		}
+		if (joinConfig.ControlPlane != nil) != controlPlane {
+			t.Errorf("Expected control plane %v, got %+v", controlPlane, joinConfig.ControlPlane)
+		}
+	}
+}
+
+func TestKubeadmPatchesValidate(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		patches KubeadmPatches
+		err     string
+	}{
+		{"empty", KubeadmPatches{}, ""},
+		{"cloud provider", KubeadmPatches{ControllerManager: ControlPlaneComponentPatch{ExtraArgs: map[string]string{"cloud-provider": "external"}}}, "managed by azk"},
+		{"kubelet cloud config", KubeadmPatches{KubeletExtraArgs: map[string]string{"cloud-config": "/etc/azure.json"}}, "managed by azk"},
+		{"cloud config volume", KubeadmPatches{APIServer: ControlPlaneComponentPatch{ExtraVolumes: []HostPathMount{{Name: "cloud-config", HostPath: "/etc", MountPath: "/etc"}}}}, "not unique"},
+		{"incomplete volume", KubeadmPatches{Scheduler: ControlPlaneComponentPatch{ExtraVolumes: []HostPathMount{{Name: "config"}}}}, "requires"},
+		{"path type", KubeadmPatches{Scheduler: ControlPlaneComponentPatch{ExtraVolumes: []HostPathMount{{Name: "config", HostPath: "/etc", MountPath: "/etc", PathType: "Dir"}}}}, "unknown path type"},
+	} {
+		err := test.patches.Validate()
+		if test.err == "" && err != nil {
+			t.Errorf("%s: expected valid patches, got %v", test.name, err)
+		}
+		if test.err != "" && (err == nil || !strings.Contains(err.Error(), test.err)) {
+			t.Errorf("%s: expected error containing %q, got %v", test.name, test.err, err)
+		}
+	}
+}
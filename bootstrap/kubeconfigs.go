@@ -1,7 +1,13 @@
 package bootstrap
 
 import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
 	kubeadmapi "k8s.io/kubernetes/cmd/kubeadm/app/apis/kubeadm"
+	kubeadmscheme "k8s.io/kubernetes/cmd/kubeadm/app/apis/kubeadm/scheme"
+	kubeadmv1beta1 "k8s.io/kubernetes/cmd/kubeadm/app/apis/kubeadm/v1beta1"
 	kubeadmconstants "k8s.io/kubernetes/cmd/kubeadm/app/constants"
 	kubeconfigphase "k8s.io/kubernetes/cmd/kubeadm/app/phases/kubeconfig"
 )
@@ -21,3 +27,51 @@ func CreateKubeconfigs(cfg *kubeadmapi.InitConfiguration, kubeConfigDir string)
 	}
 	return nil
 }
+
+// RenewCustomerKubeConfig replaces the customer kubeconfig with one holding a new admin client
+// certificate signed by the cluster CA, valid for another year, trusting the CA trust bundle
+func (spec *Spec) RenewCustomerKubeConfig() error {
+	if spec.CACertificate == "" || spec.CACertificateKey == "" {
+		return fmt.Errorf("cannot renew customer kubeconfig without the cluster CA")
+	}
+
+	tmpDirName := tmpDir + spec.ClusterName + "-renew"
+	defer os.RemoveAll(tmpDirName)
+	if err := os.MkdirAll(tmpDirName+"/certs", 0700); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(tmpDirName+"/certs/ca.crt", []byte(spec.CACertificate), 0600); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(tmpDirName+"/certs/ca.key", []byte(spec.CACertificateKey), 0600); err != nil {
+		return err
+	}
+
+	v1beta1cfg := &kubeadmv1beta1.InitConfiguration{}
+	kubeadmscheme.Scheme.Default(v1beta1cfg)
+	v1beta1cfg.CertificatesDir = tmpDirName + "/certs"
+	v1beta1cfg.LocalAPIEndpoint = kubeadmv1beta1.APIEndpoint{AdvertiseAddress: spec.Networking.FirstMasterIP(), BindPort: 6443}
+	v1beta1cfg.ControlPlaneEndpoint = fmt.Sprintf("%s:6443", spec.customerAPIServerHost())
+	v1beta1cfg.NodeRegistration.Name = "fakenode" + spec.ClusterName
+	cfg := &kubeadmapi.InitConfiguration{}
+	kubeadmscheme.Scheme.Default(cfg)
+	kubeadmscheme.Scheme.Convert(v1beta1cfg, cfg, nil)
+
+	kubeConfigDir := tmpDirName + "/kubeconfigs"
+	if err := kubeconfigphase.CreateKubeConfigFile(kubeadmconstants.AdminKubeConfigFileName, kubeConfigDir, cfg); err != nil {
+		return fmt.Errorf("cannot create customer kubeconfig: %v", err)
+	}
+	buf, err := ioutil.ReadFile(kubeConfigDir + "/" + kubeadmconstants.AdminKubeConfigFileName)
+	if err != nil {
+		return err
+	}
+	spec.CustomerKubeConfig = string(buf)
+	if bundle := spec.CATrustBundle(); bundle != spec.CACertificate {
+		kubeconfig, err := SetKubeConfigCA(spec.CustomerKubeConfig, bundle)
+		if err != nil {
+			return err
+		}
+		spec.CustomerKubeConfig = kubeconfig
+	}
+	return nil
+}
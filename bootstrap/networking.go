@@ -0,0 +1,279 @@
+package bootstrap
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	azhelpers "github.com/awesomenix/azk/azure"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	DefaultVNetCIDR               = "10.0.0.0/8"
+	DefaultMasterSubnetCIDR       = "10.0.0.0/16"
+	DefaultAgentSubnetCIDR        = "10.1.0.0/16"
+	DefaultInternalLoadBalancerIP = "10.0.0.100"
+	DefaultPodCIDR                = "10.244.0.0/16"
+	DefaultServiceCIDR            = "10.96.0.0/12"
+	DefaultDNSDomain              = "cluster.local"
+
+	// OutboundTypeLoadBalancer egresses through the public load balancer of the api server
+	OutboundTypeLoadBalancer = "loadBalancer"
+	// OutboundTypeNATGateway egresses through a nat gateway associated with the subnets of azk-vnet
+	OutboundTypeNATGateway = "natGateway"
+	// OutboundTypeUserDefinedRouting egresses through the routes of an existing vnet, e.g. to a firewall
+	OutboundTypeUserDefinedRouting = "userDefinedRouting"
+)
+
+// Networking is the network topology of a cluster, empty fields use the defaults clusters were
+// created with before the topology was configurable
+type Networking struct {
+	// VNetCIDR is the address space of azk-vnet
+	VNetCIDR string `json:"vnetCIDR,omitempty"`
+	// MasterSubnetCIDR and AgentSubnetCIDR are the subnets of masters and nodes within VNetCIDR
+	MasterSubnetCIDR string `json:"masterSubnetCIDR,omitempty"`
+	AgentSubnetCIDR  string `json:"agentSubnetCIDR,omitempty"`
+	// InternalLoadBalancerIP is the static frontend address of the internal api server endpoint, within MasterSubnetCIDR
+	InternalLoadBalancerIP string `json:"internalLoadBalancerIP,omitempty"`
+	// PodCIDR and ServiceCIDR are the kubernetes pod and service networks, outside of the subnets
+	// unless pods take addresses of the subnets with azure-cni
+	PodCIDR     string `json:"podCIDR,omitempty"`
+	ServiceCIDR string `json:"serviceCIDR,omitempty"`
+	// DNSDomain is the kubernetes cluster domain
+	DNSDomain string `json:"dnsDomain,omitempty"`
+	// NetworkPlugin is the CNI plugin pods are networked with, one of canal, calico, flannel,
+	// kube-router, azure-cni or none
+	NetworkPlugin string `json:"networkPlugin,omitempty"`
+
+	// MasterSubnetID and AgentSubnetID are existing subnets the cluster is deployed into instead of
+	// azk-vnet, both must belong to the same vnet which may be in another resource group
+	MasterSubnetID string `json:"masterSubnetID,omitempty"`
+	AgentSubnetID  string `json:"agentSubnetID,omitempty"`
+	// AgentSecurityGroupID and AgentRouteTableID are associated with the existing agent subnet, the
+	// azure cloud provider manages the rules and routes of services in them
+	AgentSecurityGroupID string `json:"agentSecurityGroupID,omitempty"`
+	AgentRouteTableID    string `json:"agentRouteTableID,omitempty"`
+
+	// PrivateCluster creates no public load balancer, the api server is only reachable through the
+	// internal load balancer
+	PrivateCluster bool `json:"privateCluster,omitempty"`
+	// OutboundType is how masters and nodes reach the internet, private clusters default to a nat
+	// gateway in azk-vnet and to user defined routing in an existing vnet
+	OutboundType string `json:"outboundType,omitempty"`
+}
+
+// ExistingVNet returns true if the cluster is deployed into existing subnets
+func (n Networking) ExistingVNet() bool {
+	return n.MasterSubnetID != "" || n.AgentSubnetID != ""
+}
+
+// WithDefaults returns the topology with every empty field defaulted. Existing subnets keep the
+// address space of their vnet, their internal load balancer defaults to the last usable address
+// of the master subnet. The pods of azure-cni default to the smallest network holding both subnets.
+func (n Networking) WithDefaults() Networking {
+	if n.NetworkPlugin == "" {
+		n.NetworkPlugin = DefaultNetworkPlugin
+	}
+	podCIDRUnset := n.PodCIDR == ""
+	defaults := []struct {
+		value        *string
+		defaultValue string
+	}{
+		{&n.VNetCIDR, DefaultVNetCIDR},
+		{&n.MasterSubnetCIDR, DefaultMasterSubnetCIDR},
+		{&n.AgentSubnetCIDR, DefaultAgentSubnetCIDR},
+		{&n.InternalLoadBalancerIP, DefaultInternalLoadBalancerIP},
+		{&n.PodCIDR, DefaultPodCIDR},
+		{&n.ServiceCIDR, DefaultServiceCIDR},
+		{&n.DNSDomain, DefaultDNSDomain},
+	}
+	if n.ExistingVNet() {
+		defaults = defaults[4:]
+		if _, master, err := net.ParseCIDR(n.MasterSubnetCIDR); err == nil && n.InternalLoadBalancerIP == "" {
+			ones, bits := master.Mask.Size()
+			n.InternalLoadBalancerIP = uint32ToIP(ipToUint32(master.IP) + 1<<uint(bits-ones) - 2).String()
+		}
+	}
+	for _, field := range defaults {
+		if *field.value == "" {
+			*field.value = field.defaultValue
+		}
+	}
+	if podCIDRUnset && n.NetworkPlugin == NetworkPluginAzureCNI {
+		n.PodCIDR = supernet(n.MasterSubnetCIDR, n.AgentSubnetCIDR)
+	}
+	if n.OutboundType == "" {
+		switch {
+		case !n.PrivateCluster:
+			n.OutboundType = OutboundTypeLoadBalancer
+		case n.ExistingVNet():
+			n.OutboundType = OutboundTypeUserDefinedRouting
+		default:
+			n.OutboundType = OutboundTypeNATGateway
+		}
+	}
+	return n
+}
+
+// Validate checks the defaulted topology: every network is an IPv4 CIDR, the subnets lie within the
+// vnet without overlapping, the internal load balancer address is usable in the master subnet, the
+// service network overlaps neither the subnets nor the pod network, and the pod network suits the
+// network plugin. The pod and service networks may lie within the vnet address space as they are
+// never routed by azure.
+func (n Networking) Validate() error {
+	n = n.WithDefaults()
+
+	if n.ExistingVNet() {
+		if err := n.validateExistingVNet(); err != nil {
+			return err
+		}
+	}
+
+	networks := map[string]*net.IPNet{}
+	for _, field := range []struct {
+		name  string
+		value string
+	}{
+		{"vnet CIDR", n.VNetCIDR},
+		{"master subnet CIDR", n.MasterSubnetCIDR},
+		{"agent subnet CIDR", n.AgentSubnetCIDR},
+		{"pod CIDR", n.PodCIDR},
+		{"service CIDR", n.ServiceCIDR},
+	} {
+		if field.name == "vnet CIDR" && n.ExistingVNet() {
+			continue
+		}
+		ip, ipNet, err := net.ParseCIDR(field.value)
+		if err != nil || ip.To4() == nil {
+			return fmt.Errorf("%s %q is not an IPv4 CIDR", field.name, field.value)
+		}
+		if !ip.Equal(ipNet.IP) {
+			return fmt.Errorf("%s %q has host bits set, use %s", field.name, field.value, ipNet)
+		}
+		networks[field.name] = ipNet
+	}
+
+	// azure keeps the subnets of an existing vnet within its address space
+	if vnet, ok := networks["vnet CIDR"]; ok {
+		for _, subnet := range []string{"master subnet CIDR", "agent subnet CIDR"} {
+			if !containsCIDR(vnet, networks[subnet]) {
+				return fmt.Errorf("%s %s is not within vnet CIDR %s", subnet, networks[subnet], vnet)
+			}
+		}
+	}
+	for _, pair := range [][2]string{
+		{"master subnet CIDR", "agent subnet CIDR"},
+		{"service CIDR", "master subnet CIDR"},
+		{"service CIDR", "agent subnet CIDR"},
+		{"pod CIDR", "service CIDR"},
+	} {
+		if overlaps(networks[pair[0]], networks[pair[1]]) {
+			return fmt.Errorf("%s %s overlaps %s %s", pair[0], networks[pair[0]], pair[1], networks[pair[1]])
+		}
+	}
+
+	if err := n.validatePodCIDR(networks); err != nil {
+		return err
+	}
+	if ones, _ := networks["service CIDR"].Mask.Size(); ones < 12 {
+		return fmt.Errorf("service CIDR %s is larger than the /12 the api server allows", networks["service CIDR"])
+	}
+
+	ilbIP := net.ParseIP(n.InternalLoadBalancerIP).To4()
+	master := networks["master subnet CIDR"]
+	if ilbIP == nil {
+		return fmt.Errorf("internal load balancer IP %q is not an IPv4 address", n.InternalLoadBalancerIP)
+	}
+	if !master.Contains(ilbIP) {
+		return fmt.Errorf("internal load balancer IP %s is not within master subnet CIDR %s", ilbIP, master)
+	}
+	// azure reserves the first four and the last address of every subnet
+	offset := ipToUint32(ilbIP) - ipToUint32(master.IP)
+	ones, bits := master.Mask.Size()
+	if offset < 4 || offset == 1<<uint(bits-ones)-1 {
+		return fmt.Errorf("internal load balancer IP %s is reserved by azure in master subnet CIDR %s", ilbIP, master)
+	}
+
+	if errs := validation.IsDNS1123Subdomain(n.DNSDomain); len(errs) > 0 {
+		return fmt.Errorf("DNS domain %q is invalid: %s", n.DNSDomain, strings.Join(errs, ", "))
+	}
+	return n.validateOutboundType()
+}
+
+// validateOutboundType checks the egress of the cluster exists: private clusters have no public
+// load balancer, the nat gateway is only associated with azk-vnet and user defined routes are only
+// managed in existing vnets
+func (n Networking) validateOutboundType() error {
+	switch n.OutboundType {
+	case OutboundTypeLoadBalancer:
+		if n.PrivateCluster {
+			return fmt.Errorf("outbound type %s requires the public load balancer a private cluster does not have", n.OutboundType)
+		}
+	case OutboundTypeNATGateway:
+		if n.ExistingVNet() {
+			return fmt.Errorf("outbound type %s is not supported in an existing vnet, use %s", n.OutboundType, OutboundTypeUserDefinedRouting)
+		}
+	case OutboundTypeUserDefinedRouting:
+		if !n.ExistingVNet() {
+			return fmt.Errorf("outbound type %s requires an existing vnet", n.OutboundType)
+		}
+	default:
+		return fmt.Errorf("outbound type %q is not one of %s, %s or %s", n.OutboundType, OutboundTypeLoadBalancer, OutboundTypeNATGateway, OutboundTypeUserDefinedRouting)
+	}
+	return nil
+}
+
+// validateExistingVNet checks the existing subnets belong to the same vnet, and were resolved by
+// ResolveExistingSubnets
+func (n Networking) validateExistingVNet() error {
+	master, err := azhelpers.ParseSubnetID(n.MasterSubnetID)
+	if err != nil {
+		return fmt.Errorf("invalid master subnet: %v", err)
+	}
+	agent, err := azhelpers.ParseSubnetID(n.AgentSubnetID)
+	if err != nil {
+		return fmt.Errorf("invalid agent subnet: %v", err)
+	}
+	if !strings.EqualFold(master.VNetID(), agent.VNetID()) {
+		return fmt.Errorf("master subnet %s and agent subnet %s are not in the same vnet", n.MasterSubnetID, n.AgentSubnetID)
+	}
+	if master.Name == agent.Name {
+		return fmt.Errorf("master and agent subnet must differ, both are %s", n.MasterSubnetID)
+	}
+	if n.VNetCIDR != "" {
+		return fmt.Errorf("vnet CIDR %s cannot be set for an existing vnet", n.VNetCIDR)
+	}
+	if n.MasterSubnetCIDR == "" || n.AgentSubnetCIDR == "" || n.AgentSecurityGroupID == "" || n.AgentRouteTableID == "" {
+		return fmt.Errorf("existing subnets %s and %s are not resolved", n.MasterSubnetID, n.AgentSubnetID)
+	}
+	return nil
+}
+
+// FirstMasterIP is the address azure assigns the first machine of the master subnet
+func (n Networking) FirstMasterIP() string {
+	_, master, err := net.ParseCIDR(n.WithDefaults().MasterSubnetCIDR)
+	if err != nil {
+		return ""
+	}
+	return uint32ToIP(ipToUint32(master.IP) + 4).String()
+}
+
+func containsCIDR(outer, inner *net.IPNet) bool {
+	outerOnes, _ := outer.Mask.Size()
+	innerOnes, _ := inner.Mask.Size()
+	return outerOnes <= innerOnes && outer.Contains(inner.IP)
+}
+
+func overlaps(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+func ipToUint32(ip net.IP) uint32 {
+	ip = ip.To4()
+	return uint32(ip[0])<<24 | uint32(ip[1])<<16 | uint32(ip[2])<<8 | uint32(ip[3])
+}
+
+func uint32ToIP(v uint32) net.IP {
+	return net.IPv4(byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
@@ -0,0 +1,114 @@
+package bootstrap
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNetworkingValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		networking Networking
+		err        string
+	}{
+		{"defaults", Networking{}, ""},
+		{"custom", Networking{
+			VNetCIDR:               "172.16.0.0/16",
+			MasterSubnetCIDR:       "172.16.0.0/24",
+			AgentSubnetCIDR:        "172.16.16.0/20",
+			InternalLoadBalancerIP: "172.16.0.250",
+			PodCIDR:                "192.168.0.0/16",
+			ServiceCIDR:            "10.96.0.0/16",
+			DNSDomain:              "azk.local",
+		}, ""},
+		{"not a cidr", Networking{PodCIDR: "10.244.0.0"}, "not an IPv4 CIDR"},
+		{"host bits", Networking{ServiceCIDR: "10.96.0.1/12"}, "host bits"},
+		{"subnet outside vnet", Networking{AgentSubnetCIDR: "11.1.0.0/16"}, "not within vnet"},
+		{"overlapping subnets", Networking{AgentSubnetCIDR: "10.0.128.0/17"}, "overlaps"},
+		{"pod cidr in agent subnet", Networking{PodCIDR: "10.1.0.0/16"}, "overlaps"},
+		{"service cidr in master subnet", Networking{ServiceCIDR: "10.0.0.0/12"}, "overlaps"},
+		{"pod cidr too small", Networking{PodCIDR: "192.168.0.0/25"}, "smaller than the /24"},
+		{"service cidr too large", Networking{ServiceCIDR: "172.0.0.0/11"}, "larger than the /12"},
+		{"load balancer outside master subnet", Networking{InternalLoadBalancerIP: "10.1.0.100"}, "not within master subnet"},
+		{"reserved load balancer ip", Networking{InternalLoadBalancerIP: "10.0.0.3"}, "reserved"},
+		{"broadcast load balancer ip", Networking{InternalLoadBalancerIP: "10.0.255.255"}, "reserved"},
+		{"dns domain", Networking{DNSDomain: "Cluster_Local"}, "DNS domain"},
+		{"unknown network plugin", Networking{NetworkPlugin: "weave"}, "not one of"},
+		{"azure-cni", Networking{NetworkPlugin: NetworkPluginAzureCNI}, ""},
+		{"azure-cni pod cidr outside subnets", Networking{NetworkPlugin: NetworkPluginAzureCNI, PodCIDR: DefaultPodCIDR}, "does not hold"},
+		{"existing vnet", Networking{
+			MasterSubnetID:       hubVNetID + "/subnets/masters",
+			AgentSubnetID:        hubVNetID + "/subnets/nodes",
+			MasterSubnetCIDR:     "172.16.0.0/24",
+			AgentSubnetCIDR:      "172.16.16.0/20",
+			AgentSecurityGroupID: "nsg",
+			AgentRouteTableID:    "routetable",
+		}, ""},
+		{"unresolved existing vnet", Networking{
+			MasterSubnetID: hubVNetID + "/subnets/masters",
+			AgentSubnetID:  hubVNetID + "/subnets/nodes",
+		}, "not resolved"},
+		{"existing subnets of different vnets", Networking{
+			MasterSubnetID: hubVNetID + "/subnets/masters",
+			AgentSubnetID:  "/subscriptions/subscription/resourceGroups/network/providers/Microsoft.Network/virtualNetworks/spoke/subnets/nodes",
+		}, "not in the same vnet"},
+		{"vnet cidr of existing vnet", Networking{
+			MasterSubnetID: hubVNetID + "/subnets/masters",
+			AgentSubnetID:  hubVNetID + "/subnets/nodes",
+			VNetCIDR:       "172.16.0.0/16",
+		}, "cannot be set"},
+		{"private cluster", Networking{PrivateCluster: true}, ""},
+		{"nat gateway of public cluster", Networking{OutboundType: OutboundTypeNATGateway}, ""},
+		{"private cluster egress through load balancer", Networking{PrivateCluster: true, OutboundType: OutboundTypeLoadBalancer}, "public load balancer"},
+		{"user defined routing of azk-vnet", Networking{PrivateCluster: true, OutboundType: OutboundTypeUserDefinedRouting}, "requires an existing vnet"},
+		{"nat gateway of existing vnet", Networking{
+			MasterSubnetID:       hubVNetID + "/subnets/masters",
+			AgentSubnetID:        hubVNetID + "/subnets/nodes",
+			MasterSubnetCIDR:     "172.16.0.0/24",
+			AgentSubnetCIDR:      "172.16.16.0/20",
+			AgentSecurityGroupID: "nsg",
+			AgentRouteTableID:    "routetable",
+			PrivateCluster:       true,
+			OutboundType:         OutboundTypeNATGateway,
+		}, "not supported in an existing vnet"},
+		{"unknown outbound type", Networking{OutboundType: "proxy"}, "not one of"},
+	}
+	for _, test := range tests {
+		err := test.networking.Validate()
+		if test.err == "" && err != nil {
+			t.Errorf("%s: expected valid networking, got %v", test.name, err)
+		}
+		if test.err != "" && (err == nil || !strings.Contains(err.Error(), test.err)) {
+			t.Errorf("%s: expected error containing %q, got %v", test.name, test.err, err)
+		}
+	}
+}
+
+func TestNetworkingOutboundTypeDefaults(t *testing.T) {
+	existing := Networking{MasterSubnetID: hubVNetID + "/subnets/masters", AgentSubnetID: hubVNetID + "/subnets/nodes"}
+	for _, test := range []struct {
+		networking   Networking
+		outboundType string
+	}{
+		{Networking{}, OutboundTypeLoadBalancer},
+		{Networking{PrivateCluster: true}, OutboundTypeNATGateway},
+		{existing, OutboundTypeLoadBalancer},
+	} {
+		if outboundType := test.networking.WithDefaults().OutboundType; outboundType != test.outboundType {
+			t.Errorf("Expected outbound type %s for %+v, got %s", test.outboundType, test.networking, outboundType)
+		}
+	}
+	existing.PrivateCluster = true
+	if outboundType := existing.WithDefaults().OutboundType; outboundType != OutboundTypeUserDefinedRouting {
+		t.Errorf("Expected private cluster in an existing vnet to default to %s, got %s", OutboundTypeUserDefinedRouting, outboundType)
+	}
+}
+
+func TestNetworkingFirstMasterIP(t *testing.T) {
+	if ip := (Networking{}).FirstMasterIP(); ip != "10.0.0.4" {
+		t.Errorf("Expected default first master IP 10.0.0.4, got %s", ip)
+	}
+	if ip := (Networking{MasterSubnetCIDR: "172.16.1.0/24"}).FirstMasterIP(); ip != "172.16.1.4" {
+		t.Errorf("Expected first master IP 172.16.1.4, got %s", ip)
+	}
+}
@@ -0,0 +1,194 @@
+package bootstrap
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/awesomenix/azk/cniassets"
+	kubeadmv1beta2 "k8s.io/kubernetes/cmd/kubeadm/app/apis/kubeadm/v1beta2"
+)
+
+const (
+	NetworkPluginCanal      = "canal"
+	NetworkPluginCalico     = "calico"
+	NetworkPluginFlannel    = "flannel"
+	NetworkPluginKubeRouter = "kube-router"
+	NetworkPluginAzureCNI   = "azure-cni"
+	// NetworkPluginNone installs no network plugin, nodes stay NotReady until one is applied
+	NetworkPluginNone = "none"
+
+	DefaultNetworkPlugin = NetworkPluginCanal
+
+	// AzureCNIMaxPods is the number of pods of a master or node with azure-cni, every pod takes
+	// a secondary ip configuration of its network interface
+	AzureCNIMaxPods = 30
+
+	azureCNIVersion     = "v1.0.27"
+	azureCNIConfPath    = "/etc/cni/net.d/10-azure.conflist"
+	azureCNIInstallPath = "/etc/kubernetes/install-azure-cni.sh"
+)
+
+// azureCNIChecksums pins the sha256 of the azure-cni release archive of a version, the binaries are
+// only installed from an archive matching it. hack/azure-cni-checksum.sh prints the checksum of a
+// version, pin it when changing azureCNIVersion.
+var azureCNIChecksums = map[string]string{}
+
+// networkPlugin is a vendored manifest of a network plugin in the cniassets
+type networkPlugin struct {
+	manifest string
+	// podCIDR is the pod network of the manifest, replaced by the pod CIDR of the cluster
+	podCIDR string
+}
+
+// networkPlugins are the plugins applied once the first master initialized the cluster, azure-cni is
+// installed on every master and node instead
+var networkPlugins = map[string]networkPlugin{
+	NetworkPluginCanal:      {manifest: "/canal/v3.8.2/canal.yaml", podCIDR: "10.244.0.0/16"},
+	NetworkPluginCalico:     {manifest: "/calico/v3.8.2/calico.yaml", podCIDR: "192.168.0.0/16"},
+	NetworkPluginFlannel:    {manifest: "/flannel/v0.12.0/kube-flannel.yml", podCIDR: "10.244.0.0/16"},
+	NetworkPluginKubeRouter: {manifest: "/kube-router/v1.0.1/kubeadm-kuberouter.yaml"},
+	NetworkPluginAzureCNI:   {},
+	NetworkPluginNone:       {},
+}
+
+// validatePodCIDR checks the pod network suits the network plugin. Pods of azure-cni take addresses
+// of the master and agent subnet, the pod network must hold both for kube-proxy to tell pod traffic
+// apart. Every other plugin networks pods outside the subnets, from the /24 each node is assigned.
+func (n Networking) validatePodCIDR(networks map[string]*net.IPNet) error {
+	if _, ok := networkPlugins[n.NetworkPlugin]; !ok {
+		return fmt.Errorf("network plugin %q is not one of %s", n.NetworkPlugin, strings.Join(NetworkPlugins(), ", "))
+	}
+	pods := networks["pod CIDR"]
+	for _, subnet := range []string{"master subnet CIDR", "agent subnet CIDR"} {
+		switch {
+		case n.NetworkPlugin == NetworkPluginAzureCNI && !containsCIDR(pods, networks[subnet]):
+			return fmt.Errorf("pod CIDR %s does not hold %s %s the pods of %s take addresses of", pods, subnet, networks[subnet], n.NetworkPlugin)
+		case n.NetworkPlugin != NetworkPluginAzureCNI && overlaps(pods, networks[subnet]):
+			return fmt.Errorf("pod CIDR %s overlaps %s %s", pods, subnet, networks[subnet])
+		}
+	}
+	if ones, _ := pods.Mask.Size(); n.NetworkPlugin != NetworkPluginAzureCNI && ones > 24 {
+		return fmt.Errorf("pod CIDR %s is smaller than the /24 every node is assigned", pods)
+	}
+	return nil
+}
+
+// NetworkPlugins returns the supported network plugins
+func NetworkPlugins() []string {
+	return []string{NetworkPluginCanal, NetworkPluginCalico, NetworkPluginFlannel, NetworkPluginKubeRouter, NetworkPluginAzureCNI, NetworkPluginNone}
+}
+
+// IPConfigurations returns the number of ip configurations of the network interface of every master
+// and node
+func (n Networking) IPConfigurations() int {
+	if n.WithDefaults().NetworkPlugin == NetworkPluginAzureCNI {
+		return AzureCNIMaxPods + 1
+	}
+	return 1
+}
+
+// networkPluginScript applies the manifest of the network plugin with the pod CIDR of the cluster
+func (spec *Spec) networkPluginScript() (string, error) {
+	networking := spec.Networking.WithDefaults()
+	plugin := networkPlugins[networking.NetworkPlugin]
+	if plugin.manifest == "" {
+		return "", nil
+	}
+	manifest, err := readCNIAsset(plugin.manifest)
+	if err != nil {
+		return "", err
+	}
+	if plugin.podCIDR != "" {
+		manifest = strings.Replace(manifest, plugin.podCIDR, networking.PodCIDR, -1)
+	}
+	return fmt.Sprintf(`
+sudo kubectl --kubeconfig /etc/kubernetes/admin.conf apply -f - <<'EOF'
+%sEOF
+`, manifest), nil
+}
+
+// networkPluginFiles returns the files and scripts cloud-init writes on every master and node to
+// install azure-cni, other plugins are installed by their manifest
+func (spec *Spec) networkPluginFiles() (files map[string]string, scripts map[string]string, err error) {
+	files, scripts = map[string]string{}, map[string]string{}
+	if spec.Networking.WithDefaults().NetworkPlugin != NetworkPluginAzureCNI {
+		return files, scripts, nil
+	}
+	checksum := azureCNIChecksums[azureCNIVersion]
+	if checksum == "" {
+		return nil, nil, fmt.Errorf("azure-cni %s has no pinned checksum, run hack/azure-cni-checksum.sh %s", azureCNIVersion, azureCNIVersion)
+	}
+	conf, err := readCNIAsset(fmt.Sprintf("/azure-cni/%s/10-azure.conflist", azureCNIVersion))
+	if err != nil {
+		return nil, nil, err
+	}
+	files[azureCNIConfPath] = conf
+	scripts[azureCNIInstallPath] = fmt.Sprintf(`
+set -eux
+sudo mkdir -p /opt/cni/bin
+until curl -fsSL -o /tmp/azure-cni.tgz https://github.com/Azure/azure-container-networking/releases/download/%[1]s/azure-vnet-cni-linux-amd64-%[1]s.tgz &&
+	echo '%[2]s  /tmp/azure-cni.tgz' | sha256sum -c -; do
+	sleep 30
+done
+sudo tar -xzf /tmp/azure-cni.tgz -C /opt/cni/bin azure-vnet azure-vnet-ipam
+rm -f /tmp/azure-cni.tgz
+`, azureCNIVersion, checksum)
+	return files, scripts, nil
+}
+
+// networkPluginControllerManager stops the controller manager from assigning pod networks and routes
+// to nodes with azure-cni, pods take addresses of the subnets instead
+func (spec *Spec) networkPluginControllerManager(component kubeadmv1beta2.ControlPlaneComponent) kubeadmv1beta2.ControlPlaneComponent {
+	if spec.Networking.WithDefaults().NetworkPlugin == NetworkPluginAzureCNI {
+		component.ExtraArgs["allocate-node-cidrs"] = "false"
+		component.ExtraArgs["configure-cloud-routes"] = "false"
+	}
+	return component
+}
+
+// networkPluginKubeletArgs limits the pods of a master or node to its secondary ip configurations
+// with azure-cni
+func (spec *Spec) networkPluginKubeletArgs(args map[string]string) {
+	if spec.Networking.WithDefaults().NetworkPlugin == NetworkPluginAzureCNI {
+		args["max-pods"] = strconv.Itoa(AzureCNIMaxPods)
+	}
+}
+
+func readCNIAsset(name string) (string, error) {
+	f, err := cniassets.Manifests.Open(name)
+	if err != nil {
+		return "", fmt.Errorf("cannot open network plugin manifest %s: %v", name, err)
+	}
+	defer f.Close()
+	buf, err := ioutil.ReadAll(f)
+	if err != nil {
+		return "", fmt.Errorf("cannot read network plugin manifest %s: %v", name, err)
+	}
+	return string(buf), nil
+}
+
+// supernet returns the smallest network holding both networks, or an empty string if either is
+// not a CIDR
+func supernet(a, b string) string {
+	_, netA, errA := net.ParseCIDR(a)
+	_, netB, errB := net.ParseCIDR(b)
+	if errA != nil || errB != nil || netA.IP.To4() == nil || netB.IP.To4() == nil {
+		return ""
+	}
+	onesA, _ := netA.Mask.Size()
+	onesB, _ := netB.Mask.Size()
+	ones := onesA
+	if onesB < ones {
+		ones = onesB
+	}
+	for ; ones > 0; ones-- {
+		mask := net.CIDRMask(ones, 32)
+		if netA.IP.Mask(mask).Equal(netB.IP.Mask(mask)) {
+			break
+		}
+	}
+	return (&net.IPNet{IP: netA.IP.Mask(net.CIDRMask(ones, 32)), Mask: net.CIDRMask(ones, 32)}).String()
+}
@@ -0,0 +1,83 @@
+package bootstrap
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNetworkPluginScript(t *testing.T) {
+	for _, test := range []struct {
+		plugin   string
+		expected string
+	}{
+		{NetworkPluginCanal, `"Network": "172.30.0.0/16"`},
+		{NetworkPluginCalico, `value: "172.30.0.0/16"`},
+		{NetworkPluginFlannel, `"Network": "172.30.0.0/16"`},
+		{NetworkPluginKubeRouter, "name: kube-router"},
+		{NetworkPluginAzureCNI, ""},
+		{NetworkPluginNone, ""},
+	} {
+		spec := &Spec{Networking: Networking{NetworkPlugin: test.plugin, PodCIDR: "172.30.0.0/16"}}
+		script, err := spec.networkPluginScript()
+		if err != nil {
+			t.Fatalf("Failed to get %s script: %v", test.plugin, err)
+		}
+		if test.expected == "" {
+			if script != "" {
+				t.Errorf("Expected %s to apply no manifest, got %s", test.plugin, script)
+			}
+			continue
+		}
+		if !strings.HasPrefix(script, "\nsudo kubectl --kubeconfig /etc/kubernetes/admin.conf apply -f - <<'EOF'\n") || !strings.Contains(script, test.expected) {
+			t.Errorf("Expected %s manifest containing %s, got %s", test.plugin, test.expected, script)
+		}
+		if strings.Contains(script, "10.244.0.0/16") || strings.Contains(script, "192.168.0.0/16") {
+			t.Errorf("Expected the pod network of the %s manifest to be replaced", test.plugin)
+		}
+	}
+}
+
+func TestAzureCNI(t *testing.T) {
+	spec, _, _ := newFakeSpec(t)
+	spec.Networking = Networking{NetworkPlugin: NetworkPluginAzureCNI}
+	if _, err := spec.NodeCloudConfig("echo join"); err == nil {
+		t.Errorf("Expected azure-cni without a pinned checksum to be refused")
+	}
+	checksum := strings.Repeat("0", 64)
+	azureCNIChecksums[azureCNIVersion] = checksum
+	defer delete(azureCNIChecksums, azureCNIVersion)
+	if podCIDR := spec.Networking.WithDefaults().PodCIDR; podCIDR != "10.0.0.0/15" {
+		t.Errorf("Expected the pods to default to the master and agent subnets, got %s", podCIDR)
+	}
+	if ipConfigurations := spec.Networking.IPConfigurations(); ipConfigurations != AzureCNIMaxPods+1 {
+		t.Errorf("Expected a secondary ip configuration per pod, got %d", ipConfigurations)
+	}
+
+	config, err := spec.NodeCloudConfig("echo join")
+	if err != nil {
+		t.Fatalf("Failed to get node cloud config: %v", err)
+	}
+	paths := map[string]bool{}
+	for _, file := range config.WriteFiles {
+		paths[file.Path] = true
+		if file.Path == azureCNIInstallPath && !strings.Contains(file.Content, "echo '"+checksum+"  /tmp/azure-cni.tgz' | sha256sum -c -") {
+			t.Errorf("Expected the azure-cni archive to be verified before it is installed, got %s", file.Content)
+		}
+	}
+	if !paths[azureCNIConfPath] || !paths[azureCNIInstallPath] {
+		t.Errorf("Expected azure-cni to be installed, got %v", paths)
+	}
+	if len(config.RunCmd) != 2 || config.RunCmd[0][1] != azureCNIInstallPath || config.RunCmd[1][1] != StartupScriptPath {
+		t.Errorf("Expected azure-cni to be installed before the startup script, got %v", config.RunCmd)
+	}
+
+	script, err := spec.kubeadmInitConfig("1.15.3")
+	if err != nil {
+		t.Fatalf("Failed to get kubeadm init config: %v", err)
+	}
+	for _, expected := range []string{`allocate-node-cidrs: "false"`, `configure-cloud-routes: "false"`, `max-pods: "30"`, "podSubnet: 10.0.0.0/15"} {
+		if !strings.Contains(script, expected) {
+			t.Errorf("Expected kubeadm config to contain %s, got %s", expected, script)
+		}
+	}
+}
@@ -0,0 +1,330 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-02-01/network"
+	"github.com/Azure/go-autorest/autorest/to"
+	azhelpers "github.com/awesomenix/azk/azure"
+)
+
+const (
+	sshRuleName       = "allow_ssh"
+	apiServerRuleName = "allow_6443"
+	// the azure cloud provider adds the rules of services to the agent security group starting at
+	// this priority, azk owns the rules below it
+	cloudProviderMinimumRulePriority = 500
+)
+
+// NetworkSecurity is the access to the subnets of azk-vnet, the cluster controller reconciles the
+// master security group to exactly these rules and the agent security group to its custom rules,
+// keeping the service rules of the azure cloud provider
+type NetworkSecurity struct {
+	// APIServerAuthorizedIPRanges are the sources allowed to reach the api server, any source when empty
+	APIServerAuthorizedIPRanges []string `json:"apiServerAuthorizedIPRanges,omitempty"`
+	// SSHAuthorizedIPRanges are the sources allowed to ssh into masters, any source when empty
+	SSHAuthorizedIPRanges []string `json:"sshAuthorizedIPRanges,omitempty"`
+	// DisableSSH removes the ssh rule, masters stay reachable from within the vnet
+	DisableSSH bool `json:"disableSSH,omitempty"`
+	// MasterRules and AgentRules are additional rules of the master and agent subnet
+	MasterRules []SecurityRule `json:"masterRules,omitempty"`
+	AgentRules  []SecurityRule `json:"agentRules,omitempty"`
+}
+
+// SecurityRule is a custom rule of a subnet security group
+type SecurityRule struct {
+	// Name is unique within the security group
+	Name string `json:"name"`
+	// Priority is between 100 and 4096, agent rules must be below 500
+	Priority int32 `json:"priority"`
+	// Direction is Inbound or Outbound, defaults to Inbound
+	Direction string `json:"direction,omitempty"`
+	// Access is Allow or Deny, defaults to Allow
+	Access string `json:"access,omitempty"`
+	// Protocol is Tcp, Udp or *, defaults to Tcp
+	Protocol string `json:"protocol,omitempty"`
+	// SourceAddressPrefixes are CIDRs or service tags, any source when empty
+	SourceAddressPrefixes []string `json:"sourceAddressPrefixes,omitempty"`
+	// DestinationPortRange is a port, a range such as 30000-32767, or *
+	DestinationPortRange string `json:"destinationPortRange"`
+}
+
+// DeepCopyInto copies the rules and ranges, they are not shared with the copy
+func (in *NetworkSecurity) DeepCopyInto(out *NetworkSecurity) {
+	*out = *in
+	out.APIServerAuthorizedIPRanges = append([]string(nil), in.APIServerAuthorizedIPRanges...)
+	out.SSHAuthorizedIPRanges = append([]string(nil), in.SSHAuthorizedIPRanges...)
+	out.MasterRules = copySecurityRules(in.MasterRules)
+	out.AgentRules = copySecurityRules(in.AgentRules)
+}
+
+func copySecurityRules(in []SecurityRule) []SecurityRule {
+	if in == nil {
+		return nil
+	}
+	out := make([]SecurityRule, len(in))
+	for i, rule := range in {
+		out[i] = rule
+		out[i].SourceAddressPrefixes = append([]string(nil), rule.SourceAddressPrefixes...)
+	}
+	return out
+}
+
+// IsZero returns true if the default rules of azk are used
+func (s NetworkSecurity) IsZero() bool {
+	return len(s.APIServerAuthorizedIPRanges) == 0 && len(s.SSHAuthorizedIPRanges) == 0 && !s.DisableSSH &&
+		len(s.MasterRules) == 0 && len(s.AgentRules) == 0
+}
+
+// Validate checks the authorized ranges are IPv4 CIDRs and the custom rules are complete with
+// unique names and priorities. The security groups of an existing vnet belong to its owner and
+// cannot be configured.
+func (s NetworkSecurity) Validate(networking Networking) error {
+	if networking.ExistingVNet() && !s.IsZero() {
+		return fmt.Errorf("security rules cannot be set for an existing vnet, its security groups are managed by their owner")
+	}
+	for _, ranges := range []struct {
+		name   string
+		values []string
+	}{
+		{"api server authorized IP range", s.APIServerAuthorizedIPRanges},
+		{"ssh authorized IP range", s.SSHAuthorizedIPRanges},
+	} {
+		for _, value := range ranges.values {
+			if ip, _, err := net.ParseCIDR(value); err != nil || ip.To4() == nil {
+				return fmt.Errorf("%s %q is not an IPv4 CIDR", ranges.name, value)
+			}
+		}
+	}
+	if s.DisableSSH && len(s.SSHAuthorizedIPRanges) > 0 {
+		return fmt.Errorf("ssh authorized IP ranges cannot be set with ssh disabled")
+	}
+
+	masterRules, agentRules := s.masterRules(), s.agentRules()
+	for _, group := range []struct {
+		nsgName     string
+		rules       []network.SecurityRule
+		maxPriority int32
+	}{
+		{azkMasterNSGName, masterRules, 4096},
+		{azkNSGName, agentRules, cloudProviderMinimumRulePriority - 1},
+	} {
+		names, priorities := map[string]bool{}, map[string]bool{}
+		for _, rule := range group.rules {
+			name := strings.ToLower(*rule.Name)
+			priority := fmt.Sprintf("%s/%d", rule.Direction, *rule.Priority)
+			switch {
+			case *rule.Name == "":
+				return fmt.Errorf("rule of %s has no name", group.nsgName)
+			case names[name]:
+				return fmt.Errorf("rule %s of %s is not unique", *rule.Name, group.nsgName)
+			case *rule.Priority < 100 || *rule.Priority > group.maxPriority:
+				return fmt.Errorf("rule %s of %s has priority %d, outside of 100 to %d", *rule.Name, group.nsgName, *rule.Priority, group.maxPriority)
+			case priorities[priority]:
+				return fmt.Errorf("rule %s of %s has the priority %d of another %s rule", *rule.Name, group.nsgName, *rule.Priority, rule.Direction)
+			case *rule.DestinationPortRange == "":
+				return fmt.Errorf("rule %s of %s has no destination port range", *rule.Name, group.nsgName)
+			}
+			names[name], priorities[priority] = true, true
+			if err := validateSecurityRuleEnums(rule); err != nil {
+				return fmt.Errorf("rule %s of %s %v", *rule.Name, group.nsgName, err)
+			}
+		}
+	}
+	return nil
+}
+
+func validateSecurityRuleEnums(rule network.SecurityRule) error {
+	switch {
+	case rule.Direction != network.SecurityRuleDirectionInbound && rule.Direction != network.SecurityRuleDirectionOutbound:
+		return fmt.Errorf("direction %q is not Inbound or Outbound", rule.Direction)
+	case rule.Access != network.SecurityRuleAccessAllow && rule.Access != network.SecurityRuleAccessDeny:
+		return fmt.Errorf("access %q is not Allow or Deny", rule.Access)
+	case rule.Protocol != network.SecurityRuleProtocolTCP && rule.Protocol != network.SecurityRuleProtocolUDP && rule.Protocol != network.SecurityRuleProtocolAsterisk:
+		return fmt.Errorf("protocol %q is not Tcp, Udp or *", rule.Protocol)
+	}
+	return nil
+}
+
+// masterRules are the rules of the master security group, ssh and the api server followed by the
+// custom master rules
+func (s NetworkSecurity) masterRules() []network.SecurityRule {
+	rules := []network.SecurityRule{}
+	if !s.DisableSSH {
+		rules = append(rules, securityRule(SecurityRule{Name: sshRuleName, Priority: 100, SourceAddressPrefixes: s.SSHAuthorizedIPRanges, DestinationPortRange: "22"}))
+	}
+	rules = append(rules, securityRule(SecurityRule{Name: apiServerRuleName, Priority: 101, SourceAddressPrefixes: s.APIServerAuthorizedIPRanges, DestinationPortRange: "6443"}))
+	for _, rule := range s.MasterRules {
+		rules = append(rules, securityRule(rule))
+	}
+	return rules
+}
+
+// agentRules are the custom rules of the agent security group
+func (s NetworkSecurity) agentRules() []network.SecurityRule {
+	rules := []network.SecurityRule{}
+	for _, rule := range s.AgentRules {
+		rules = append(rules, securityRule(rule))
+	}
+	return rules
+}
+
+// securityRule converts a custom rule to an azure rule with the defaults applied
+func securityRule(rule SecurityRule) network.SecurityRule {
+	r := network.SecurityRule{
+		Name: to.StringPtr(rule.Name),
+		SecurityRulePropertiesFormat: &network.SecurityRulePropertiesFormat{
+			Protocol:                 network.SecurityRuleProtocol(rule.Protocol),
+			SourcePortRange:          to.StringPtr("*"),
+			DestinationAddressPrefix: to.StringPtr("*"),
+			DestinationPortRange:     to.StringPtr(rule.DestinationPortRange),
+			Access:                   network.SecurityRuleAccess(rule.Access),
+			Direction:                network.SecurityRuleDirection(rule.Direction),
+			Priority:                 to.Int32Ptr(rule.Priority),
+		},
+	}
+	if r.Protocol == "" {
+		r.Protocol = network.SecurityRuleProtocolTCP
+	}
+	if r.Access == "" {
+		r.Access = network.SecurityRuleAccessAllow
+	}
+	if r.Direction == "" {
+		r.Direction = network.SecurityRuleDirectionInbound
+	}
+	switch len(rule.SourceAddressPrefixes) {
+	case 0:
+		r.SourceAddressPrefix = to.StringPtr("*")
+	case 1:
+		r.SourceAddressPrefix = to.StringPtr(rule.SourceAddressPrefixes[0])
+	default:
+		r.SourceAddressPrefixes = &rule.SourceAddressPrefixes
+	}
+	return r
+}
+
+// sourceAddressPrefixes returns the sorted sources of a rule, azure keeps a single source in
+// SourceAddressPrefix and several in SourceAddressPrefixes
+func sourceAddressPrefixes(rule network.SecurityRule) []string {
+	var prefixes []string
+	if rule.SourceAddressPrefix != nil && *rule.SourceAddressPrefix != "" {
+		prefixes = append(prefixes, *rule.SourceAddressPrefix)
+	}
+	if rule.SourceAddressPrefixes != nil {
+		prefixes = append(prefixes, *rule.SourceAddressPrefixes...)
+	}
+	sort.Strings(prefixes)
+	return prefixes
+}
+
+// equalSecurityRules returns true if the rules match in every property azk sets
+func equalSecurityRules(a, b network.SecurityRule) bool {
+	if a.SecurityRulePropertiesFormat == nil || b.SecurityRulePropertiesFormat == nil {
+		return false
+	}
+	return strings.EqualFold(to.String(a.Name), to.String(b.Name)) &&
+		to.Int32(a.Priority) == to.Int32(b.Priority) &&
+		strings.EqualFold(string(a.Direction), string(b.Direction)) &&
+		strings.EqualFold(string(a.Access), string(b.Access)) &&
+		strings.EqualFold(string(a.Protocol), string(b.Protocol)) &&
+		to.String(a.SourcePortRange) == to.String(b.SourcePortRange) &&
+		to.String(a.DestinationAddressPrefix) == to.String(b.DestinationAddressPrefix) &&
+		to.String(a.DestinationPortRange) == to.String(b.DestinationPortRange) &&
+		strings.Join(sourceAddressPrefixes(a), ",") == strings.Join(sourceAddressPrefixes(b), ",")
+}
+
+// securityGroupRules returns the expected rules of an azk security group, rules for which owned
+// returns false are kept as they are
+func (spec *Spec) securityGroupRules(nsgName string) ([]network.SecurityRule, func(network.SecurityRule) bool) {
+	if nsgName == azkMasterNSGName {
+		return spec.NetworkSecurity.masterRules(), func(network.SecurityRule) bool { return true }
+	}
+	return spec.NetworkSecurity.agentRules(), func(rule network.SecurityRule) bool {
+		return rule.SecurityRulePropertiesFormat != nil && to.Int32(rule.Priority) < cloudProviderMinimumRulePriority
+	}
+}
+
+// securityRulesDrift describes how the owned rules of the security group differ from the expected rules
+func securityRulesDrift(nsg network.SecurityGroup, expected []network.SecurityRule, owned func(network.SecurityRule) bool) []string {
+	var existing []network.SecurityRule
+	if nsg.SecurityGroupPropertiesFormat != nil && nsg.SecurityRules != nil {
+		existing = *nsg.SecurityRules
+	}
+	var drift []string
+	for _, e := range expected {
+		found := false
+		for _, rule := range existing {
+			if strings.EqualFold(to.String(rule.Name), *e.Name) {
+				found = true
+				if !equalSecurityRules(rule, e) {
+					drift = append(drift, fmt.Sprintf("security rule %s modified", *e.Name))
+				}
+			}
+		}
+		if !found {
+			drift = append(drift, fmt.Sprintf("security rule %s missing", *e.Name))
+		}
+	}
+	for _, rule := range existing {
+		if !owned(rule) {
+			continue
+		}
+		found := false
+		for _, e := range expected {
+			if strings.EqualFold(to.String(rule.Name), *e.Name) {
+				found = true
+			}
+		}
+		if !found {
+			drift = append(drift, fmt.Sprintf("unexpected security rule %s", to.String(rule.Name)))
+		}
+	}
+	return drift
+}
+
+func (spec *Spec) checkNetworkSecurityGroup(nsgName string) func(context.Context, azhelpers.Provider) error {
+	return func(ctx context.Context, provider azhelpers.Provider) error {
+		nsg, err := provider.GetNetworkSecurityGroup(ctx, nsgName)
+		if err != nil {
+			return err
+		}
+		expected, owned := spec.securityGroupRules(nsgName)
+		if drift := securityRulesDrift(nsg, expected, owned); len(drift) > 0 {
+			return driftf("%s", strings.Join(drift, ", "))
+		}
+		return nil
+	}
+}
+
+// reconcileNetworkSecurityGroups sets the rules of the azk security groups to the expected rules,
+// the rules of the azure cloud provider in the agent security group are kept
+func (spec *Spec) reconcileNetworkSecurityGroups(ctx context.Context, provider azhelpers.Provider) error {
+	for _, nsgName := range []string{azkMasterNSGName, azkNSGName} {
+		nsg, err := provider.GetNetworkSecurityGroup(ctx, nsgName)
+		if err != nil {
+			return err
+		}
+		expected, owned := spec.securityGroupRules(nsgName)
+		drift := securityRulesDrift(nsg, expected, owned)
+		if len(drift) == 0 {
+			continue
+		}
+		rules := expected
+		if nsg.SecurityGroupPropertiesFormat != nil && nsg.SecurityRules != nil {
+			for _, rule := range *nsg.SecurityRules {
+				if !owned(rule) {
+					rules = append(rules, rule)
+				}
+			}
+		}
+		log.Info("Updating", "NetworkSecurityGroup", nsgName, "Drift", strings.Join(drift, ", "))
+		if err := provider.UpdateNetworkSecurityGroupRules(ctx, nsgName, rules); err != nil {
+			return err
+		}
+	}
+	return nil
+}
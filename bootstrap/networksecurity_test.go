@@ -0,0 +1,121 @@
+package bootstrap
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-02-01/network"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+func TestNetworkSecurityValidate(t *testing.T) {
+	existing := Networking{MasterSubnetID: hubVNetID + "/subnets/masters", AgentSubnetID: hubVNetID + "/subnets/nodes"}
+	for _, test := range []struct {
+		name       string
+		security   NetworkSecurity
+		networking Networking
+		err        string
+	}{
+		{"defaults", NetworkSecurity{}, Networking{}, ""},
+		{"defaults of existing vnet", NetworkSecurity{}, existing, ""},
+		{"custom", NetworkSecurity{
+			APIServerAuthorizedIPRanges: []string{"203.0.113.0/24", "198.51.100.7/32"},
+			DisableSSH:                  true,
+			MasterRules:                 []SecurityRule{{Name: "allow_node_exporter", Priority: 200, SourceAddressPrefixes: []string{"VirtualNetwork"}, DestinationPortRange: "9100"}},
+			AgentRules:                  []SecurityRule{{Name: "allow_nodeports", Priority: 100, DestinationPortRange: "30000-32767"}},
+		}, Networking{}, ""},
+		{"existing vnet", NetworkSecurity{DisableSSH: true}, existing, "existing vnet"},
+		{"not a cidr", NetworkSecurity{APIServerAuthorizedIPRanges: []string{"203.0.113.7"}}, Networking{}, "not an IPv4 CIDR"},
+		{"ssh ranges with ssh disabled", NetworkSecurity{DisableSSH: true, SSHAuthorizedIPRanges: []string{"203.0.113.0/24"}}, Networking{}, "ssh disabled"},
+		{"default rule name", NetworkSecurity{MasterRules: []SecurityRule{{Name: "Allow_SSH", Priority: 200, DestinationPortRange: "2222"}}}, Networking{}, "not unique"},
+		{"default rule priority", NetworkSecurity{MasterRules: []SecurityRule{{Name: "allow_https", Priority: 101, DestinationPortRange: "443"}}}, Networking{}, "priority 101"},
+		{"cloud provider priority", NetworkSecurity{AgentRules: []SecurityRule{{Name: "allow_http", Priority: 500, DestinationPortRange: "80"}}}, Networking{}, "outside of 100 to 499"},
+		{"no port", NetworkSecurity{AgentRules: []SecurityRule{{Name: "allow_all", Priority: 100}}}, Networking{}, "no destination port range"},
+		{"unknown protocol", NetworkSecurity{AgentRules: []SecurityRule{{Name: "allow_icmp", Priority: 100, Protocol: "Icmp", DestinationPortRange: "*"}}}, Networking{}, "protocol"},
+	} {
+		err := test.security.Validate(test.networking)
+		if test.err == "" && err != nil {
+			t.Errorf("%s: expected valid security rules, got %v", test.name, err)
+		}
+		if test.err != "" && (err == nil || !strings.Contains(err.Error(), test.err)) {
+			t.Errorf("%s: expected error containing %q, got %v", test.name, test.err, err)
+		}
+	}
+}
+
+func TestReconcileNetworkSecurityGroups(t *testing.T) {
+	ctx := context.Background()
+	spec, _, provider := newFakeSpec(t)
+	spec.NetworkSecurity = NetworkSecurity{
+		APIServerAuthorizedIPRanges: []string{"203.0.113.0/24"},
+		DisableSSH:                  true,
+		AgentRules:                  []SecurityRule{{Name: "allow_nodeports", Priority: 100, DestinationPortRange: "30000-32767"}},
+	}
+	if err := spec.CreateBaseInfrastructure(provider); err != nil {
+		t.Fatalf("Failed to create base infrastructure: %v", err)
+	}
+
+	masterNSG, err := provider.GetNetworkSecurityGroup(ctx, azkMasterNSGName)
+	if err != nil {
+		t.Fatalf("Failed to get master nsg: %v", err)
+	}
+	if rules := *masterNSG.SecurityRules; len(rules) != 1 || *rules[0].Name != apiServerRuleName || *rules[0].SourceAddressPrefix != "203.0.113.0/24" {
+		t.Fatalf("Expected only the api server rule from the authorized range, got %+v", rules)
+	}
+
+	// a service rule of the cloud provider is kept, manual changes are drift
+	agentNSG, err := provider.GetNetworkSecurityGroup(ctx, azkNSGName)
+	if err != nil {
+		t.Fatalf("Failed to get agent nsg: %v", err)
+	}
+	serviceRule := securityRule(SecurityRule{Name: "a0123456789-TCP-80-Internet", Priority: 500, DestinationPortRange: "80"})
+	if err := provider.UpdateNetworkSecurityGroupRules(ctx, azkNSGName, append(*agentNSG.SecurityRules, serviceRule)); err != nil {
+		t.Fatalf("Failed to add service rule: %v", err)
+	}
+	opened := append([]network.SecurityRule{}, *masterNSG.SecurityRules...)
+	opened[0].SourceAddressPrefix = to.StringPtr("*")
+	opened = append(opened, securityRule(SecurityRule{Name: "allow_rdp", Priority: 300, DestinationPortRange: "3389"}))
+	if err := provider.UpdateNetworkSecurityGroupRules(ctx, azkMasterNSGName, opened); err != nil {
+		t.Fatalf("Failed to open master nsg: %v", err)
+	}
+
+	states, err := spec.CheckBaseInfrastructure(ctx, provider)
+	if err != nil {
+		t.Fatalf("Failed to check base infrastructure: %v", err)
+	}
+	if names := notReady(states); len(names) != 1 || names[0] != azkMasterNSGName {
+		t.Fatalf("Expected only %s to drift, got %v", azkMasterNSGName, names)
+	}
+	for _, state := range states {
+		if state.Name == azkMasterNSGName {
+			if drift := state.Err.Error(); !strings.Contains(drift, "allow_6443 modified") || !strings.Contains(drift, "unexpected security rule allow_rdp") {
+				t.Fatalf("Expected the modified and unexpected rules as drift, got %s", drift)
+			}
+		}
+	}
+
+	if err := spec.CreateBaseInfrastructure(provider); err != nil {
+		t.Fatalf("Failed to repair base infrastructure: %v", err)
+	}
+	states, err = spec.CheckBaseInfrastructure(ctx, provider)
+	if err != nil {
+		t.Fatalf("Failed to check base infrastructure: %v", err)
+	}
+	if names := notReady(states); len(names) > 0 {
+		t.Fatalf("Expected all resources repaired, got drift in %v", names)
+	}
+	agentNSG, err = provider.GetNetworkSecurityGroup(ctx, azkNSGName)
+	if err != nil {
+		t.Fatalf("Failed to get agent nsg: %v", err)
+	}
+	found := false
+	for _, rule := range *agentNSG.SecurityRules {
+		if equalSecurityRules(rule, serviceRule) {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("Expected the service rule of the cloud provider to be kept, got %+v", *agentNSG.SecurityRules)
+	}
+}
@@ -0,0 +1,126 @@
+package bootstrap
+
+import (
+	"crypto"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"time"
+
+	certutil "k8s.io/client-go/util/cert"
+	"k8s.io/client-go/util/keyutil"
+	"k8s.io/kubernetes/cmd/kubeadm/app/util/pubkeypin"
+)
+
+// suppliedCA is a CA which can be supplied instead of generated, by its base name in the kubeadm certificates dir
+type suppliedCA struct {
+	baseName    string
+	certificate *string
+	key         *string
+}
+
+func (spec *Spec) suppliedCAs() []suppliedCA {
+	return []suppliedCA{
+		{"ca", &spec.CACertificate, &spec.CACertificateKey},
+		{"front-proxy-ca", &spec.FrontProxyCACertificate, &spec.FrontProxyCACertificateKey},
+		{"etcd/ca", &spec.EtcdCACertificate, &spec.EtcdCACertificateKey},
+	}
+}
+
+// ValidateCA checks a supplied PEM encoded CA, the certificate may be followed by the chain up to its
+// root. The certificate must be a currently valid CA allowed to sign certificates, the key must belong
+// to it and the chain, if present, must verify.
+func ValidateCA(certPEM, keyPEM string) error {
+	certs, err := certutil.ParseCertsPEM([]byte(certPEM))
+	if err != nil {
+		return fmt.Errorf("cannot parse CA certificate: %v", err)
+	}
+	ca := certs[0]
+	if !ca.IsCA {
+		return fmt.Errorf("certificate %s is not a CA", ca.Subject.CommonName)
+	}
+	if ca.KeyUsage != 0 && ca.KeyUsage&x509.KeyUsageCertSign == 0 {
+		return fmt.Errorf("CA %s is not allowed to sign certificates", ca.Subject.CommonName)
+	}
+	now := time.Now()
+	if now.Before(ca.NotBefore) || now.After(ca.NotAfter) {
+		return fmt.Errorf("CA %s is only valid from %s to %s", ca.Subject.CommonName, ca.NotBefore.Format(time.RFC3339), ca.NotAfter.Format(time.RFC3339))
+	}
+
+	key, err := keyutil.ParsePrivateKeyPEM([]byte(keyPEM))
+	if err != nil {
+		return fmt.Errorf("cannot parse key of CA %s: %v", ca.Subject.CommonName, err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok || !reflect.DeepEqual(signer.Public(), ca.PublicKey) {
+		return fmt.Errorf("key does not belong to CA %s", ca.Subject.CommonName)
+	}
+
+	if len(certs) == 1 {
+		return nil
+	}
+	// the last certificate is the root the chain is verified against
+	roots := x509.NewCertPool()
+	roots.AddCert(certs[len(certs)-1])
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1 : len(certs)-1] {
+		intermediates.AddCert(cert)
+	}
+	if _, err := ca.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}); err != nil {
+		return fmt.Errorf("cannot verify chain of CA %s: %v", ca.Subject.CommonName, err)
+	}
+	return nil
+}
+
+// ValidateSuppliedCAs validates every CA supplied on the spec, a certificate requires its key
+func (spec *Spec) ValidateSuppliedCAs() error {
+	for _, ca := range spec.suppliedCAs() {
+		if *ca.certificate == "" && *ca.key == "" {
+			continue
+		}
+		if *ca.certificate == "" || *ca.key == "" {
+			return fmt.Errorf("%s requires both certificate and key", ca.baseName)
+		}
+		if err := ValidateCA(*ca.certificate, *ca.key); err != nil {
+			return fmt.Errorf("invalid %s: %v", ca.baseName, err)
+		}
+	}
+	return nil
+}
+
+// writeSuppliedCAs writes the CAs supplied on the spec to the kubeadm certificates dir, kubeadm issues
+// the leaf certificates from existing CAs instead of generating them
+func (spec *Spec) writeSuppliedCAs(certsDir string) error {
+	for _, ca := range spec.suppliedCAs() {
+		if *ca.certificate == "" {
+			continue
+		}
+		path := filepath.Join(certsDir, ca.baseName)
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(path+".crt", []byte(*ca.certificate), 0600); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(path+".key", []byte(*ca.key), 0600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CADiscoveryHash returns the discovery hash of the cluster CA, the first certificate of CACertificate
+func (spec *Spec) CADiscoveryHash() (string, error) {
+	certs, err := certutil.ParseCertsPEM([]byte(spec.CACertificate))
+	if err != nil {
+		return "", fmt.Errorf("cannot parse CA certificate: %v", err)
+	}
+	return pubkeypin.Hash(certs[0]), nil
+}
@@ -0,0 +1,143 @@
+package bootstrap
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	certutil "k8s.io/client-go/util/cert"
+	"k8s.io/client-go/util/keyutil"
+	kubeadmapi "k8s.io/kubernetes/cmd/kubeadm/app/apis/kubeadm"
+	kubeadmscheme "k8s.io/kubernetes/cmd/kubeadm/app/apis/kubeadm/scheme"
+	kubeadmv1beta1 "k8s.io/kubernetes/cmd/kubeadm/app/apis/kubeadm/v1beta1"
+	"k8s.io/kubernetes/cmd/kubeadm/app/util/pkiutil"
+)
+
+// newIntermediateCA issues an intermediate CA from a new root, returning the intermediate followed by
+// the root and the key of the intermediate
+func newIntermediateCA(t *testing.T) (string, string) {
+	rootPEM, rootKeyPEM, err := NewCertificateAuthority()
+	if err != nil {
+		t.Fatalf("Failed to create root CA: %v", err)
+	}
+	root, err := certutil.ParseCertsPEM([]byte(rootPEM))
+	if err != nil {
+		t.Fatalf("Failed to parse root CA: %v", err)
+	}
+	rootKey, err := keyutil.ParsePrivateKeyPEM([]byte(rootKeyPEM))
+	if err != nil {
+		t.Fatalf("Failed to parse root CA key: %v", err)
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to create intermediate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(2),
+		Subject:               pkix.Name{CommonName: "intermediate"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, root[0], &key.PublicKey, rootKey)
+	if err != nil {
+		t.Fatalf("Failed to issue intermediate CA: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("Failed to parse intermediate CA: %v", err)
+	}
+	keyPEM, err := keyutil.MarshalPrivateKeyToPEM(key)
+	if err != nil {
+		t.Fatalf("Failed to encode intermediate key: %v", err)
+	}
+	return string(pkiutil.EncodeCertPEM(cert)) + rootPEM, string(keyPEM)
+}
+
+func TestValidateCA(t *testing.T) {
+	caCert, caKey, err := NewCertificateAuthority()
+	if err != nil {
+		t.Fatalf("Failed to create CA: %v", err)
+	}
+	if err := ValidateCA(caCert, caKey); err != nil {
+		t.Errorf("Expected a generated CA to be valid: %v", err)
+	}
+
+	intermediateCert, intermediateKey := newIntermediateCA(t)
+	if err := ValidateCA(intermediateCert, intermediateKey); err != nil {
+		t.Errorf("Expected an intermediate CA with its chain to be valid: %v", err)
+	}
+
+	if err := ValidateCA(caCert, intermediateKey); err == nil || !strings.Contains(err.Error(), "does not belong") {
+		t.Errorf("Expected a foreign key to be rejected, got %v", err)
+	}
+	intermediateOnly := strings.SplitAfter(intermediateCert, "-----END CERTIFICATE-----\n")[0]
+	if err := ValidateCA(intermediateOnly+caCert, intermediateKey); err == nil || !strings.Contains(err.Error(), "cannot verify chain") {
+		t.Errorf("Expected a chain to a different root to be rejected, got %v", err)
+	}
+
+	spec := &Spec{CACertificate: caCert}
+	if err := spec.ValidateSuppliedCAs(); err == nil {
+		t.Errorf("Expected a CA without key to be rejected")
+	}
+}
+
+func TestSuppliedCAsIssueLeafCertificates(t *testing.T) {
+	certsDir, err := ioutil.TempDir("", "azk-pki")
+	if err != nil {
+		t.Fatalf("Failed to create certificates dir: %v", err)
+	}
+	defer os.RemoveAll(certsDir)
+
+	caCert, caKey := newIntermediateCA(t)
+	spec := &Spec{CACertificate: caCert, CACertificateKey: caKey}
+	if err := spec.writeSuppliedCAs(certsDir); err != nil {
+		t.Fatalf("Failed to write supplied CAs: %v", err)
+	}
+
+	v1beta1cfg := &kubeadmv1beta1.InitConfiguration{}
+	kubeadmscheme.Scheme.Default(v1beta1cfg)
+	v1beta1cfg.CertificatesDir = certsDir
+	v1beta1cfg.Etcd.Local = &kubeadmv1beta1.LocalEtcd{}
+	v1beta1cfg.LocalAPIEndpoint = kubeadmv1beta1.APIEndpoint{AdvertiseAddress: "10.0.0.4", BindPort: 6443}
+	v1beta1cfg.NodeRegistration.Name = "fakenode"
+	cfg := &kubeadmapi.InitConfiguration{}
+	kubeadmscheme.Scheme.Default(cfg)
+	kubeadmscheme.Scheme.Convert(v1beta1cfg, cfg, nil)
+	if err := CreatePKISACertificates(cfg); err != nil {
+		t.Fatalf("Failed to create certificates: %v", err)
+	}
+
+	ca, err := pkiutil.TryLoadCertFromDisk(certsDir, "ca")
+	if err != nil {
+		t.Fatalf("Failed to load CA: %v", err)
+	}
+	if ca.Subject.CommonName != "intermediate" {
+		t.Errorf("Expected the supplied CA to be kept, got %s", ca.Subject.CommonName)
+	}
+	apiserver, err := pkiutil.TryLoadCertFromDisk(certsDir, "apiserver")
+	if err != nil {
+		t.Fatalf("Failed to load apiserver certificate: %v", err)
+	}
+	if err := apiserver.CheckSignatureFrom(ca); err != nil {
+		t.Errorf("Expected the apiserver certificate to be issued by the supplied CA: %v", err)
+	}
+
+	hash, err := spec.CADiscoveryHash()
+	if err != nil {
+		t.Fatalf("Failed to compute discovery hash: %v", err)
+	}
+	if !strings.HasPrefix(hash, "sha256:") {
+		t.Errorf("Expected a sha256 discovery hash, got %s", hash)
+	}
+}
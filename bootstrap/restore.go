@@ -0,0 +1,65 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+
+	azhelpers "github.com/awesomenix/azk/azure"
+)
+
+// GetRestoreStartupScript returns the startup script of a first master that restores the etcd
+// snapshot at snapshotURL as a new single member cluster, before kubeadm init starts etcd on it.
+// The cluster add-ons are part of the snapshot and are not applied again.
+func (spec *Spec) GetRestoreStartupScript(kubernetesVersion, snapshotURL string) (string, error) {
+	kubeadmConfig, err := spec.kubeadmInitConfig(kubernetesVersion)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`
+set -eux
+%[1]s
+%[2]s
+until curl -fsSL -o /tmp/etcd-snapshot.db '%[3]s'; do
+	sleep 30
+done
+NODE_NAME=$(hostname | tr '[:upper:]' '[:lower:]')
+NODE_IP=$(hostname -I | awk '{print $1}')
+sudo rm -rf /var/lib/etcd
+sudo docker run --rm -v /tmp/etcd-snapshot.db:/etcd-snapshot.db -v /var/lib:/var/lib --entrypoint /usr/local/bin/etcdctl %[4]s \
+	snapshot restore /etcd-snapshot.db \
+	--name ${NODE_NAME} \
+	--data-dir /var/lib/etcd \
+	--initial-cluster ${NODE_NAME}=https://${NODE_IP}:2380 \
+	--initial-advertise-peer-urls https://${NODE_IP}:2380
+rm -f /tmp/etcd-snapshot.db
+until sudo kubeadm init --config /tmp/kubeadm-config.yaml --ignore-preflight-errors=DirAvailable--var-lib-etcd > /dev/null; do
+	sleep 30
+done
+mkdir -p $HOME/.kube
+sudo cp -f /etc/kubernetes/admin.conf $HOME/.kube/config
+sudo chown $(id -u):$(id -g) $HOME/.kube/config
+`, kubeadmConfig,
+		spec.preRequisites(kubernetesVersion),
+		snapshotURL,
+		etcdImage), nil
+}
+
+// RestoreInfrastructure replaces the master scale set with a single master restoring the etcd
+// snapshot, the control plane controller joins the remaining masters once the cluster is back
+func (spec *Spec) RestoreInfrastructure(provider azhelpers.Provider, kubernetesVersion, snapshotURL string) error {
+	if _, err := provider.GetVMSS(context.TODO(), masterVmssName); err == nil {
+		log.Info("Deleting", "VMSS", masterVmssName)
+		if err := provider.DeleteVMSS(context.TODO(), masterVmssName); err != nil {
+			return fmt.Errorf("cannot delete %s: %v", masterVmssName, err)
+		}
+		log.Info("Successfully Deleted", "VMSS", masterVmssName)
+	} else if !azhelpers.ResourceNotFound(err) {
+		return err
+	}
+
+	startupScript, err := spec.GetRestoreStartupScript(kubernetesVersion, snapshotURL)
+	if err != nil {
+		return err
+	}
+	return spec.createMasterVMSS(provider, startupScript)
+}
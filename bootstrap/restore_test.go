@@ -0,0 +1,44 @@
+package bootstrap
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/awesomenix/azk/azure/fake"
+)
+
+func TestRestoreInfrastructure(t *testing.T) {
+	spec, cloud, provider := newFakeSpec(t)
+	spec.BootstrapKubernetesVersion = "1.15.3"
+	if err := spec.Bootstrap(provider); err != nil {
+		t.Fatalf("Failed to bootstrap: %v", err)
+	}
+
+	snapshotURL := "https://azkbackups.blob.core.windows.net/etcd/snapshot.db?sv=2019-02-02&sig=secret"
+	if err := spec.RestoreInfrastructure(provider, "1.15.3", snapshotURL); err != nil {
+		t.Fatalf("Failed to restore: %v", err)
+	}
+
+	var names []string
+	for _, op := range cloud.Operations() {
+		if op.Resource == masterVmssName && op.Status == fake.OperationSucceeded {
+			names = append(names, op.Name)
+		}
+	}
+	if strings.Join(names, ",") != "CreateVMSS,DeleteVMSS,CreateVMSS" {
+		t.Fatalf("Expected the master scale set to be recreated, got %v", names)
+	}
+
+	script, err := spec.GetRestoreStartupScript("1.15.3", snapshotURL)
+	if err != nil {
+		t.Fatalf("Failed to get restore startup script: %v", err)
+	}
+	for _, expected := range []string{"'" + snapshotURL + "'", "snapshot restore", "--ignore-preflight-errors=DirAvailable--var-lib-etcd"} {
+		if !strings.Contains(script, expected) {
+			t.Errorf("Expected restore script to contain %s", expected)
+		}
+	}
+	if strings.Contains(script, "kubectl --kubeconfig /etc/kubernetes/admin.conf apply") {
+		t.Errorf("Expected restore script not to reapply add-ons kept in the snapshot")
+	}
+}
@@ -0,0 +1,144 @@
+package bootstrap
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	credentialsSecretSuffix  = "-credentials"
+	certificatesSecretSuffix = "-ca"
+	kubeconfigSecretSuffix   = "-kubeconfig"
+)
+
+func (spec *Spec) credentialsFields() map[string]*string {
+	return map[string]*string{
+		"clientSecret":              &spec.ClientSecret,
+		"clientCertificate":         &spec.ClientCertificate,
+		"clientCertificatePassword": &spec.ClientCertificatePassword,
+		"azure.json":                &spec.AzureCloudProviderConfig,
+	}
+}
+
+func (spec *Spec) certificatesFields() map[string]*string {
+	return map[string]*string{
+		"ca.crt":             &spec.CACertificate,
+		"ca.key":             &spec.CACertificateKey,
+		"sa.key":             &spec.ServiceAccountKey,
+		"sa.pub":             &spec.ServiceAccountPub,
+		"front-proxy-ca.crt": &spec.FrontProxyCACertificate,
+		"front-proxy-ca.key": &spec.FrontProxyCACertificateKey,
+		"etcd-ca.crt":        &spec.EtcdCACertificate,
+		"etcd-ca.key":        &spec.EtcdCACertificateKey,
+		"next-ca.crt":        &spec.NextCACertificate,
+		"next-ca.key":        &spec.NextCACertificateKey,
+		"previous-ca.crt":    &spec.PreviousCACertificate,
+	}
+}
+
+func (spec *Spec) kubeconfigFields() map[string]*string {
+	return map[string]*string{
+		"admin.conf":    &spec.AdminKubeConfig,
+		"customer.conf": &spec.CustomerKubeConfig,
+	}
+}
+
+func secretData(fields map[string]*string) map[string][]byte {
+	data := map[string][]byte{}
+	for key, value := range fields {
+		if *value != "" {
+			data[key] = []byte(*value)
+		}
+	}
+	return data
+}
+
+func loadSecretData(fields map[string]*string, data map[string][]byte) {
+	for key, value := range fields {
+		if buf, ok := data[key]; ok {
+			*value = string(buf)
+		}
+	}
+}
+
+// CredentialsSecretName is the Secret holding azure credentials and cloud provider config
+func CredentialsSecretName(clusterName string) string {
+	return clusterName + credentialsSecretSuffix
+}
+
+// CertificatesSecretName is the Secret holding the cluster CA bundle
+func CertificatesSecretName(clusterName string) string {
+	return clusterName + certificatesSecretSuffix
+}
+
+// KubeconfigSecretName is the Secret holding the admin and customer kubeconfigs
+func KubeconfigSecretName(clusterName string) string {
+	return clusterName + kubeconfigSecretSuffix
+}
+
+// Secrets returns the secret material of the spec, split into credentials, CA bundle and kubeconfig Secrets
+func (spec *Spec) Secrets(namespace string) []*corev1.Secret {
+	newSecret := func(name string, fields map[string]*string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: secretData(fields),
+		}
+	}
+	return []*corev1.Secret{
+		newSecret(CredentialsSecretName(spec.ClusterName), spec.credentialsFields()),
+		newSecret(CertificatesSecretName(spec.ClusterName), spec.certificatesFields()),
+		newSecret(KubeconfigSecretName(spec.ClusterName), spec.kubeconfigFields()),
+	}
+}
+
+// CertificatesSecretData returns the data of the CA bundle Secret
+func (spec *Spec) CertificatesSecretData() map[string][]byte {
+	return secretData(spec.certificatesFields())
+}
+
+// KubeconfigSecretData returns the data of the kubeconfig Secret
+func (spec *Spec) KubeconfigSecretData() map[string][]byte {
+	return secretData(spec.kubeconfigFields())
+}
+
+// LoadCredentials populates the azure credentials from Secret data
+func (spec *Spec) LoadCredentials(data map[string][]byte) {
+	loadSecretData(spec.credentialsFields(), data)
+}
+
+// LoadCertificates populates the CA bundle from Secret data
+func (spec *Spec) LoadCertificates(data map[string][]byte) {
+	loadSecretData(spec.certificatesFields(), data)
+}
+
+// LoadKubeconfigs populates the admin and customer kubeconfigs from Secret data
+func (spec *Spec) LoadKubeconfigs(data map[string][]byte) {
+	loadSecretData(spec.kubeconfigFields(), data)
+}
+
+// HasSecrets returns true if any secret material is set on the spec
+func (spec *Spec) HasSecrets() bool {
+	return len(secretData(spec.credentialsFields())) > 0 ||
+		len(secretData(spec.certificatesFields())) > 0 ||
+		len(secretData(spec.kubeconfigFields())) > 0
+}
+
+// WithoutSecrets returns a copy of the spec with all secret material removed
+func (spec *Spec) WithoutSecrets() Spec {
+	out := *spec
+	out.DiscoveryHashes = append([]string(nil), spec.DiscoveryHashes...)
+	for _, fields := range []map[string]*string{
+		out.credentialsFields(),
+		out.certificatesFields(),
+		out.kubeconfigFields(),
+	} {
+		for _, value := range fields {
+			*value = ""
+		}
+	}
+	return out
+}
@@ -37,6 +37,9 @@ type Spec struct {
 	FrontProxyCACertificateKey   string   `json:"frontProxyCACertificateKey,omitempty"`
 	EtcdCACertificate            string   `json:"etcdCACertificate,omitempty"`
 	EtcdCACertificateKey         string   `json:"etcdCACertificateKey,omitempty"`
+	NextCACertificate            string   `json:"nextCACertificate,omitempty"`
+	NextCACertificateKey         string   `json:"nextCACertificateKey,omitempty"`
+	PreviousCACertificate        string   `json:"previousCACertificate,omitempty"`
 	AdminKubeConfig              string   `json:"adminKubeConfig,omitempty"`
 	CustomerKubeConfig           string   `json:"customerKubeConfig,omitempty"`
 	DiscoveryHashes              []string `json:"discoveryHashes,omitempty"`
@@ -46,15 +49,39 @@ type Spec struct {
 	AzureCloudProviderConfig     string   `json:"azureCloudProviderConfig,omitempty"`
 	BootstrapVMSKUType           string   `json:"bootstrapVMSKUType,omitempty"`
 	BootstrapKubernetesVersion   string   `json:"bootstrapKubernetesVersion,omitempty"`
+
+	// Networking is the network topology of the cluster
+	Networking Networking `json:"networking,omitempty"`
+	// NetworkSecurity are the security rules of the master and agent subnet of azk-vnet
+	NetworkSecurity NetworkSecurity `json:"networkSecurity,omitempty"`
+	// KubeadmPatches are merged into the generated kubeadm configuration
+	KubeadmPatches KubeadmPatches `json:"kubeadmPatches,omitempty"`
 }
 
 func (in *Spec) DeepCopyInto(out *Spec) {
 	*out = *in
+	in.NetworkSecurity.DeepCopyInto(&out.NetworkSecurity)
+	in.KubeadmPatches.DeepCopyInto(&out.KubeadmPatches)
 	return
 }
 
-func CreateSpec(cloudConfig *azhelpers.CloudConfiguration, dnsPrefix, vmSKUType, kubernetesVersion string) (*Spec, error) {
-	spec := &Spec{}
+// CreateSpec creates the PKI, kubeconfigs and endpoints of a new cluster with the given network topology.
+// CAs set on pki are used instead of generated ones, pki may be nil.
+func CreateSpec(cloudConfig *azhelpers.CloudConfiguration, dnsPrefix, vmSKUType, kubernetesVersion string, networking Networking, pki *Spec) (*Spec, error) {
+	if err := networking.Validate(); err != nil {
+		return nil, err
+	}
+	spec := &Spec{Networking: networking.WithDefaults()}
+	if pki != nil {
+		if err := pki.ValidateSuppliedCAs(); err != nil {
+			return nil, err
+		}
+		supplied := pki.suppliedCAs()
+		for i, ca := range spec.suppliedCAs() {
+			*ca.certificate = *supplied[i].certificate
+			*ca.key = *supplied[i].key
+		}
+	}
 	if spec.ClusterName == "" {
 		h := fnv.New64a()
 		h.Write([]byte(fmt.Sprintf("%s/%s", cloudConfig.SubscriptionID, cloudConfig.GroupName)))
@@ -82,13 +109,11 @@ func CreateSpec(cloudConfig *azhelpers.CloudConfiguration, dnsPrefix, vmSKUType,
 	}
 
 	publicIPName = dnsPrefix + publicIPName
-	publicDNSName := fmt.Sprintf("%s.%s.cloudapp.azure.com", strings.ToLower(publicIPName), strings.ToLower(cloudConfig.GroupLocation))
+	publicDNSName := cloudConfig.PublicDNSName(publicIPName)
 	internalDNSName := fmt.Sprintf("%s.internal", strings.ToLower(publicIPName))
 
-	if spec.PublicDNSName == "" {
+	if spec.PublicDNSName == "" && !spec.Networking.PrivateCluster {
 		spec.PublicDNSName = publicDNSName
-	} else {
-		publicDNSName = spec.PublicDNSName
 	}
 	if spec.InternalDNSName == "" {
 		spec.InternalDNSName = internalDNSName
@@ -104,14 +129,31 @@ func CreateSpec(cloudConfig *azhelpers.CloudConfiguration, dnsPrefix, vmSKUType,
 	kubeadmscheme.Scheme.Default(v1beta1cfg)
 	v1beta1cfg.CertificatesDir = tmpDirName + "/certs"
 	v1beta1cfg.Etcd.Local = &kubeadmv1beta1.LocalEtcd{}
-	v1beta1cfg.LocalAPIEndpoint = kubeadmv1beta1.APIEndpoint{AdvertiseAddress: "10.0.0.4", BindPort: 6443}
+	v1beta1cfg.LocalAPIEndpoint = kubeadmv1beta1.APIEndpoint{AdvertiseAddress: spec.Networking.FirstMasterIP(), BindPort: 6443}
 	v1beta1cfg.ControlPlaneEndpoint = fmt.Sprintf("%s:6443", internalDNSName)
-	v1beta1cfg.APIServer.CertSANs = []string{"10.0.0.100", publicDNSName, internalDNSName}
+	v1beta1cfg.APIServer.CertSANs = spec.apiServerCertSANs()
+	v1beta1cfg.Networking = kubeadmv1beta1.Networking{
+		PodSubnet:     spec.Networking.PodCIDR,
+		ServiceSubnet: spec.Networking.ServiceCIDR,
+		DNSDomain:     spec.Networking.DNSDomain,
+	}
 	v1beta1cfg.NodeRegistration.Name = "fakenode" + spec.ClusterName
 	cfg := &kubeadmapi.InitConfiguration{}
 	kubeadmscheme.Scheme.Default(cfg)
 	kubeadmscheme.Scheme.Convert(v1beta1cfg, cfg, nil)
 
+	if err := spec.writeSuppliedCAs(cfg.CertificatesDir); err != nil {
+		log.Error(err, "Error Writing Supplied CAs")
+		return nil, err
+	}
+	if spec.CACertificate != "" {
+		discoveryHash, err := spec.CADiscoveryHash()
+		if err != nil {
+			return nil, err
+		}
+		spec.DiscoveryHashes = []string{discoveryHash}
+	}
+
 	log.Info("Creating PKI Certificates", "InternalDNS", internalDNSName)
 	if err := CreatePKISACertificates(cfg); err != nil {
 		log.Error(err, "Error Generating Certificates")
@@ -133,15 +175,15 @@ func CreateSpec(cloudConfig *azhelpers.CloudConfiguration, dnsPrefix, vmSKUType,
 	}
 
 	if spec.AzureCloudProviderConfig == "" {
-		azureCloudProviderConfig := azhelpers.GetAzureCloudProviderConfig(cloudConfig)
+		azureCloudProviderConfig := azhelpers.GetAzureCloudProviderConfig(cloudConfig, spec.cloudProviderNetwork())
 		spec.AzureCloudProviderConfig = azureCloudProviderConfig
 	}
 
 	if spec.CustomerKubeConfig == "" {
-		log.Info("Creating Customer Kubeconfig", "DNS", publicDNSName)
+		log.Info("Creating Customer Kubeconfig", "DNS", spec.customerAPIServerHost())
 		os.Remove(tmpDirName + "/kubeconfigs/admin.conf")
-		cfg.LocalAPIEndpoint = kubeadmapi.APIEndpoint{AdvertiseAddress: "10.0.0.4", BindPort: 6443}
-		cfg.ControlPlaneEndpoint = fmt.Sprintf("%s:6443", publicDNSName)
+		cfg.LocalAPIEndpoint = kubeadmapi.APIEndpoint{AdvertiseAddress: spec.Networking.FirstMasterIP(), BindPort: 6443}
+		cfg.ControlPlaneEndpoint = fmt.Sprintf("%s:6443", spec.customerAPIServerHost())
 		if err := kubeconfigphase.CreateKubeConfigFile(kubeadmconstants.AdminKubeConfigFileName, kubeConfigDir, cfg); err != nil {
 			return nil, err
 		}
@@ -150,7 +192,7 @@ func CreateSpec(cloudConfig *azhelpers.CloudConfiguration, dnsPrefix, vmSKUType,
 			return nil, err
 		}
 		spec.CustomerKubeConfig = string(buf)
-		log.Info("Created Customer Kubeconfig", "DNS", publicDNSName)
+		log.Info("Created Customer Kubeconfig", "DNS", spec.customerAPIServerHost())
 	}
 
 	return spec, nil
@@ -225,7 +267,7 @@ func (spec *Spec) UpdateSpec() error {
 		spec.AdminKubeConfig = string(buf)
 	}
 
-	// Discovery hashes typically never changes
+	// Discovery hashes only change while the CA is rotated
 	if len(spec.DiscoveryHashes) <= 0 {
 		discoveryHashes, err := GetDiscoveryHashes(tmpDirName + "/kubeconfigs/admin.conf")
 		if err != nil {
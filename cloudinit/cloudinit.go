@@ -0,0 +1,190 @@
+package cloudinit
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"sort"
+	"unicode/utf8"
+
+	sigsyaml "sigs.k8s.io/yaml"
+)
+
+const (
+	// MaxCustomDataSize is the largest customData azure accepts, in bytes before base64 encoding
+	MaxCustomDataSize = 65535
+
+	// ContentTypeCloudConfig and ContentTypeShellScript are the content types of multi-part parts
+	ContentTypeCloudConfig = "text/cloud-config"
+	ContentTypeShellScript = "text/x-shellscript"
+
+	cloudConfigHeader = "#cloud-config\n"
+	// multiPartBoundary is fixed so the same parts always produce the same payload
+	multiPartBoundary = "azk-cloud-init-boundary"
+)
+
+// Config is a #cloud-config document, sections render in a fixed order and files, commands and
+// users keep the order they are given in
+type Config struct {
+	BootCmd    []Command `json:"bootcmd,omitempty"`
+	Apt        *Apt      `json:"apt,omitempty"`
+	Packages   []string  `json:"packages,omitempty"`
+	Users      []User    `json:"users,omitempty"`
+	WriteFiles []File    `json:"write_files,omitempty"`
+	RunCmd     []Command `json:"runcmd,omitempty"`
+}
+
+// Command runs without a shell, cloud-init already runs it as root
+type Command []string
+
+// File is written by cloud-init before runcmd, Content is plain text unless Encoding is set
+type File struct {
+	Path        string `json:"path"`
+	Owner       string `json:"owner,omitempty"`
+	Permissions string `json:"permissions,omitempty"`
+	Encoding    string `json:"encoding,omitempty"`
+	Content     string `json:"content"`
+}
+
+// User is an additional user of the machine
+type User struct {
+	Name              string   `json:"name"`
+	Groups            string   `json:"groups,omitempty"`
+	Shell             string   `json:"shell,omitempty"`
+	Sudo              string   `json:"sudo,omitempty"`
+	SSHAuthorizedKeys []string `json:"ssh_authorized_keys,omitempty"`
+}
+
+// Apt configures the package sources of the machine
+type Apt struct {
+	Preserve bool                 `json:"preserve_sources_list,omitempty"`
+	Sources  map[string]AptSource `json:"sources,omitempty"`
+}
+
+// AptSource is an additional apt repository, keyed by its list file name
+type AptSource struct {
+	Source string `json:"source"`
+	Key    string `json:"key,omitempty"`
+	KeyID  string `json:"keyid,omitempty"`
+}
+
+// Part is a part of a multi-part payload, e.g. a cloud config followed by a shell script
+type Part struct {
+	ContentType string
+	Filename    string
+	Content     []byte
+}
+
+// Files returns the files owned by root with the given permissions, sorted by path. Binary
+// content is base64 encoded, text is kept readable.
+func Files(files map[string]string, permissions string) []File {
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	result := make([]File, 0, len(paths))
+	for _, path := range paths {
+		file := File{
+			Path:        path,
+			Owner:       "root",
+			Permissions: permissions,
+			Content:     files[path],
+		}
+		if !utf8.ValidString(file.Content) {
+			file.Encoding, file.Content = "b64", base64.StdEncoding.EncodeToString([]byte(file.Content))
+		}
+		result = append(result, file)
+	}
+	return result
+}
+
+// Marshal renders the #cloud-config document
+func (c *Config) Marshal() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(cloudConfigHeader)
+	// sigsyaml sorts the keys of a map, render the sections one by one to keep their order
+	for _, section := range []struct {
+		name  string
+		value interface{}
+		empty bool
+	}{
+		{"bootcmd", c.BootCmd, len(c.BootCmd) == 0},
+		{"apt", c.Apt, c.Apt == nil},
+		{"packages", c.Packages, len(c.Packages) == 0},
+		{"users", c.Users, len(c.Users) == 0},
+		{"write_files", c.WriteFiles, len(c.WriteFiles) == 0},
+		{"runcmd", c.RunCmd, len(c.RunCmd) == 0},
+	} {
+		if section.empty {
+			continue
+		}
+		out, err := sigsyaml.Marshal(map[string]interface{}{section.name: section.value})
+		if err != nil {
+			return nil, fmt.Errorf("cannot marshal cloud-init %s: %v", section.name, err)
+		}
+		buf.Write(out)
+	}
+	return buf.Bytes(), nil
+}
+
+// MultiPart combines parts into a multi-part MIME payload, cloud-init processes them in order
+func MultiPart(parts ...Part) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "MIME-Version: 1.0\r\nContent-Type: multipart/mixed; boundary=%q\r\n\r\n", multiPartBoundary)
+	w := multipart.NewWriter(&buf)
+	if err := w.SetBoundary(multiPartBoundary); err != nil {
+		return nil, err
+	}
+	for _, part := range parts {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", part.ContentType+`; charset="us-ascii"`)
+		header.Set("MIME-Version", "1.0")
+		if part.Filename != "" {
+			header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", part.Filename))
+		}
+		pw, err := w.CreatePart(header)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create multi-part %s: %v", part.Filename, err)
+		}
+		if _, err := pw.Write(part.Content); err != nil {
+			return nil, fmt.Errorf("cannot write multi-part %s: %v", part.Filename, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// CustomData compresses a payload with gzip, which cloud-init detects, and encodes it with base64
+// for the customData of a scale set. Payloads exceeding the azure limit once compressed are refused.
+func CustomData(payload []byte) (string, error) {
+	var buf bytes.Buffer
+	w, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return "", err
+	}
+	if _, err := w.Write(payload); err != nil {
+		return "", fmt.Errorf("cannot compress custom data: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		return "", fmt.Errorf("cannot compress custom data: %v", err)
+	}
+	if buf.Len() > MaxCustomDataSize {
+		return "", fmt.Errorf("custom data of %d bytes compressed exceeds the azure limit of %d bytes", buf.Len(), MaxCustomDataSize)
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// CustomData renders the config and encodes it for the customData of a scale set
+func (c *Config) CustomData() (string, error) {
+	payload, err := c.Marshal()
+	if err != nil {
+		return "", err
+	}
+	return CustomData(payload)
+}
@@ -0,0 +1,140 @@
+package cloudinit
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+)
+
+func TestMarshal(t *testing.T) {
+	config := &Config{
+		RunCmd: []Command{{"bash", "/etc/kubernetes/init.sh"}},
+		WriteFiles: append(
+			Files(map[string]string{"/etc/b": "b\n", "/etc/a": "a\n", "/etc/bin": "\xff\xfe"}, "0644"),
+			Files(map[string]string{"/etc/kubernetes/init.sh": "set -eux\necho init\n"}, "0755")...,
+		),
+		BootCmd: []Command{{"mkdir", "-p", "/etc/kubernetes"}},
+		Users:   []User{{Name: "azk", Groups: "sudo", SSHAuthorizedKeys: []string{"ssh-rsa AAAA"}}},
+	}
+	expected := `#cloud-config
+bootcmd:
+- - mkdir
+  - -p
+  - /etc/kubernetes
+users:
+- groups: sudo
+  name: azk
+  ssh_authorized_keys:
+  - ssh-rsa AAAA
+write_files:
+- content: |
+    a
+  owner: root
+  path: /etc/a
+  permissions: "0644"
+- content: |
+    b
+  owner: root
+  path: /etc/b
+  permissions: "0644"
+- content: //4=
+  encoding: b64
+  owner: root
+  path: /etc/bin
+  permissions: "0644"
+- content: |
+    set -eux
+    echo init
+  owner: root
+  path: /etc/kubernetes/init.sh
+  permissions: "0755"
+runcmd:
+- - bash
+  - /etc/kubernetes/init.sh
+`
+	for i := 0; i < 10; i++ {
+		out, err := config.Marshal()
+		if err != nil {
+			t.Fatalf("Failed to marshal cloud config: %v", err)
+		}
+		if string(out) != expected {
+			t.Fatalf("Expected cloud config\n%s\ngot\n%s", expected, out)
+		}
+	}
+}
+
+func TestCustomData(t *testing.T) {
+	config := &Config{WriteFiles: Files(map[string]string{"/etc/kubernetes/azure.json": "{}"}, "0644")}
+	payload, err := config.Marshal()
+	if err != nil {
+		t.Fatalf("Failed to marshal cloud config: %v", err)
+	}
+	customData, err := config.CustomData()
+	if err != nil {
+		t.Fatalf("Failed to encode custom data: %v", err)
+	}
+	compressed, err := base64.StdEncoding.DecodeString(customData)
+	if err != nil {
+		t.Fatalf("Expected base64 custom data: %v", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		t.Fatalf("Expected gzip custom data: %v", err)
+	}
+	decompressed, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatalf("Failed to decompress custom data: %v", err)
+	}
+	if !bytes.Equal(decompressed, payload) {
+		t.Fatalf("Expected the cloud config, got %s", decompressed)
+	}
+
+	random := make([]byte, MaxCustomDataSize)
+	if _, err := rand.Read(random); err != nil {
+		t.Fatalf("Failed to read random data: %v", err)
+	}
+	if _, err := CustomData(random); err == nil || !strings.Contains(err.Error(), "exceeds the azure limit") {
+		t.Fatalf("Expected incompressible payload to exceed the limit, got %v", err)
+	}
+}
+
+func TestMultiPart(t *testing.T) {
+	payload, err := MultiPart(
+		Part{ContentType: ContentTypeCloudConfig, Filename: "cloud-config.yaml", Content: []byte("#cloud-config\nruncmd:\n- - true\n")},
+		Part{ContentType: ContentTypeShellScript, Filename: "init.sh", Content: []byte("#!/bin/bash\necho init\n")},
+	)
+	if err != nil {
+		t.Fatalf("Failed to create multi-part payload: %v", err)
+	}
+	msg, err := mail.ReadMessage(bytes.NewReader(payload))
+	if err != nil {
+		t.Fatalf("Failed to read multi-part payload: %v", err)
+	}
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/mixed" {
+		t.Fatalf("Expected multipart/mixed, got %s %v", mediaType, err)
+	}
+	r := multipart.NewReader(msg.Body, params["boundary"])
+	var contentTypes []string
+	for {
+		part, err := r.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Failed to read part: %v", err)
+		}
+		contentTypes = append(contentTypes, part.Header.Get("Content-Type"))
+	}
+	if len(contentTypes) != 2 || !strings.HasPrefix(contentTypes[0], ContentTypeCloudConfig) || !strings.HasPrefix(contentTypes[1], ContentTypeShellScript) {
+		t.Fatalf("Expected a cloud config followed by a shell script, got %v", contentTypes)
+	}
+}
@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"net/http"
+	"os"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/chi/middleware"
+	"github.com/spf13/cobra"
+
+	"github.com/awesomenix/azk/azure/armserver"
+)
+
+var armServerAddress string
+
+var ARMServerCmd = &cobra.Command{
+	Use:   "armserver",
+	Short: "local stand-in for the azure resource manager apis",
+	Long: `Serves the subset of the azure resource manager and active directory apis azk uses from memory,
+point azk create cluster at it with --resource-manager-endpoint and --active-directory-endpoint`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := RunARMServer(armServerAddress); err != nil {
+			log.Error(err, "failed to run armserver")
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	ARMServerCmd.Flags().StringVar(&armServerAddress, "address", ":8443", "Address to listen on")
+	RootCmd.AddCommand(ARMServerCmd)
+}
+
+func RunARMServer(address string) error {
+	r := chi.NewRouter()
+	r.Use(middleware.Logger)
+	r.Use(middleware.Recoverer)
+	r.Mount("/", armserver.NewServer())
+
+	log.Info("Serving azure resource manager", "Address", address)
+	return http.ListenAndServe(address, r)
+}
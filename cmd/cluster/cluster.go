@@ -2,6 +2,7 @@ package cluster
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"hash/fnv"
@@ -21,11 +22,12 @@ import (
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v2"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
+	sigsyaml "sigs.k8s.io/yaml"
 )
 
 var log = logf.Log.WithName("azk")
@@ -36,8 +38,12 @@ func init() {
 	CreateClusterCmd.MarkFlagRequired("subscriptionid")
 	CreateClusterCmd.Flags().StringVarP(&co.ClientID, "clientid", "i", "", "Client ID Required.")
 	CreateClusterCmd.MarkFlagRequired("clientid")
-	CreateClusterCmd.Flags().StringVarP(&co.ClientSecret, "clientsecret", "e", "", "Client Secret Required.")
-	CreateClusterCmd.MarkFlagRequired("clientsecret")
+	CreateClusterCmd.Flags().StringVarP(&co.ClientSecret, "clientsecret", "e", "", "Client Secret, Required unless a client certificate or federated token file is used.")
+	CreateClusterCmd.Flags().StringVar(&co.ClientCertificateFile, "client-certificate-file", "", "Pkcs12 certificate of the service principal, used instead of the client secret")
+	CreateClusterCmd.Flags().StringVar(&co.ClientCertificatePassword, "client-certificate-password", "", "Password of the client certificate")
+	CreateClusterCmd.Flags().StringVar(&co.FederatedTokenFile, "federated-token-file", "", "Workload identity token file exchanged for an access token, used instead of the client secret")
+	CreateClusterCmd.Flags().StringVar(&co.IdentityClientID, "identity-client-id", "", "Client ID of a user assigned identity, the in-cluster manager and cloud provider authenticate as it instead of the service principal")
+	CreateClusterCmd.Flags().StringVar(&co.IdentityResourceID, "identity-resource-id", "", "Resource ID of the user assigned identity assigned to every scale set")
 	CreateClusterCmd.Flags().StringVarP(&co.TenantID, "tenantid", "t", "", "Tenant ID Required.")
 	CreateClusterCmd.MarkFlagRequired("tenantid")
 	CreateClusterCmd.Flags().StringVarP(&co.ResourceGroup, "resourcegroup", "r", "", "Resource Group Name, in which all resources are created Required.")
@@ -48,11 +54,40 @@ func init() {
 	CreateClusterCmd.Flags().StringVarP(&co.KubernetesVersion, "kubernetesversion", "k", "stable", "Master Kubernetes Version")
 	CreateClusterCmd.Flags().StringVarP(&co.NodePoolName, "nodepoolname", "n", "nodepool1", "Nodepool Name, Optional, default nodepool1")
 	CreateClusterCmd.Flags().Int32VarP(&co.NodePoolCount, "nodepoolcount", "c", 1, "Nodepool Count, Optional, default 1")
+	CreateClusterCmd.Flags().Int32Var(&co.ControlPlaneCount, "controlplanecount", enginev1alpha1.DefaultControlPlaneReplicas, "Control plane node count, one of 1, 3 or 5, Optional, default 3")
 	CreateClusterCmd.Flags().BoolVarP(&co.IsDevelopment, "isdev", "m", false, "Is development mode")
 	CreateClusterCmd.Flags().StringVarP(&co.VMSKUType, "vmskutype", "u", "Standard_DS2_v2", "VM SKU Type, default: Standard_DS2_v2")
 
 	// Optional flags
 	CreateClusterCmd.Flags().StringVarP(&co.KubeconfigOutput, "kubeconfigout", "o", "kubeconfig", "Where to output the kubeconfig for the provisioned cluster")
+	CreateClusterCmd.Flags().StringVar(&co.CloudName, "cloud", azhelpers.AzurePublicCloudName, "Azure cloud, one of AzurePublicCloud, AzureChinaCloud, AzureUSGovernmentCloud, AzureGermanCloud or AzureStackCloud")
+	CreateClusterCmd.Flags().StringVar(&co.CloudEnvironmentFile, "cloud-environment-file", "", "Json environment of a custom cloud, required for AzureStackCloud")
+	CreateClusterCmd.Flags().StringVar(&co.ResourceManagerEndpoint, "resource-manager-endpoint", "", "Override the azure resource manager endpoint, e.g. a local azk armserver")
+	CreateClusterCmd.Flags().StringVar(&co.ActiveDirectoryEndpoint, "active-directory-endpoint", "", "Override the azure active directory endpoint tokens are requested from")
+	CreateClusterCmd.Flags().StringVar(&co.CACertFile, "ca-cert", "", "PEM cluster CA certificate, optionally followed by its chain, used instead of generating the cluster CA")
+	CreateClusterCmd.Flags().StringVar(&co.CAKeyFile, "ca-key", "", "PEM key of the cluster CA, required with --ca-cert")
+	CreateClusterCmd.Flags().StringVar(&co.FrontProxyCACertFile, "front-proxy-ca-cert", "", "PEM front proxy CA certificate, used instead of generating the front proxy CA")
+	CreateClusterCmd.Flags().StringVar(&co.FrontProxyCAKeyFile, "front-proxy-ca-key", "", "PEM key of the front proxy CA, required with --front-proxy-ca-cert")
+	CreateClusterCmd.Flags().StringVar(&co.EtcdCACertFile, "etcd-ca-cert", "", "PEM etcd CA certificate, used instead of generating the etcd CA")
+	CreateClusterCmd.Flags().StringVar(&co.EtcdCAKeyFile, "etcd-ca-key", "", "PEM key of the etcd CA, required with --etcd-ca-cert")
+	CreateClusterCmd.Flags().StringVar(&co.Networking.VNetCIDR, "vnet-cidr", "", "Address space of the cluster vnet, default "+bootstrap.DefaultVNetCIDR)
+	CreateClusterCmd.Flags().StringVar(&co.Networking.MasterSubnetCIDR, "master-subnet-cidr", "", "Subnet of the masters, within the vnet address space, default "+bootstrap.DefaultMasterSubnetCIDR)
+	CreateClusterCmd.Flags().StringVar(&co.Networking.AgentSubnetCIDR, "agent-subnet-cidr", "", "Subnet of the nodes, within the vnet address space, default "+bootstrap.DefaultAgentSubnetCIDR)
+	CreateClusterCmd.Flags().StringVar(&co.Networking.InternalLoadBalancerIP, "internal-lb-ip", "", "Static address of the internal api server load balancer, within the master subnet, default "+bootstrap.DefaultInternalLoadBalancerIP+" or the last usable address of an existing master subnet")
+	CreateClusterCmd.Flags().StringVar(&co.Networking.MasterSubnetID, "master-subnet-id", "", "Resource id of an existing subnet for the masters, deploys into its vnet instead of creating one, requires --agent-subnet-id")
+	CreateClusterCmd.Flags().StringVar(&co.Networking.AgentSubnetID, "agent-subnet-id", "", "Resource id of an existing subnet for the nodes, in the vnet of --master-subnet-id")
+	CreateClusterCmd.Flags().StringVar(&co.Networking.PodCIDR, "pod-cidr", "", "Pod network, must not overlap the master or agent subnet, default "+bootstrap.DefaultPodCIDR+", with "+bootstrap.NetworkPluginAzureCNI+" it must hold both subnets and defaults to the smallest network that does")
+	CreateClusterCmd.Flags().StringVar(&co.Networking.ServiceCIDR, "service-cidr", bootstrap.DefaultServiceCIDR, "Service network, must not overlap the master or agent subnet or pod network")
+	CreateClusterCmd.Flags().StringVar(&co.Networking.DNSDomain, "dns-domain", bootstrap.DefaultDNSDomain, "Kubernetes cluster dns domain")
+	CreateClusterCmd.Flags().StringVar(&co.Networking.NetworkPlugin, "network-plugin", bootstrap.DefaultNetworkPlugin, "CNI plugin pods are networked with, one of "+strings.Join(bootstrap.NetworkPlugins(), ", "))
+	CreateClusterCmd.Flags().BoolVar(&co.Networking.PrivateCluster, "private-cluster", false, "Create no public load balancer, the api server is only reachable through the internal endpoint, use --jumpbox unless running within the vnet")
+	CreateClusterCmd.Flags().StringVar(&co.Networking.OutboundType, "outbound-type", "", "Egress of masters and nodes, one of "+bootstrap.OutboundTypeLoadBalancer+", "+bootstrap.OutboundTypeNATGateway+" or "+bootstrap.OutboundTypeUserDefinedRouting+", private clusters default to "+bootstrap.OutboundTypeNATGateway+", or "+bootstrap.OutboundTypeUserDefinedRouting+" in an existing vnet")
+	CreateClusterCmd.Flags().StringSliceVar(&co.NetworkSecurity.APIServerAuthorizedIPRanges, "api-server-authorized-ip-ranges", nil, "CIDRs allowed to reach the api server, default any source")
+	CreateClusterCmd.Flags().StringSliceVar(&co.NetworkSecurity.SSHAuthorizedIPRanges, "ssh-authorized-ip-ranges", nil, "CIDRs allowed to ssh into the masters, default any source")
+	CreateClusterCmd.Flags().BoolVar(&co.NetworkSecurity.DisableSSH, "disable-ssh", false, "Do not allow ssh into the masters from outside the vnet")
+	CreateClusterCmd.Flags().StringVar(&co.SecurityRulesFile, "security-rules-file", "", "Yaml of additional masterRules and agentRules of the subnet security groups")
+	CreateClusterCmd.Flags().StringVar(&co.KubeadmPatchesFile, "kubeadm-patches-file", "", "Yaml of apiServer, controllerManager and scheduler extraArgs and extraVolumes, and kubeletExtraArgs, merged into the generated kubeadm configuration")
+	CreateClusterCmd.Flags().StringVar(&co.CASecretFile, "ca-secret", "", "Secret manifest holding CAs under the keys of the cluster certificates Secret, e.g. ca.crt and ca.key")
 
 	// Delete
 	DeleteClusterCmd.Flags().StringVarP(&do.SubscriptionID, "subscriptionid", "s", "", "SubscriptionID Required.")
@@ -96,9 +131,38 @@ type CreateOptions struct {
 	KubernetesVersion string
 	NodePoolName      string
 	NodePoolCount     int32
+	ControlPlaneCount int32
 	KubeconfigOutput  string
 	VMSKUType         string
 	IsDevelopment     bool
+	// Service principal credentials other than the client secret
+	ClientCertificateFile     string
+	ClientCertificatePassword string
+	FederatedTokenFile        string
+	// IdentityClientID and IdentityResourceID select the user assigned identity the cluster authenticates as
+	IdentityClientID   string
+	IdentityResourceID string
+	// CloudName and CloudEnvironmentFile select the azure cloud, defaults to the public cloud
+	CloudName            string
+	CloudEnvironmentFile string
+	// Endpoint overrides, used to run against a local stand-in
+	ResourceManagerEndpoint string
+	ActiveDirectoryEndpoint string
+	// Networking is the network topology of the cluster
+	Networking bootstrap.Networking
+	// NetworkSecurity is the access to the subnets, custom rules are read from SecurityRulesFile
+	NetworkSecurity   bootstrap.NetworkSecurity
+	SecurityRulesFile string
+	// KubeadmPatchesFile is a yaml of patches merged into the generated kubeadm configuration
+	KubeadmPatchesFile string
+	// CA files or a Secret manifest supplying CAs instead of generating them
+	CACertFile           string
+	CAKeyFile            string
+	FrontProxyCACertFile string
+	FrontProxyCAKeyFile  string
+	EtcdCACertFile       string
+	EtcdCAKeyFile        string
+	CASecretFile         string
 }
 
 type DeleteOptions struct {
@@ -109,6 +173,141 @@ type DeleteOptions struct {
 var co = &CreateOptions{}
 var do = &DeleteOptions{}
 
+// cloudConfiguration returns the cloud configuration of the options, failing early on
+// unknown clouds or invalid custom environments
+func (co *CreateOptions) cloudConfiguration() (*azhelpers.CloudConfiguration, error) {
+	cloudConfig := &azhelpers.CloudConfiguration{
+		CloudName:      co.CloudName,
+		SubscriptionID: co.SubscriptionID,
+		ClientID:       co.ClientID,
+		ClientSecret:   co.ClientSecret,
+		TenantID:       co.TenantID,
+		GroupName:      co.ResourceGroup,
+		GroupLocation:  co.ResourceLocation,
+		UserAgent:      "azk",
+
+		ClientCertificatePassword:      co.ClientCertificatePassword,
+		FederatedTokenFile:             co.FederatedTokenFile,
+		UserAssignedIdentityID:         co.IdentityClientID,
+		UserAssignedIdentityResourceID: co.IdentityResourceID,
+		ResourceManagerEndpoint:        co.ResourceManagerEndpoint,
+		ActiveDirectoryEndpoint:        co.ActiveDirectoryEndpoint,
+	}
+	if co.ClientCertificateFile != "" {
+		buf, err := ioutil.ReadFile(co.ClientCertificateFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read client certificate file: %v", err)
+		}
+		cloudConfig.ClientCertificate = base64.StdEncoding.EncodeToString(buf)
+	}
+	if co.ClientSecret == "" && co.ClientCertificateFile == "" && co.FederatedTokenFile == "" {
+		return nil, fmt.Errorf("one of --clientsecret, --client-certificate-file or --federated-token-file is required")
+	}
+	if (co.IdentityClientID == "") != (co.IdentityResourceID == "") {
+		return nil, fmt.Errorf("--identity-client-id and --identity-resource-id must be set together")
+	}
+	if cloudConfig.CloudName == "" {
+		cloudConfig.CloudName = azhelpers.AzurePublicCloudName
+	}
+	if co.CloudEnvironmentFile != "" {
+		buf, err := ioutil.ReadFile(co.CloudEnvironmentFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read cloud environment file: %v", err)
+		}
+		cloudConfig.CloudEnvironment = string(buf)
+		if cloudConfig.CloudName == azhelpers.AzurePublicCloudName {
+			cloudConfig.CloudName = azhelpers.AzureStackCloudName
+		}
+	}
+	if cloudConfig.CloudName == azhelpers.AzureStackCloudName && cloudConfig.CloudEnvironment == "" {
+		return nil, fmt.Errorf("%s requires --cloud-environment-file", azhelpers.AzureStackCloudName)
+	}
+	if _, err := cloudConfig.Environment(); err != nil {
+		return nil, err
+	}
+	return cloudConfig, nil
+}
+
+// networkSecurity returns the security rules of the options, custom rules are read from the security rules file
+func (co *CreateOptions) networkSecurity() (bootstrap.NetworkSecurity, error) {
+	networkSecurity := co.NetworkSecurity
+	if co.SecurityRulesFile != "" {
+		buf, err := ioutil.ReadFile(co.SecurityRulesFile)
+		if err != nil {
+			return bootstrap.NetworkSecurity{}, fmt.Errorf("cannot read security rules: %v", err)
+		}
+		rules := bootstrap.NetworkSecurity{}
+		if err := sigsyaml.Unmarshal(buf, &rules); err != nil {
+			return bootstrap.NetworkSecurity{}, fmt.Errorf("cannot parse security rules: %v", err)
+		}
+		networkSecurity.MasterRules, networkSecurity.AgentRules = rules.MasterRules, rules.AgentRules
+	}
+	return networkSecurity, nil
+}
+
+// kubeadmPatches reads the patches of the kubeadm configuration from the patches file, if any
+func (co *CreateOptions) kubeadmPatches() (bootstrap.KubeadmPatches, error) {
+	patches := bootstrap.KubeadmPatches{}
+	if co.KubeadmPatchesFile == "" {
+		return patches, nil
+	}
+	buf, err := ioutil.ReadFile(co.KubeadmPatchesFile)
+	if err != nil {
+		return patches, fmt.Errorf("cannot read kubeadm patches: %v", err)
+	}
+	if err := sigsyaml.UnmarshalStrict(buf, &patches); err != nil {
+		return patches, fmt.Errorf("cannot parse kubeadm patches: %v", err)
+	}
+	return patches, patches.Validate()
+}
+
+// suppliedPKI reads the CAs supplied with the options, files take precedence over the Secret manifest,
+// returns nil when no CA was supplied
+func (co *CreateOptions) suppliedPKI() (*bootstrap.Spec, error) {
+	pki := &bootstrap.Spec{}
+	if co.CASecretFile != "" {
+		buf, err := ioutil.ReadFile(co.CASecretFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read CA secret: %v", err)
+		}
+		secret := &corev1.Secret{}
+		if err := sigsyaml.Unmarshal(buf, secret); err != nil {
+			return nil, fmt.Errorf("cannot parse CA secret: %v", err)
+		}
+		for key, value := range secret.StringData {
+			if secret.Data == nil {
+				secret.Data = map[string][]byte{}
+			}
+			secret.Data[key] = []byte(value)
+		}
+		pki.LoadCertificates(secret.Data)
+	}
+	for _, f := range []struct {
+		file  string
+		value *string
+	}{
+		{co.CACertFile, &pki.CACertificate},
+		{co.CAKeyFile, &pki.CACertificateKey},
+		{co.FrontProxyCACertFile, &pki.FrontProxyCACertificate},
+		{co.FrontProxyCAKeyFile, &pki.FrontProxyCACertificateKey},
+		{co.EtcdCACertFile, &pki.EtcdCACertificate},
+		{co.EtcdCAKeyFile, &pki.EtcdCACertificateKey},
+	} {
+		if f.file == "" {
+			continue
+		}
+		buf, err := ioutil.ReadFile(f.file)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read CA file: %v", err)
+		}
+		*f.value = string(buf)
+	}
+	if !pki.HasSecrets() {
+		return nil, nil
+	}
+	return pki, nil
+}
+
 func RunCreate(co *CreateOptions) error {
 	kubernetesVersion, err := helpers.GetKubernetesVersion(co.KubernetesVersion)
 	if err != nil {
@@ -117,35 +316,80 @@ func RunCreate(co *CreateOptions) error {
 	}
 	co.KubernetesVersion = kubernetesVersion
 
+	if co.ControlPlaneCount == 0 {
+		co.ControlPlaneCount = enginev1alpha1.DefaultControlPlaneReplicas
+	}
+	if err := enginev1alpha1.ValidateControlPlaneReplicas(co.ControlPlaneCount); err != nil {
+		return err
+	}
+
+	cloudConfig, err := co.cloudConfiguration()
+	if err != nil {
+		log.Error(err, "Failed to determine azure cloud")
+		return err
+	}
+
 	h := fnv.New64a()
 	h.Write([]byte(fmt.Sprintf("%s/%s", co.SubscriptionID, co.ResourceGroup)))
 	clusterName := fmt.Sprintf("%x", h.Sum64())
 
+	// local state holds credentials and cluster PKI, keep it private to the user
 	clusterdir := os.Getenv("HOME") + "/.azk/" + clusterName
-	if err := os.MkdirAll(clusterdir, 0755); err != nil {
-		log.Error(err, "Failed to marshal bootstrap spec to json")
+	if err := os.MkdirAll(clusterdir, 0700); err != nil {
+		log.Error(err, "Failed to create cluster state directory")
+		return err
+	}
+	if err := os.Chmod(clusterdir, 0700); err != nil {
+		log.Error(err, "Failed to restrict cluster state directory")
 		return err
 	}
 
 	clusterStart := time.Now()
 	log.Info("Creating Cluster", "KubernetesVersion", co.KubernetesVersion, "ClusterName", clusterName)
 
-	spec, err := bootstrap.CreateSpec(&azhelpers.CloudConfiguration{
-		CloudName:      azhelpers.AzurePublicCloudName,
-		SubscriptionID: co.SubscriptionID,
-		ClientID:       co.ClientID,
-		ClientSecret:   co.ClientSecret,
-		TenantID:       co.TenantID,
-		GroupName:      co.ResourceGroup,
-		GroupLocation:  co.ResourceLocation,
-		UserAgent:      "azk",
-	}, co.DNSPrefix, "", co.KubernetesVersion)
+	pki, err := co.suppliedPKI()
+	if err != nil {
+		log.Error(err, "Failed to read supplied CAs")
+		return err
+	}
+
+	if co.Networking.ExistingVNet() {
+		provider, err := azhelpers.NewProvider(cloudConfig)
+		if err != nil {
+			log.Error(err, "Failed to create azure provider")
+			return err
+		}
+		if co.Networking, err = co.Networking.ResolveExistingSubnets(context.TODO(), provider, cloudConfig.GroupName); err != nil {
+			log.Error(err, "Failed to resolve existing subnets")
+			return err
+		}
+	}
+
+	networkSecurity, err := co.networkSecurity()
+	if err != nil {
+		log.Error(err, "Failed to read security rules")
+		return err
+	}
+	if err := networkSecurity.Validate(co.Networking); err != nil {
+		log.Error(err, "Invalid security rules")
+		return err
+	}
+
+	kubeadmPatches, err := co.kubeadmPatches()
+	if err != nil {
+		log.Error(err, "Invalid kubeadm patches")
+		return err
+	}
+
+	spec, err := bootstrap.CreateSpec(cloudConfig, co.DNSPrefix, "", co.KubernetesVersion, co.Networking, pki)
 
 	if err != nil {
 		log.Error(err, "Failed to create bootstrap spec")
 		return err
 	}
 	spec.BootstrapVMSKUType = co.VMSKUType
+	spec.NetworkSecurity = networkSecurity
+	spec.KubeadmPatches = kubeadmPatches
 
 	jsonSpec, err := json.Marshal(spec)
 	if err != nil {
@@ -153,17 +397,23 @@ func RunCreate(co *CreateOptions) error {
 		return err
 	}
 
-	if err := ioutil.WriteFile(clusterdir+"/bootstrapspec.json", jsonSpec, 0644); err != nil {
+	if err := writePrivateFile(clusterdir+"/bootstrapspec.json", jsonSpec); err != nil {
 		log.Error(err, "Failed to store bootstrap spec")
 		return err
 	}
 
+	provider, err := azhelpers.NewProvider(&spec.CloudConfiguration)
+	if err != nil {
+		log.Error(err, "Failed to create azure provider")
+		return err
+	}
+
 	s := spinner.New(spinner.CharSets[11], 200*time.Millisecond)
 	s.Color("green")
 	s.Suffix = fmt.Sprintf(" Creating bootstrap resources %s in group %s", spec.ClusterName, co.ResourceGroup)
 	s.Start()
 	start := time.Now()
-	err = spec.Bootstrap()
+	err = spec.Bootstrap(provider)
 	s.Stop()
 
 	if err != nil {
@@ -172,7 +422,7 @@ func RunCreate(co *CreateOptions) error {
 		s.Color("green")
 		s.Suffix = fmt.Sprintf(" Cleaning up bootstrap resources %s", spec.ClusterName)
 		s.Start()
-		spec.CleanupInfrastructure()
+		spec.CleanupInfrastructure(provider)
 		s.Stop()
 		fmt.Fprintf(s.Writer, " ✓ Successfully cleanup up bootstrap resources %s\n", spec.ClusterName)
 		return err
@@ -181,18 +431,12 @@ func RunCreate(co *CreateOptions) error {
 	fmt.Fprintf(s.Writer, " ✓ Successfully created bootstrap resources %s in %s\n", spec.ClusterName, time.Since(start))
 
 	if co.KubeconfigOutput != "" {
-		ioutil.WriteFile(co.KubeconfigOutput+"-"+clusterName, []byte(spec.CustomerKubeConfig), 0644)
+		writePrivateFile(co.KubeconfigOutput+"-"+clusterName, []byte(spec.CustomerKubeConfig))
 	}
 
 	// Get a config to talk to the apiserver
 
-	clientcfg, err := clientcmd.NewClientConfigFromBytes([]byte(spec.CustomerKubeConfig))
-	if err != nil {
-		log.Error(err, "Failed to create config")
-		return err
-	}
-
-	cfg, err := clientcfg.ClientConfig()
+	cfg, err := cmdhelpers.RESTConfig(spec.CustomerKubeConfig)
 	if err != nil {
 		log.Error(err, " ✗ Failed to get client config")
 		return err
@@ -261,6 +505,14 @@ func RunCreate(co *CreateOptions) error {
 
 	fmt.Fprintf(s.Writer, " ✓ Successfully Created Namespace %s\n", clusterName)
 
+	// with a user assigned identity no service principal credentials are handed to the cluster
+	clusterSpec := spec
+	if spec.UserAssignedIdentityResourceID != "" {
+		clusterSpec = &bootstrap.Spec{}
+		*clusterSpec = *spec
+		clusterSpec.CloudConfiguration = spec.ManagedIdentityConfiguration()
+	}
+
 	cluster := &enginev1alpha1.Cluster{}
 	if err := kClient.Get(context.TODO(), types.NamespacedName{Namespace: clusterName, Name: clusterName}, cluster); err == nil {
 		if cluster.Status.ProvisioningState == "Succeeded" {
@@ -268,23 +520,45 @@ func RunCreate(co *CreateOptions) error {
 		}
 		clusterSpec, err := yaml.Marshal(cluster)
 		if err == nil {
-			ioutil.WriteFile(clusterdir+"/clusterspec.yml", clusterSpec, 0644)
+			writePrivateFile(clusterdir+"/clusterspec.yml", clusterSpec)
 		}
 	} else {
 		time.Sleep(3 * time.Second)
+
+		s = spinner.New(spinner.CharSets[11], 200*time.Millisecond)
+		s.Color("green")
+		s.Suffix = fmt.Sprintf(" Creating Secrets for Cluster %s", clusterName)
+		s.Start()
+		for _, secret := range clusterSpec.Secrets(clusterName) {
+			if err = kClient.Create(context.TODO(), secret); err != nil && !apierrors.IsAlreadyExists(err) {
+				break
+			}
+			err = nil
+		}
+		s.Stop()
+
+		if err != nil {
+			fmt.Fprintf(s.Writer, " ✗ Failed to Create Secrets %v\n", err)
+			return err
+		}
+		fmt.Fprintf(s.Writer, " ✓ Successfully Created Secrets for Cluster %s\n", clusterName)
+
 		cluster = &enginev1alpha1.Cluster{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      clusterName,
 				Namespace: clusterName,
 			},
 			Spec: enginev1alpha1.ClusterSpec{
-				Spec: *spec,
+				Spec:            clusterSpec.WithoutSecrets(),
+				CredentialsRef:  corev1.LocalObjectReference{Name: bootstrap.CredentialsSecretName(spec.ClusterName)},
+				CertificatesRef: corev1.LocalObjectReference{Name: bootstrap.CertificatesSecretName(spec.ClusterName)},
+				KubeconfigRef:   corev1.LocalObjectReference{Name: bootstrap.KubeconfigSecretName(spec.ClusterName)},
 			},
 		}
 
 		clusterSpec, err := yaml.Marshal(cluster)
 		if err == nil {
-			ioutil.WriteFile(clusterdir+"/clusterspec.yml", clusterSpec, 0644)
+			writePrivateFile(clusterdir+"/clusterspec.yml", clusterSpec)
 		}
 
 		s = spinner.New(spinner.CharSets[11], 200*time.Millisecond)
@@ -325,12 +599,14 @@ func RunCreate(co *CreateOptions) error {
 			Spec: enginev1alpha1.ControlPlaneSpec{
 				KubernetesVersion: co.KubernetesVersion,
 				VMSKUType:         co.VMSKUType,
+				Replicas:          &co.ControlPlaneCount,
+				ClusterRef:        corev1.LocalObjectReference{Name: clusterName},
 			},
 		}
 
 		controlplaneSpec, err := yaml.Marshal(controlPlane)
 		if err == nil {
-			ioutil.WriteFile(clusterdir+"/controlplanespec.yml", controlplaneSpec, 0644)
+			writePrivateFile(clusterdir+"/controlplanespec.yml", controlplaneSpec)
 		}
 
 		log.Info("Creating ControlPlane .. timeout 15m0s", "ClusterName", clusterName, "KubernetesVersion", co.KubernetesVersion)
@@ -375,13 +651,14 @@ func RunCreate(co *CreateOptions) error {
 					KubernetesVersion: co.KubernetesVersion,
 					Replicas:          &(co.NodePoolCount),
 					VMSKUType:         co.VMSKUType,
+					ClusterRef:        corev1.LocalObjectReference{Name: clusterName},
 				},
 			},
 		}
 
 		nodepoolSpec, err := yaml.Marshal(nodePool)
 		if err == nil {
-			ioutil.WriteFile(clusterdir+"/nodepoolspec.yml", nodepoolSpec, 0644)
+			writePrivateFile(clusterdir+"/nodepoolspec.yml", nodepoolSpec)
 		}
 
 		log.Info("Creating Nodepool .. timeout 10m0s", "Name", co.NodePoolName, "KubernetesVersion", co.KubernetesVersion)
@@ -430,9 +707,26 @@ func RunCreate(co *CreateOptions) error {
 	return nil
 }
 
+// deleteCloudConfiguration returns the credentials to delete the cluster with, clusters
+// authenticating as a managed identity fall back to the locally stored bootstrap credentials
+func deleteCloudConfiguration(clusterName string, cloudConfig *azhelpers.CloudConfiguration) *azhelpers.CloudConfiguration {
+	if !cloudConfig.UseManagedIdentity {
+		return cloudConfig
+	}
+	buf, err := ioutil.ReadFile(os.Getenv("HOME") + "/.azk/" + clusterName + "/bootstrapspec.json")
+	if err != nil {
+		return cloudConfig
+	}
+	spec := &bootstrap.Spec{}
+	if err := json.Unmarshal(buf, spec); err != nil || !spec.CloudConfiguration.IsValid() || spec.UseManagedIdentity {
+		return cloudConfig
+	}
+	return &spec.CloudConfiguration
+}
+
 func RunDelete(do *DeleteOptions) error {
 	log.Info("setting up client for delete")
-	cfg, err := clientcmd.BuildConfigFromFlags("", os.Getenv("KUBECONFIG"))
+	cfg, err := cmdhelpers.RESTConfigFromEnv()
 	if err != nil {
 		log.Error(err, "Failed to create config from KUBECONFIG")
 		return err
@@ -460,7 +754,13 @@ func RunDelete(do *DeleteOptions) error {
 	s.Start()
 
 	start := time.Now()
-	err = cluster.Spec.CleanupInfrastructure()
+	if err = cluster.LoadSecrets(context.TODO(), kClient); err == nil {
+		cloudConfig := deleteCloudConfiguration(clusterName, &cluster.Spec.CloudConfiguration)
+		var provider azhelpers.Provider
+		if provider, err = azhelpers.NewProvider(cloudConfig); err == nil {
+			err = cluster.Spec.CleanupInfrastructure(provider)
+		}
+	}
 	s.Stop()
 
 	if err != nil {
@@ -486,3 +786,12 @@ func kubectlApplyResources(kubeconfig string, isDevlopment bool) error {
 	}
 	return nil
 }
+
+// writePrivateFile writes local state readable only by the current user
+func writePrivateFile(filename string, data []byte) error {
+	if err := ioutil.WriteFile(filename, data, 0600); err != nil {
+		return err
+	}
+	// WriteFile keeps the permissions of existing files
+	return os.Chmod(filename, 0600)
+}
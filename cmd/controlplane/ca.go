@@ -0,0 +1,149 @@
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"io/ioutil"
+	"time"
+
+	enginev1alpha1 "github.com/awesomenix/azk/api/v1alpha1"
+	"github.com/awesomenix/azk/bootstrap"
+	"github.com/briandowns/spinner"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// RotateCA asks the cluster controller to rotate the cluster CA and follows the rotation, the local
+// cluster state and customer kubeconfig pick up every CA the controller introduces or retires, so
+// azk keeps access to the cluster throughout
+func RotateCA(rcao *RotateCAOptions) error {
+	ctx := context.TODO()
+
+	h := fnv.New64a()
+	h.Write([]byte(fmt.Sprintf("%s/%s", rcao.SubscriptionID, rcao.ResourceGroup)))
+	clusterName := fmt.Sprintf("%x", h.Sum64())
+
+	spec, err := readBootstrapSpec(clusterName)
+	if err != nil {
+		return err
+	}
+	if spec.ClusterName == "" {
+		spec.ClusterName = clusterName
+	}
+	if err := spec.RenewCustomerKubeConfig(); err != nil {
+		log.Error(err, "Failed to renew customer kubeconfig")
+		return err
+	}
+	kClient, err := newKubeClient(spec.CustomerKubeConfig)
+	if err != nil {
+		log.Error(err, "Failed to create kube client from config")
+		return err
+	}
+
+	cluster := &enginev1alpha1.Cluster{}
+	if err := kClient.Get(ctx, types.NamespacedName{Namespace: clusterName, Name: clusterName}, cluster); err != nil {
+		log.Error(err, "Failed to get cluster", "Name", clusterName)
+		return err
+	}
+	if cluster.Spec.CertificatesRef.Name == "" {
+		return fmt.Errorf("cannot rotate CA of %s, its certificates are not stored in a Secret yet", clusterName)
+	}
+
+	// resume a rotation which was requested before, otherwise request a new one
+	requested := cluster.Annotations[enginev1alpha1.RotateCAAnnotation]
+	if rotation := cluster.Status.CARotation; requested == "" ||
+		(rotation != nil && rotation.Requested == requested && rotation.Phase == enginev1alpha1.CARotationCompleted) {
+		requested = time.Now().UTC().Format(time.RFC3339)
+		if cluster.Annotations == nil {
+			cluster.Annotations = map[string]string{}
+		}
+		cluster.Annotations[enginev1alpha1.RotateCAAnnotation] = requested
+		if err := kClient.Update(ctx, cluster); err != nil {
+			log.Error(err, "Failed to request CA rotation", "Name", clusterName)
+			return err
+		}
+	}
+
+	s := spinner.New(spinner.CharSets[11], 200*time.Millisecond)
+	s.Color("green")
+	s.Suffix = fmt.Sprintf(" Rotating CA of Cluster %s .. timeout 2h0m0s", clusterName)
+	s.Start()
+
+	start := time.Now()
+	for i := 0; i < 240; i++ {
+		time.Sleep(30 * time.Second)
+		if err := kClient.Get(ctx, types.NamespacedName{Namespace: clusterName, Name: clusterName}, cluster); err != nil {
+			continue
+		}
+		rotation := cluster.Status.CARotation
+		if rotation == nil || rotation.Requested != requested {
+			continue
+		}
+		s.Suffix = fmt.Sprintf(" Rotating CA of Cluster %s, %s .. timeout 2h0m0s", clusterName, rotation.Phase)
+		if rotation.Message != "" {
+			s.Suffix += ", " + rotation.Message
+		}
+
+		changed, err := loadClusterCA(ctx, kClient, cluster, spec)
+		if err != nil {
+			continue
+		}
+		if changed {
+			if kClient, err = renewRotationKubeConfig(spec, rcao.KubeconfigOutput); err != nil {
+				s.Stop()
+				return err
+			}
+		}
+
+		if rotation.Phase == enginev1alpha1.CARotationCompleted {
+			s.Stop()
+			fmt.Fprintf(s.Writer, " ✓ Successfully Rotated CA of Cluster %s in %s\n", clusterName, time.Since(start))
+			if rcao.KubeconfigOutput != "" {
+				fmt.Printf(" ✓ Customer kubeconfig %s-%s issued by the new CA\n", rcao.KubeconfigOutput, clusterName)
+			}
+			return nil
+		}
+	}
+	s.Stop()
+
+	fmt.Fprintf(s.Writer, " ✗ Failed to Rotate CA of Cluster %s timedout\n", clusterName)
+
+	return fmt.Errorf("timed out rotating CA of %s", clusterName)
+}
+
+// loadClusterCA reads the CAs and discovery hashes the cluster controller stored during the
+// rotation into the spec, returns true if they changed
+func loadClusterCA(ctx context.Context, kClient client.Client, cluster *enginev1alpha1.Cluster, spec *bootstrap.Spec) (bool, error) {
+	secret := &corev1.Secret{}
+	if err := kClient.Get(ctx, types.NamespacedName{Namespace: cluster.Namespace, Name: cluster.Spec.CertificatesRef.Name}, secret); err != nil {
+		return false, err
+	}
+	bundle := spec.CATrustBundle()
+	caCertificate := spec.CACertificate
+	// the next and previous CA are only stored while they are part of the rotation
+	spec.NextCACertificate = ""
+	spec.NextCACertificateKey = ""
+	spec.PreviousCACertificate = ""
+	spec.LoadCertificates(secret.Data)
+	spec.DiscoveryHashes = append([]string(nil), cluster.Spec.DiscoveryHashes...)
+	return spec.CATrustBundle() != bundle || spec.CACertificate != caCertificate, nil
+}
+
+// renewRotationKubeConfig issues a customer kubeconfig from the current CA trusting every CA of the
+// rotation, stores it with the local cluster state and returns a client using it
+func renewRotationKubeConfig(spec *bootstrap.Spec, kubeconfigOutput string) (client.Client, error) {
+	if err := spec.RenewCustomerKubeConfig(); err != nil {
+		return nil, err
+	}
+	if err := writeBootstrapSpec(spec); err != nil {
+		return nil, err
+	}
+	if kubeconfigOutput != "" {
+		if err := ioutil.WriteFile(kubeconfigOutput+"-"+spec.ClusterName, []byte(spec.CustomerKubeConfig), 0600); err != nil {
+			return nil, err
+		}
+	}
+	return newKubeClient(spec.CustomerKubeConfig)
+}
@@ -0,0 +1,174 @@
+package controlplane
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io/ioutil"
+	"os"
+	"time"
+
+	enginev1alpha1 "github.com/awesomenix/azk/api/v1alpha1"
+	"github.com/awesomenix/azk/bootstrap"
+	cmdhelpers "github.com/awesomenix/azk/cmd/helpers"
+	"github.com/briandowns/spinner"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// bootstrapSpecPath is the local state azk create cluster stored for the cluster
+func bootstrapSpecPath(clusterName string) string {
+	return os.Getenv("HOME") + "/.azk/" + clusterName + "/bootstrapspec.json"
+}
+
+// readBootstrapSpec reads the PKI and credentials stored when the cluster was created
+func readBootstrapSpec(clusterName string) (*bootstrap.Spec, error) {
+	buf, err := ioutil.ReadFile(bootstrapSpecPath(clusterName))
+	if err != nil {
+		return nil, fmt.Errorf("cannot read cluster state of %s: %v", clusterName, err)
+	}
+	spec := &bootstrap.Spec{}
+	if err := json.Unmarshal(buf, spec); err != nil {
+		return nil, fmt.Errorf("cannot parse cluster state of %s: %v", clusterName, err)
+	}
+	return spec, nil
+}
+
+// writeBootstrapSpec stores the renewed PKI and kubeconfigs of the cluster
+func writeBootstrapSpec(spec *bootstrap.Spec) error {
+	jsonSpec, err := json.Marshal(spec)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(bootstrapSpecPath(spec.ClusterName), jsonSpec, 0600)
+}
+
+// newKubeClient creates a client of the cluster from a kubeconfig
+func newKubeClient(kubeconfig string) (client.Client, error) {
+	cfg, err := cmdhelpers.RESTConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	return client.New(cfg, client.Options{})
+}
+
+// RenewCertificates renews the customer kubeconfig with the cluster CA stored locally, so it works
+// with an already expired kubeconfig, then asks the control plane controller to renew the
+// certificates of every master and waits for it
+func RenewCertificates(rco *RenewCertificatesOptions) error {
+	ctx := context.TODO()
+
+	h := fnv.New64a()
+	h.Write([]byte(fmt.Sprintf("%s/%s", rco.SubscriptionID, rco.ResourceGroup)))
+	clusterName := fmt.Sprintf("%x", h.Sum64())
+
+	spec, err := readBootstrapSpec(clusterName)
+	if err != nil {
+		return err
+	}
+	if spec.ClusterName == "" {
+		spec.ClusterName = clusterName
+	}
+
+	if err := spec.RenewCustomerKubeConfig(); err != nil {
+		log.Error(err, "Failed to renew customer kubeconfig")
+		return err
+	}
+	if err := writeBootstrapSpec(spec); err != nil {
+		return err
+	}
+	if rco.KubeconfigOutput != "" {
+		if err := ioutil.WriteFile(rco.KubeconfigOutput+"-"+clusterName, []byte(spec.CustomerKubeConfig), 0600); err != nil {
+			return err
+		}
+		fmt.Printf(" ✓ Renewed customer kubeconfig %s-%s\n", rco.KubeconfigOutput, clusterName)
+	}
+
+	kClient, err := newKubeClient(spec.CustomerKubeConfig)
+	if err != nil {
+		log.Error(err, "Failed to create kube client from config")
+		return err
+	}
+
+	if err := updateCustomerKubeConfig(ctx, kClient, spec); err != nil {
+		log.Error(err, "Failed to update cluster kubeconfig", "Name", clusterName)
+		return err
+	}
+
+	cp := &enginev1alpha1.ControlPlane{}
+	if err := kClient.Get(ctx, types.NamespacedName{Namespace: clusterName, Name: clusterName}, cp); err != nil {
+		log.Error(err, "Failed to get control plane", "Name", clusterName)
+		return err
+	}
+	renewal := time.Now().UTC().Format(time.RFC3339)
+	if cp.Annotations == nil {
+		cp.Annotations = map[string]string{}
+	}
+	cp.Annotations[enginev1alpha1.RenewCertificatesAnnotation] = renewal
+	if err := kClient.Update(ctx, cp); err != nil {
+		log.Error(err, "Failed to request certificate renewal", "Name", clusterName)
+		return err
+	}
+
+	s := spinner.New(spinner.CharSets[11], 200*time.Millisecond)
+	s.Color("green")
+	s.Suffix = fmt.Sprintf(" Renewing certificates of ControlPlane %s .. timeout 30m0s", clusterName)
+	s.Start()
+
+	start := time.Now()
+	for i := 0; i < 60; i++ {
+		time.Sleep(30 * time.Second)
+		if err := kClient.Get(ctx, types.NamespacedName{Namespace: clusterName, Name: clusterName}, cp); err != nil {
+			continue
+		}
+		if cp.Status.CertificatesRenewal == renewal {
+			s.Stop()
+			expiry := ""
+			if cp.Status.CertificatesNotAfter != nil {
+				expiry = ", valid until " + cp.Status.CertificatesNotAfter.Format(time.RFC3339)
+			}
+			fmt.Fprintf(s.Writer, " ✓ Successfully Renewed certificates of ControlPlane %s in %s%s\n", clusterName, time.Since(start), expiry)
+			return nil
+		}
+		checked := cp.Status.CertificatesCheckedTime
+		if c := enginev1alpha1.FindCondition(cp.Status.Conditions, enginev1alpha1.CertificatesExpiringCondition); c != nil &&
+			c.Reason == "RenewFailed" && checked != nil && checked.After(start) {
+			s.Stop()
+			fmt.Fprintf(s.Writer, " ✗ Failed to Renew certificates %s\n", c.Message)
+			return fmt.Errorf("cannot renew certificates: %s", c.Message)
+		}
+	}
+	s.Stop()
+
+	fmt.Fprintf(s.Writer, " ✗ Failed to Renew certificates of ControlPlane %s timedout\n", clusterName)
+
+	return fmt.Errorf("timed out renewing certificates of %s", clusterName)
+}
+
+// updateCustomerKubeConfig stores the renewed customer kubeconfig in the cluster, in the kubeconfig
+// Secret or inlined in the spec of clusters created before secrets were referenced
+func updateCustomerKubeConfig(ctx context.Context, kClient client.Client, spec *bootstrap.Spec) error {
+	clusterName := spec.ClusterName
+	cluster := &enginev1alpha1.Cluster{}
+	if err := kClient.Get(ctx, types.NamespacedName{Namespace: clusterName, Name: clusterName}, cluster); err != nil {
+		return err
+	}
+	if cluster.Spec.KubeconfigRef.Name == "" {
+		cluster.Spec.CustomerKubeConfig = spec.CustomerKubeConfig
+		return kClient.Update(ctx, cluster)
+	}
+
+	secret := &corev1.Secret{}
+	if err := kClient.Get(ctx, types.NamespacedName{Namespace: clusterName, Name: cluster.Spec.KubeconfigRef.Name}, secret); err != nil {
+		return err
+	}
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	for key, value := range spec.KubeconfigSecretData() {
+		secret.Data[key] = value
+	}
+	return kClient.Update(ctx, secret)
+}
@@ -8,12 +8,13 @@ import (
 	"time"
 
 	enginev1alpha1 "github.com/awesomenix/azk/api/v1alpha1"
+	cmdhelpers "github.com/awesomenix/azk/cmd/helpers"
 	"github.com/awesomenix/azk/helpers"
 	"github.com/briandowns/spinner"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
 )
@@ -29,6 +30,46 @@ func init() {
 
 	// Optional flags
 	CreateControlPlaneCmd.Flags().StringVarP(&ccpo.MasterKubernetesVersion, "kubernetesversion", "k", "stable", "Master Kubernetes version, Optional, Uses Stable version as default.")
+	CreateControlPlaneCmd.Flags().Int32VarP(&ccpo.Count, "count", "c", enginev1alpha1.DefaultControlPlaneReplicas, "Control plane node count, one of 1, 3 or 5, Optional, default 3")
+
+	// Scale
+	ScaleControlPlaneCmd.Flags().StringVarP(&scpo.SubscriptionID, "subscriptionid", "s", "", "SubscriptionID Required.")
+	ScaleControlPlaneCmd.MarkFlagRequired("subscriptionid")
+	ScaleControlPlaneCmd.Flags().StringVarP(&scpo.ResourceGroup, "resourcegroup", "r", "", "Resource Group Name, in which all resources are created Required.")
+	ScaleControlPlaneCmd.MarkFlagRequired("resourcegroup")
+	ScaleControlPlaneCmd.Flags().Int32VarP(&scpo.Count, "count", "c", enginev1alpha1.DefaultControlPlaneReplicas, "Control plane node count, one of 1, 3 or 5 Required.")
+	ScaleControlPlaneCmd.MarkFlagRequired("count")
+
+	// Restore
+	RestoreControlPlaneCmd.Flags().StringVarP(&rcpo.SubscriptionID, "subscriptionid", "s", "", "SubscriptionID Required.")
+	RestoreControlPlaneCmd.MarkFlagRequired("subscriptionid")
+	RestoreControlPlaneCmd.Flags().StringVarP(&rcpo.ResourceGroup, "resourcegroup", "r", "", "Resource Group Name, in which all resources are created Required.")
+	RestoreControlPlaneCmd.MarkFlagRequired("resourcegroup")
+	RestoreControlPlaneCmd.Flags().StringVar(&rcpo.Snapshot, "snapshot", "", "Etcd snapshot file or blob url, blob urls without a shared access signature are signed with the cluster credentials Required.")
+	RestoreControlPlaneCmd.MarkFlagRequired("snapshot")
+
+	// Optional flags
+	RestoreControlPlaneCmd.Flags().StringVarP(&rcpo.MasterKubernetesVersion, "kubernetesversion", "k", "", "Kubernetes version of the snapshotted control plane, Optional, defaults to the version the cluster was created with.")
+	RestoreControlPlaneCmd.Flags().StringVar(&rcpo.StorageAccount, "storage-account", "", "Storage account a snapshot file is uploaded to, Required for snapshot files.")
+	RestoreControlPlaneCmd.Flags().StringVar(&rcpo.StorageResourceGroup, "storage-resourcegroup", "", "Resource group of the storage account, Optional, defaults to the cluster resource group.")
+
+	// Renew
+	RenewCertificatesCmd.Flags().StringVarP(&rco.SubscriptionID, "subscriptionid", "s", "", "SubscriptionID Required.")
+	RenewCertificatesCmd.MarkFlagRequired("subscriptionid")
+	RenewCertificatesCmd.Flags().StringVarP(&rco.ResourceGroup, "resourcegroup", "r", "", "Resource Group Name, in which all resources are created Required.")
+	RenewCertificatesCmd.MarkFlagRequired("resourcegroup")
+
+	// Optional flags
+	RenewCertificatesCmd.Flags().StringVarP(&rco.KubeconfigOutput, "kubeconfigout", "o", "kubeconfig", "Where to output the renewed kubeconfig, Optional.")
+
+	// Rotate
+	RotateCACmd.Flags().StringVarP(&rcao.SubscriptionID, "subscriptionid", "s", "", "SubscriptionID Required.")
+	RotateCACmd.MarkFlagRequired("subscriptionid")
+	RotateCACmd.Flags().StringVarP(&rcao.ResourceGroup, "resourcegroup", "r", "", "Resource Group Name, in which all resources are created Required.")
+	RotateCACmd.MarkFlagRequired("resourcegroup")
+
+	// Optional flags
+	RotateCACmd.Flags().StringVarP(&rcao.KubeconfigOutput, "kubeconfigout", "o", "kubeconfig", "Where to output the kubeconfig issued by the new CA, Optional.")
 
 	// Upgrade
 	UpgradeControlPlaneCmd.Flags().StringVarP(&ucpo.SubscriptionID, "subscriptionid", "s", "", "SubscriptionID Required.")
@@ -52,6 +93,54 @@ var CreateControlPlaneCmd = &cobra.Command{
 	},
 }
 
+var ScaleControlPlaneCmd = &cobra.Command{
+	Use:   "controlplane",
+	Short: "Scale kubernetes control plane",
+	Long:  `Scale a kubernetes control plane to 1, 3 or 5 nodes with one command`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := ScaleControlPlane(scpo); err != nil {
+			log.Error(err, "Failed to scale control plane")
+			os.Exit(1)
+		}
+	},
+}
+
+var RestoreControlPlaneCmd = &cobra.Command{
+	Use:   "controlplane",
+	Short: "Restore kubernetes control plane from an etcd snapshot",
+	Long:  `Recreate the masters of a kubernetes control plane from an etcd snapshot, using the cluster state stored by azk create cluster`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := RestoreControlPlane(rcpo); err != nil {
+			log.Error(err, "Failed to restore control plane")
+			os.Exit(1)
+		}
+	},
+}
+
+var RenewCertificatesCmd = &cobra.Command{
+	Use:   "certs",
+	Short: "Renew kubernetes control plane certificates",
+	Long:  `Renew the certificates of every master and the customer kubeconfig, using the cluster CA stored by azk create cluster`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := RenewCertificates(rco); err != nil {
+			log.Error(err, "Failed to renew certificates")
+			os.Exit(1)
+		}
+	},
+}
+
+var RotateCACmd = &cobra.Command{
+	Use:   "ca",
+	Short: "Rotate the kubernetes cluster CA",
+	Long:  `Replace the cluster CA with a new one, re-issuing the control plane and kubelet certificates and rolling every node. The front proxy and etcd CAs are not rotated`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := RotateCA(rcao); err != nil {
+			log.Error(err, "Failed to rotate CA")
+			os.Exit(1)
+		}
+	},
+}
+
 var UpgradeControlPlaneCmd = &cobra.Command{
 	Use:   "controlplane",
 	Short: "Upgrade kubernetes control plane",
@@ -68,6 +157,34 @@ type CreateControlPlaneOptions struct {
 	SubscriptionID          string
 	ResourceGroup           string
 	MasterKubernetesVersion string
+	Count                   int32
+}
+
+type ScaleControlPlaneOptions struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Count          int32
+}
+
+type RestoreControlPlaneOptions struct {
+	SubscriptionID          string
+	ResourceGroup           string
+	Snapshot                string
+	MasterKubernetesVersion string
+	StorageAccount          string
+	StorageResourceGroup    string
+}
+
+type RenewCertificatesOptions struct {
+	SubscriptionID   string
+	ResourceGroup    string
+	KubeconfigOutput string
+}
+
+type RotateCAOptions struct {
+	SubscriptionID   string
+	ResourceGroup    string
+	KubeconfigOutput string
 }
 
 type UpgradeControlPlaneOptions struct {
@@ -77,9 +194,17 @@ type UpgradeControlPlaneOptions struct {
 }
 
 var ccpo = &CreateControlPlaneOptions{}
+var scpo = &ScaleControlPlaneOptions{}
+var rcpo = &RestoreControlPlaneOptions{}
+var rco = &RenewCertificatesOptions{}
+var rcao = &RotateCAOptions{}
 var ucpo = &UpgradeControlPlaneOptions{}
 
 func CreateControlPlane(ccpo *CreateControlPlaneOptions) error {
+	if err := enginev1alpha1.ValidateControlPlaneReplicas(ccpo.Count); err != nil {
+		return err
+	}
+
 	kubernetesVersion, err := helpers.GetKubernetesVersion(ccpo.MasterKubernetesVersion)
 	if err != nil {
 		log.Error(err, "Failed to determine valid kubernetes version")
@@ -89,7 +214,7 @@ func CreateControlPlane(ccpo *CreateControlPlaneOptions) error {
 
 	// Get a config to talk to the apiserver
 	log.Info("setting up client for create")
-	cfg, err := clientcmd.BuildConfigFromFlags("", os.Getenv("KUBECONFIG"))
+	cfg, err := cmdhelpers.RESTConfigFromEnv()
 	if err != nil {
 		log.Error(err, "Failed to create config from KUBECONFIG")
 		return err
@@ -118,6 +243,8 @@ func CreateControlPlane(ccpo *CreateControlPlaneOptions) error {
 		},
 		Spec: enginev1alpha1.ControlPlaneSpec{
 			KubernetesVersion: ccpo.MasterKubernetesVersion,
+			Replicas:          &ccpo.Count,
+			ClusterRef:        corev1.LocalObjectReference{Name: cluster.Name},
 		},
 	}
 
@@ -153,9 +280,73 @@ func CreateControlPlane(ccpo *CreateControlPlaneOptions) error {
 	return nil
 }
 
+func ScaleControlPlane(scpo *ScaleControlPlaneOptions) error {
+	if err := enginev1alpha1.ValidateControlPlaneReplicas(scpo.Count); err != nil {
+		return err
+	}
+
+	log.Info("setting up client for scale")
+	cfg, err := cmdhelpers.RESTConfigFromEnv()
+	if err != nil {
+		log.Error(err, "Failed to create config from KUBECONFIG")
+		return err
+	}
+
+	kClient, err := client.New(cfg, client.Options{})
+	if err != nil {
+		log.Error(err, "Failed to create kube client from config")
+		return err
+	}
+
+	h := fnv.New64a()
+	h.Write([]byte(fmt.Sprintf("%s/%s", scpo.SubscriptionID, scpo.ResourceGroup)))
+	clusterName := fmt.Sprintf("%x", h.Sum64())
+
+	cp := &enginev1alpha1.ControlPlane{}
+	if err := kClient.Get(context.TODO(), types.NamespacedName{Namespace: clusterName, Name: clusterName}, cp); err != nil {
+		log.Error(err, "Failed to get control plane", "Name", clusterName)
+		return err
+	}
+
+	s := spinner.New(spinner.CharSets[11], 200*time.Millisecond)
+	s.Color("green")
+	s.Suffix = fmt.Sprintf(" Scaling ControlPlane %s from %d to %d .. timeout 15m0s", cp.Name, cp.Spec.DesiredReplicas(), scpo.Count)
+	s.Start()
+
+	cp.Spec.Replicas = &scpo.Count
+	if err := kClient.Update(context.TODO(), cp); err != nil {
+		s.Stop()
+		log.Error(err, "Failed to scale control plane", "Name", clusterName)
+		return err
+	}
+
+	start := time.Now()
+	cp = &enginev1alpha1.ControlPlane{}
+	for i := 0; i < 30; i++ {
+		if err := kClient.Get(context.TODO(), types.NamespacedName{Namespace: clusterName, Name: clusterName}, cp); err == nil {
+			if cp.Status.ProvisioningState == "Succeeded" &&
+				cp.Status.Replicas == scpo.Count {
+				s.Stop()
+				fmt.Fprintf(s.Writer, " ✓ Successfully Scaled Control Plane %s in %s\n", clusterName, time.Since(start))
+				return nil
+			}
+			if c := enginev1alpha1.FindCondition(cp.Status.Conditions, enginev1alpha1.ScalingInProgressCondition); c != nil && c.Reason == "ScaleDownFailed" {
+				// scale down is refused while it would break etcd quorum, report why while retrying
+				s.Suffix = fmt.Sprintf(" Scaling ControlPlane %s to %d, waiting: %s", clusterName, scpo.Count, c.Message)
+			}
+		}
+		time.Sleep(30 * time.Second)
+	}
+	s.Stop()
+
+	fmt.Fprintf(s.Writer, " ✗ Failed to Scale Control Plane %s, timedout\n", clusterName)
+
+	return nil
+}
+
 func UpgradeControlPlane(ucpo *UpgradeControlPlaneOptions) error {
 	log.Info("setting up client for upgrade")
-	cfg, err := clientcmd.BuildConfigFromFlags("", os.Getenv("KUBECONFIG"))
+	cfg, err := cmdhelpers.RESTConfigFromEnv()
 	if err != nil {
 		log.Error(err, "Failed to create config from KUBECONFIG")
 		return err
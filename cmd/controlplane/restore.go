@@ -0,0 +1,266 @@
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	enginev1alpha1 "github.com/awesomenix/azk/api/v1alpha1"
+	azhelpers "github.com/awesomenix/azk/azure"
+	"github.com/awesomenix/azk/backup"
+	cmdhelpers "github.com/awesomenix/azk/cmd/helpers"
+	"github.com/awesomenix/azk/helpers"
+	"github.com/briandowns/spinner"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	masterVmssName = "azk-master-vmss"
+	// restoreContainer holds snapshot files uploaded for a restore
+	restoreContainer = "azk-restore"
+	// restoreSASExpiry leaves the restored master time to boot and download the snapshot
+	restoreSASExpiry = 24 * time.Hour
+)
+
+// RestoreControlPlane replaces the masters with a single master restoring the etcd snapshot, the
+// control plane controller in the restored cluster then joins the remaining masters
+func RestoreControlPlane(rcpo *RestoreControlPlaneOptions) error {
+	ctx := context.TODO()
+
+	h := fnv.New64a()
+	h.Write([]byte(fmt.Sprintf("%s/%s", rcpo.SubscriptionID, rcpo.ResourceGroup)))
+	clusterName := fmt.Sprintf("%x", h.Sum64())
+
+	// the masters and the cluster objects are lost, the PKI and credentials are read from the
+	// local state stored when the cluster was created
+	spec, err := readBootstrapSpec(clusterName)
+	if err != nil {
+		return err
+	}
+	if !spec.CloudConfiguration.IsValid() {
+		return fmt.Errorf("cluster state of %s has no azure credentials", clusterName)
+	}
+	spec.CloudConfiguration.UserAgent = "azk"
+
+	kubernetesVersion := rcpo.MasterKubernetesVersion
+	if kubernetesVersion == "" {
+		kubernetesVersion = spec.BootstrapKubernetesVersion
+	}
+
+	snapshotURL, err := restoreSnapshotURL(ctx, &spec.CloudConfiguration, clusterName, rcpo)
+	if err != nil {
+		log.Error(err, "Failed to access snapshot", "Snapshot", rcpo.Snapshot)
+		return err
+	}
+
+	provider, err := azhelpers.NewProvider(&spec.CloudConfiguration)
+	if err != nil {
+		log.Error(err, "Failed to create azure provider")
+		return err
+	}
+
+	lostMasters, err := masterComputerNames(ctx, provider)
+	if err != nil {
+		log.Error(err, "Failed to list masters", "VMSS", masterVmssName)
+		return err
+	}
+
+	s := spinner.New(spinner.CharSets[11], 200*time.Millisecond)
+	s.Color("green")
+	s.Suffix = fmt.Sprintf(" Recreating %s from snapshot %s", masterVmssName, rcpo.Snapshot)
+	s.Start()
+	start := time.Now()
+	err = spec.RestoreInfrastructure(provider, kubernetesVersion, snapshotURL)
+	s.Stop()
+	if err != nil {
+		fmt.Fprintf(s.Writer, " ✗ Failed to recreate %s %v\n", masterVmssName, err)
+		return err
+	}
+	fmt.Fprintf(s.Writer, " ✓ Successfully recreated %s in %s\n", masterVmssName, time.Since(start))
+
+	masters, err := masterComputerNames(ctx, provider)
+	if err != nil {
+		return err
+	}
+	if len(masters) != 1 {
+		return fmt.Errorf("expected a single restored master in %s, found %d", masterVmssName, len(masters))
+	}
+	restoredMaster := masters[0]
+
+	cfg, err := cmdhelpers.RESTConfig(spec.CustomerKubeConfig)
+	if err != nil {
+		return err
+	}
+
+	s = spinner.New(spinner.CharSets[11], 200*time.Millisecond)
+	s.Color("green")
+	s.Suffix = fmt.Sprintf(" Waiting for restored master %s .. timeout 20m0s", restoredMaster)
+	s.Start()
+	var kClient client.Client
+	var loopErr error
+	for i := 0; i < 120; i++ {
+		if kClient == nil {
+			if kClient, loopErr = client.New(cfg, client.Options{}); loopErr != nil {
+				time.Sleep(10 * time.Second)
+				continue
+			}
+		}
+		var ready bool
+		if _, ready, loopErr = helpers.IsNodeReady(kClient, restoredMaster); loopErr == nil && ready {
+			break
+		}
+		if loopErr == nil {
+			loopErr = fmt.Errorf("restored master %s is not ready", restoredMaster)
+		}
+		time.Sleep(10 * time.Second)
+	}
+	s.Stop()
+	if loopErr != nil {
+		fmt.Fprintf(s.Writer, " ✗ Failed waiting for restored master %v\n", loopErr)
+		return loopErr
+	}
+	fmt.Fprintf(s.Writer, " ✓ Restored master %s is Ready\n", restoredMaster)
+
+	if err := resetControlPlaneStatus(ctx, kClient, clusterName, restoredMaster, lostMasters, kubernetesVersion, rcpo.Snapshot); err != nil {
+		log.Error(err, "Failed to reset control plane status")
+		return err
+	}
+
+	s = spinner.New(spinner.CharSets[11], 200*time.Millisecond)
+	s.Color("green")
+	s.Suffix = fmt.Sprintf(" Rejoining remaining masters of ControlPlane %s .. timeout 15m0s", clusterName)
+	s.Start()
+	cp := &enginev1alpha1.ControlPlane{}
+	for i := 0; i < 30; i++ {
+		time.Sleep(30 * time.Second)
+		if err := kClient.Get(ctx, types.NamespacedName{Namespace: clusterName, Name: clusterName}, cp); err == nil {
+			if cp.Status.ProvisioningState == "Succeeded" &&
+				len(cp.Status.NodeStatus) == int(cp.Spec.DesiredReplicas()) {
+				s.Stop()
+				fmt.Fprintf(s.Writer, " ✓ Successfully Restored Control Plane %s in %s\n", clusterName, time.Since(start))
+				return nil
+			}
+		}
+	}
+	s.Stop()
+
+	fmt.Fprintf(s.Writer, " ✗ Restored Control Plane %s, timedout waiting for the remaining masters to join\n", clusterName)
+	return fmt.Errorf("timed out waiting for the remaining masters of control plane %s to join", clusterName)
+}
+
+// masterComputerNames returns the node names of the instances of the master scale set, none when
+// the scale set was lost
+func masterComputerNames(ctx context.Context, provider azhelpers.Provider) ([]string, error) {
+	vms, err := provider.ListVMSSVMs(ctx, masterVmssName)
+	if err != nil {
+		if azhelpers.ResourceNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("cannot list the instances of %s: %v", masterVmssName, err)
+	}
+	var names []string
+	for _, vm := range vms {
+		if vm.OsProfile == nil || vm.OsProfile.ComputerName == nil {
+			continue
+		}
+		names = append(names, strings.ToLower(*vm.OsProfile.ComputerName))
+	}
+	return names, nil
+}
+
+// restoreSnapshotURL returns a url the restored master downloads the snapshot from, snapshot files
+// are uploaded to the storage account first
+func restoreSnapshotURL(ctx context.Context, cloudConfig *azhelpers.CloudConfiguration, clusterName string, rcpo *RestoreControlPlaneOptions) (string, error) {
+	if strings.HasPrefix(rcpo.Snapshot, "https://") {
+		account, container, name, err := azhelpers.ParseBlobURL(rcpo.Snapshot)
+		if err != nil {
+			return "", err
+		}
+		if strings.Contains(rcpo.Snapshot, "?") {
+			return rcpo.Snapshot, nil
+		}
+		containerURL, sasToken, err := cloudConfig.GetBlobContainerSAS(ctx, rcpo.StorageResourceGroup, account, container, "r", restoreSASExpiry)
+		if err != nil {
+			return "", err
+		}
+		return containerURL + "/" + name + "?" + sasToken, nil
+	}
+
+	if rcpo.StorageAccount == "" {
+		return "", fmt.Errorf("--storage-account is required to restore from snapshot file %s", rcpo.Snapshot)
+	}
+	f, err := os.Open(rcpo.Snapshot)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return "", err
+	}
+
+	containerURL, sasToken, err := cloudConfig.GetBlobContainerSAS(ctx, rcpo.StorageResourceGroup, rcpo.StorageAccount, restoreContainer, "rw", time.Hour)
+	if err != nil {
+		return "", err
+	}
+	name := fmt.Sprintf("%s-%s", clusterName, filepath.Base(rcpo.Snapshot))
+	log.Info("Uploading snapshot", "Snapshot", rcpo.Snapshot, "Size", info.Size(), "Container", containerURL)
+	if _, err := backup.NewBlobStore(containerURL, sasToken).Put(ctx, name, f, info.Size()); err != nil {
+		return "", err
+	}
+
+	// the restored master only needs to read the snapshot
+	containerURL, sasToken, err = cloudConfig.GetBlobContainerSAS(ctx, rcpo.StorageResourceGroup, rcpo.StorageAccount, restoreContainer, "r", restoreSASExpiry)
+	if err != nil {
+		return "", err
+	}
+	return containerURL + "/" + name + "?" + sasToken, nil
+}
+
+// resetControlPlaneStatus deletes the nodes of the lost masters and forgets their etcd members, so
+// the control plane controller sees a single master and scales back to the desired replicas. The
+// lost masters are the instances of the master scale set before the restore and the masters the
+// restored control plane status knows of.
+func resetControlPlaneStatus(ctx context.Context, kClient client.Client, clusterName, restoredMaster string, lostMasters []string, kubernetesVersion, snapshot string) error {
+	cp := &enginev1alpha1.ControlPlane{}
+	if err := kClient.Get(ctx, types.NamespacedName{Namespace: clusterName, Name: clusterName}, cp); err != nil {
+		return err
+	}
+
+	lost := map[string]bool{}
+	for _, name := range lostMasters {
+		lost[name] = true
+	}
+	for _, vm := range cp.Status.NodeStatus {
+		lost[strings.ToLower(vm.VMComputerName)] = true
+	}
+	delete(lost, restoredMaster)
+	for name := range lost {
+		node := &corev1.Node{}
+		node.Name = name
+		log.Info("Deleting lost master", "Node", name)
+		if err := kClient.Delete(ctx, node); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	cp.Status.KubernetesVersion = kubernetesVersion
+	cp.Status.ProvisioningState = "Restored"
+	cp.Status.NodeStatus = nil
+	cp.Status.EtcdMembers = nil
+	enginev1alpha1.SetCondition(&cp.Status.Conditions, enginev1alpha1.Condition{
+		Type:               enginev1alpha1.ReadyCondition,
+		Status:             corev1.ConditionFalse,
+		ObservedGeneration: cp.Generation,
+		Reason:             "Restored",
+		Message:            fmt.Sprintf("Restored from %s on %s", snapshot, restoredMaster),
+	})
+	return kClient.Status().Update(ctx, cp)
+}
@@ -0,0 +1,81 @@
+package controlplane
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	enginev1alpha1 "github.com/awesomenix/azk/api/v1alpha1"
+	azhelpers "github.com/awesomenix/azk/azure"
+	azfake "github.com/awesomenix/azk/azure/fake"
+)
+
+func TestMasterComputerNames(t *testing.T) {
+	ctx := context.TODO()
+	provider, err := azfake.NewCloud().Provider(&azhelpers.CloudConfiguration{SubscriptionID: "subscription", GroupName: "group", GroupLocation: "westus2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := provider.CreateOrUpdateResourceGroup(ctx); err != nil {
+		t.Fatal(err)
+	}
+	names, err := masterComputerNames(ctx, provider)
+	if err != nil || len(names) != 0 {
+		t.Fatalf("Expected no masters without the scale set, got %v %v", names, err)
+	}
+	if err := provider.CreateVMSS(ctx, masterVmssName, "", nil, nil, "", "Standard_D2s_v3", 3, 1); err != nil {
+		t.Fatal(err)
+	}
+	names, err = masterComputerNames(ctx, provider)
+	if err != nil || len(names) != 3 {
+		t.Fatalf("Expected the three masters of the scale set, got %v %v", names, err)
+	}
+}
+
+func TestResetControlPlaneStatus(t *testing.T) {
+	ctx := context.TODO()
+	cp := &enginev1alpha1.ControlPlane{ObjectMeta: metav1.ObjectMeta{Namespace: "azk", Name: "azk"}}
+	cp.Status.NodeStatus = []enginev1alpha1.VMStatus{{VMComputerName: "AZK-MASTER-VMSS000001"}, {VMComputerName: "azk-master-vmss000002"}}
+	cp.Status.EtcdMembers = []enginev1alpha1.EtcdMemberStatus{{ID: "a"}}
+	objs := []runtime.Object{cp}
+	for _, name := range []string{"azk-master-vmss000000", "azk-master-vmss000001", "azk-master-vmss000002", "azk-master-vmss000003", "azk-master-vmss-agent000000", "azk-agent000000"} {
+		objs = append(objs, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}})
+	}
+
+	s := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(s)
+	_ = enginev1alpha1.AddToScheme(s)
+	kClient := fake.NewFakeClientWithScheme(s, objs...)
+	if err := resetControlPlaneStatus(ctx, kClient, "azk", "azk-master-vmss000003", []string{"azk-master-vmss000000", "azk-master-vmss000003"}, "1.15.3", "snapshot.db"); err != nil {
+		t.Fatal(err)
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := kClient.List(ctx, nodeList); err != nil {
+		t.Fatal(err)
+	}
+	var nodes []string
+	for _, node := range nodeList.Items {
+		nodes = append(nodes, node.Name)
+	}
+	sort.Strings(nodes)
+	if want := []string{"azk-agent000000", "azk-master-vmss-agent000000", "azk-master-vmss000003"}; !reflect.DeepEqual(nodes, want) {
+		t.Errorf("Expected only the lost masters to be deleted, got %v", nodes)
+	}
+
+	cp = &enginev1alpha1.ControlPlane{}
+	if err := kClient.Get(ctx, types.NamespacedName{Namespace: "azk", Name: "azk"}, cp); err != nil {
+		t.Fatal(err)
+	}
+	if cp.Status.NodeStatus != nil || cp.Status.EtcdMembers != nil || cp.Status.ProvisioningState != "Restored" {
+		t.Errorf("Expected the control plane status to be reset, got %+v", cp.Status)
+	}
+}
@@ -10,10 +10,10 @@ import (
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/subscription/mgmt/subscription"
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/adal"
 	"github.com/Azure/go-autorest/autorest/azure"
+	azhelpers "github.com/awesomenix/azk/azure"
 	"github.com/awesomenix/azk/cmd/cluster"
 	"github.com/blang/semver"
 	"github.com/manifoldco/promptui"
@@ -96,7 +96,15 @@ func RunFlow() error {
 		return err
 	}
 
-	vmsize, err := selectVMSize(subscriptionID, region, clientID, clientSecret)
+	cloudConfig := &azhelpers.CloudConfiguration{
+		CloudName:      azhelpers.AzurePublicCloudName,
+		SubscriptionID: subscriptionID,
+		ClientID:       clientID,
+		ClientSecret:   clientSecret,
+		TenantID:       tenantID,
+		UserAgent:      "azkprompt",
+	}
+	vmsize, err := selectVMSize(cloudConfig, region)
 	if err != nil {
 		return err
 	}
@@ -221,15 +229,17 @@ func selectRegion(subscriptionID, clientID, clientSecret string) (string, error)
 	return result, nil
 }
 
-func selectVMSize(subscriptionID, location, clientID, clientSecret string) (string, error) {
-	vmSizes, err := getVMSizes(subscriptionID, location, clientID, clientSecret)
+func selectVMSize(cloudConfig *azhelpers.CloudConfiguration, location string) (string, error) {
+	// only offer sizes the subscription can create in the region
+	vmSizes, err := cloudConfig.SKUCatalog().AvailableSKUs(context.TODO(), location)
 	if err != nil {
 		return "", err
 	}
-	sort.Strings(vmSizes)
+	if len(vmSizes) == 0 {
+		return "", fmt.Errorf("no vm sizes available in %s", location)
+	}
 	searcher := func(input string, index int) bool {
-		vmSize := vmSizes[index]
-		name := strings.Replace(strings.ToLower(vmSize), " ", "", -1)
+		name := strings.ToLower(vmSizes[index].Name)
 		input = strings.Replace(strings.ToLower(input), " ", "", -1)
 
 		return strings.Contains(name, input)
@@ -241,7 +251,7 @@ func selectVMSize(subscriptionID, location, clientID, clientSecret string) (stri
 		Searcher: searcher,
 	}
 
-	_, result, err := prompt.Run()
+	index, _, err := prompt.Run()
 
 	if err == promptui.ErrInterrupt {
 		os.Exit(-1)
@@ -251,7 +261,7 @@ func selectVMSize(subscriptionID, location, clientID, clientSecret string) (stri
 		fmt.Printf("Prompt failed %v\n", err)
 		return "", err
 	}
-	return result, nil
+	return vmSizes[index].Name, nil
 }
 
 func getLocations(subscriptionID, clientID, clientSecret string) ([]string, error) {
@@ -275,54 +285,6 @@ func getLocations(subscriptionID, clientID, clientSecret string) ([]string, erro
 	return locations, err
 }
 
-// func getResourcesSku(subscriptionID, clientID, clientSecret string) ([]string, error) {
-// 	var locations []string
-// 	resClient := compute.NewResourceSkusClient(subscriptionID)
-// 	a, err := getAuthorizerForResource(subscriptionID, clientID, clientSecret)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-// 	resClient.Authorizer = a
-// 	resClient.AddToUserAgent("azkprompt")
-
-// 	res, err := resClient.List(context.TODO())
-// 	if err != nil {
-// 		return locations, err
-// 	}
-
-// 	for _, resSku := range res.Values() {
-// 		fmt.Println(*resSku.Locations)
-// 		fmt.Println(*resSku.Name)
-// 		for _, locationInfo := range *resSku.LocationInfo {
-// 			fmt.Println(*locationInfo.Location)
-// 			fmt.Println(*locationInfo.Zones)
-// 		}
-
-// 	}
-// 	return nil, nil
-// }
-
-func getVMSizes(subscriptionID, location, clientID, clientSecret string) ([]string, error) {
-	var vmsizes []string
-	vmSizesClient := compute.NewVirtualMachineSizesClient(subscriptionID)
-	a, err := getAuthorizerForResource(subscriptionID, clientID, clientSecret)
-	if err != nil {
-		return nil, err
-	}
-	vmSizesClient.Authorizer = a
-	vmSizesClient.AddToUserAgent("azkprompt")
-
-	res, err := vmSizesClient.List(context.TODO(), location)
-	if err != nil {
-		return vmsizes, err
-	}
-
-	for _, vmsize := range *res.Value {
-		vmsizes = append(vmsizes, *vmsize.Name)
-	}
-	return vmsizes, err
-}
-
 func getAuthorizerForResource(subscriptionID, clientID, clientSecret string) (autorest.Authorizer, error) {
 	env, err := azure.EnvironmentFromName("AzurePublicCloud")
 	if err != nil {
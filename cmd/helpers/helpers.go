@@ -8,7 +8,6 @@ import (
 
 	"github.com/awesomenix/azk/helpers"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
-	"k8s.io/client-go/tools/clientcmd"
 	kubectlapply "k8s.io/kubernetes/pkg/kubectl/cmd/apply"
 	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
@@ -17,7 +16,7 @@ import (
 var log = logf.Log.WithName("azk")
 
 func KubectlApply(manifestPath, kubeconfig string) error {
-	clientcfg, err := clientcmd.NewClientConfigFromBytes([]byte(kubeconfig))
+	clientcfg, err := ClientConfig(kubeconfig)
 	if err != nil {
 		return err
 	}
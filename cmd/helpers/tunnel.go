@@ -0,0 +1,196 @@
+package helpers
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// Jumpbox is [user@]host[:port] of an ssh server reaching the internal api server endpoint of a
+// private cluster, every kubeconfig is tunneled through it when set
+var Jumpbox string
+
+var (
+	tunnelsMu sync.Mutex
+	tunnels   = map[string]string{}
+)
+
+// ClientConfig returns the client config of a kubeconfig. With a jumpbox the api server is reached
+// through a local port forwarded over ssh, private clusters include the loopback address in their
+// api server certificate.
+func ClientConfig(kubeconfig string) (clientcmd.ClientConfig, error) {
+	clientcfg, err := clientcmd.NewClientConfigFromBytes([]byte(kubeconfig))
+	if err != nil || Jumpbox == "" {
+		return clientcfg, err
+	}
+	cfg, err := clientcfg.ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	server, err := url.Parse(cfg.Host)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse api server %s: %v", cfg.Host, err)
+	}
+	remote := server.Host
+	if server.Port() == "" {
+		remote = net.JoinHostPort(server.Hostname(), "443")
+	}
+	local, err := tunnel(Jumpbox, remote)
+	if err != nil {
+		return nil, err
+	}
+	rawConfig, err := clientcfg.RawConfig()
+	if err != nil {
+		return nil, err
+	}
+	return clientcmd.NewDefaultClientConfig(rawConfig, &clientcmd.ConfigOverrides{
+		ClusterInfo: clientcmdapi.Cluster{Server: "https://" + local},
+	}), nil
+}
+
+// RESTConfig returns the rest config of a kubeconfig, tunneled through the jumpbox if set
+func RESTConfig(kubeconfig string) (*rest.Config, error) {
+	clientcfg, err := ClientConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	return clientcfg.ClientConfig()
+}
+
+// RESTConfigFromEnv returns the rest config of the kubeconfig KUBECONFIG points to, tunneled
+// through the jumpbox if set
+func RESTConfigFromEnv() (*rest.Config, error) {
+	if Jumpbox == "" {
+		return clientcmd.BuildConfigFromFlags("", os.Getenv("KUBECONFIG"))
+	}
+	buf, err := ioutil.ReadFile(os.Getenv("KUBECONFIG"))
+	if err != nil {
+		return nil, err
+	}
+	return RESTConfig(string(buf))
+}
+
+// tunnel forwards a local port to the remote address through the jumpbox and returns the local
+// address, tunnels live as long as the cli and are shared by every client of the remote address
+func tunnel(jumpbox, remote string) (string, error) {
+	tunnelsMu.Lock()
+	defer tunnelsMu.Unlock()
+	if local, ok := tunnels[remote]; ok {
+		return local, nil
+	}
+
+	config, address, err := sshClientConfig(jumpbox)
+	if err != nil {
+		return "", err
+	}
+	client, err := ssh.Dial("tcp", address, config)
+	if err != nil {
+		return "", fmt.Errorf("cannot connect to jumpbox %s: %v", jumpbox, err)
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		client.Close()
+		return "", fmt.Errorf("cannot listen for tunnel: %v", err)
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go forward(client, conn, remote)
+		}
+	}()
+
+	local := listener.Addr().String()
+	log.Info("Tunneling", "APIServer", remote, "Jumpbox", jumpbox, "Local", local)
+	tunnels[remote] = local
+	return local, nil
+}
+
+// forward copies a local connection to the remote address dialed from the jumpbox
+func forward(client *ssh.Client, conn net.Conn, remote string) {
+	defer conn.Close()
+	remoteConn, err := client.Dial("tcp", remote)
+	if err != nil {
+		log.Error(err, "Failed to reach api server through jumpbox", "APIServer", remote)
+		return
+	}
+	defer remoteConn.Close()
+
+	done := make(chan struct{}, 2)
+	go func() {
+		io.Copy(remoteConn, conn)
+		done <- struct{}{}
+	}()
+	go func() {
+		io.Copy(conn, remoteConn)
+		done <- struct{}{}
+	}()
+	<-done
+}
+
+// sshClientConfig parses [user@]host[:port] of the jumpbox, it authenticates with the ssh agent
+// and the unencrypted default keys, and verifies the host key against ~/.ssh/known_hosts
+func sshClientConfig(jumpbox string) (*ssh.ClientConfig, string, error) {
+	user, address := os.Getenv("USER"), jumpbox
+	if i := strings.LastIndex(jumpbox, "@"); i >= 0 {
+		user, address = jumpbox[:i], jumpbox[i+1:]
+	}
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(address, "22")
+	}
+	if user == "" || address == ":22" {
+		return nil, "", fmt.Errorf("jumpbox %q is not [user@]host[:port]", jumpbox)
+	}
+
+	var auth []ssh.AuthMethod
+	if socket := os.Getenv("SSH_AUTH_SOCK"); socket != "" {
+		if conn, err := net.Dial("unix", socket); err == nil {
+			auth = append(auth, ssh.PublicKeysCallback(agent.NewClient(conn).Signers))
+		}
+	}
+	sshDir := filepath.Join(os.Getenv("HOME"), ".ssh")
+	var signers []ssh.Signer
+	for _, name := range []string{"id_rsa", "id_ecdsa", "id_ed25519"} {
+		buf, err := ioutil.ReadFile(filepath.Join(sshDir, name))
+		if err != nil {
+			continue
+		}
+		// keys with a passphrase are used through the agent
+		if signer, err := ssh.ParsePrivateKey(buf); err == nil {
+			signers = append(signers, signer)
+		}
+	}
+	if len(signers) > 0 {
+		auth = append(auth, ssh.PublicKeys(signers...))
+	}
+	if len(auth) == 0 {
+		return nil, "", fmt.Errorf("no ssh agent or private key to authenticate to jumpbox %s", jumpbox)
+	}
+
+	hostKeyCallback, err := knownhosts.New(filepath.Join(sshDir, "known_hosts"))
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot read known hosts to verify jumpbox %s: %v", jumpbox, err)
+	}
+	return &ssh.ClientConfig{
+		User:            user,
+		Auth:            auth,
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         30 * time.Second,
+	}, address, nil
+}
@@ -8,12 +8,13 @@ import (
 	"time"
 
 	enginev1alpha1 "github.com/awesomenix/azk/api/v1alpha1"
+	cmdhelpers "github.com/awesomenix/azk/cmd/helpers"
 	"github.com/awesomenix/azk/helpers"
 	"github.com/briandowns/spinner"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
 )
@@ -153,7 +154,7 @@ func CreateNodePool(cnpo *CreateNodePoolOptions) error {
 	cnpo.AgentKubernetesVersion = kubernetesVersion
 
 	log.Info("setting up client for create")
-	cfg, err := clientcmd.BuildConfigFromFlags("", os.Getenv("KUBECONFIG"))
+	cfg, err := cmdhelpers.RESTConfigFromEnv()
 	if err != nil {
 		log.Error(err, "Failed to create config from KUBECONFIG")
 		return err
@@ -178,6 +179,7 @@ func CreateNodePool(cnpo *CreateNodePoolOptions) error {
 			NodeSetSpec: enginev1alpha1.NodeSetSpec{
 				KubernetesVersion: cnpo.AgentKubernetesVersion,
 				Replicas:          &(cnpo.Count),
+				ClusterRef:        corev1.LocalObjectReference{Name: clusterName},
 			},
 		},
 	}
@@ -216,7 +218,7 @@ func CreateNodePool(cnpo *CreateNodePoolOptions) error {
 
 func DeleteNodePool(dnpo *DeleteNodePoolOptions) error {
 	log.Info("setting up client for delete")
-	cfg, err := clientcmd.BuildConfigFromFlags("", os.Getenv("KUBECONFIG"))
+	cfg, err := cmdhelpers.RESTConfigFromEnv()
 	if err != nil {
 		log.Error(err, "Failed to create config from KUBECONFIG")
 		return err
@@ -257,7 +259,7 @@ func DeleteNodePool(dnpo *DeleteNodePoolOptions) error {
 
 func ScaleNodePool(snpo *ScaleNodePoolOptions) error {
 	log.Info("setting up client for scale")
-	cfg, err := clientcmd.BuildConfigFromFlags("", os.Getenv("KUBECONFIG"))
+	cfg, err := cmdhelpers.RESTConfigFromEnv()
 	if err != nil {
 		log.Error(err, "Failed to create config from KUBECONFIG")
 		return err
@@ -312,7 +314,7 @@ func ScaleNodePool(snpo *ScaleNodePoolOptions) error {
 
 func UpgradeNodePool(unpo *UpgradeNodePoolOptions) error {
 	log.Info("setting up client for upgrade")
-	cfg, err := clientcmd.BuildConfigFromFlags("", os.Getenv("KUBECONFIG"))
+	cfg, err := cmdhelpers.RESTConfigFromEnv()
 	if err != nil {
 		log.Error(err, "Failed to create config from KUBECONFIG")
 		return err
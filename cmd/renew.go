@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"github.com/awesomenix/azk/cmd/controlplane"
+	"github.com/spf13/cobra"
+)
+
+var RenewCmd = &cobra.Command{
+	Use: "renew",
+}
+
+func init() {
+	RootCmd.AddCommand(RenewCmd)
+	RenewCmd.AddCommand(controlplane.RenewCertificatesCmd)
+}
@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"github.com/awesomenix/azk/cmd/controlplane"
+	"github.com/spf13/cobra"
+)
+
+var RestoreCmd = &cobra.Command{
+	Use: "restore",
+}
+
+func init() {
+	RootCmd.AddCommand(RestoreCmd)
+	RestoreCmd.AddCommand(controlplane.RestoreControlPlaneCmd)
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 
 	enginev1alpha1 "github.com/awesomenix/azk/api/v1alpha1"
+	cmdhelpers "github.com/awesomenix/azk/cmd/helpers"
 	"github.com/spf13/cobra"
 	"k8s.io/client-go/kubernetes/scheme"
 	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
@@ -42,5 +43,6 @@ func exitWithHelp(cmd *cobra.Command, err string) {
 func init() {
 	enginev1alpha1.AddToScheme(scheme.Scheme)
 	flag.CommandLine.Set("logtostderr", "true")
+	RootCmd.PersistentFlags().StringVar(&cmdhelpers.Jumpbox, "jumpbox", "", "[user@]host[:port] of an ssh server in or peered with the cluster vnet, the api server of private clusters is tunneled through it, e.g. localhost:port of an azure bastion tunnel")
 	//RootCmd.PersistentFlags().AddGoFlagSet(flag.CommandLine)
 }
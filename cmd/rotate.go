@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"github.com/awesomenix/azk/cmd/controlplane"
+	"github.com/spf13/cobra"
+)
+
+var RotateCmd = &cobra.Command{
+	Use: "rotate",
+}
+
+func init() {
+	RootCmd.AddCommand(RotateCmd)
+	RotateCmd.AddCommand(controlplane.RotateCACmd)
+}
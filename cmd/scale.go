@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"github.com/awesomenix/azk/cmd/controlplane"
 	"github.com/awesomenix/azk/cmd/nodepool"
 	"github.com/spf13/cobra"
 )
@@ -11,5 +12,6 @@ var ScaleCmd = &cobra.Command{
 
 func init() {
 	RootCmd.AddCommand(ScaleCmd)
+	ScaleCmd.AddCommand(controlplane.ScaleControlPlaneCmd)
 	ScaleCmd.AddCommand(nodepool.ScaleNodepoolCmd)
 }
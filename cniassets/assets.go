@@ -0,0 +1,9 @@
+// +build dev
+
+package cniassets
+
+import "net/http"
+
+//go:generate vfsgendev -source="github.com/awesomenix/azk/cniassets".Manifests
+// Manifests contains the vendored manifests of the network plugins
+var Manifests http.FileSystem = http.Dir("../cni")
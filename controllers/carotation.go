@@ -0,0 +1,588 @@
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	bootstrapapi "k8s.io/cluster-bootstrap/token/api"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	enginev1alpha1 "github.com/awesomenix/azk/api/v1alpha1"
+	azhelpers "github.com/awesomenix/azk/azure"
+	"github.com/awesomenix/azk/bootstrap"
+	"github.com/awesomenix/azk/helpers"
+)
+
+const (
+	// caRotationDone is printed by the rotation scripts once every command succeeded, run command
+	// reports success for failing scripts
+	caRotationDone = "azk-ca-rotation-done"
+	// caRotationPollPeriod is how often a machine of the current phase is checked to be Ready
+	caRotationPollPeriod = 10 * time.Second
+	// caRotationNodeReadyTimeout is how long a machine may take to be Ready after the phase ran on it,
+	// the phase runs on it again afterwards
+	caRotationNodeReadyTimeout = 10 * time.Minute
+	// caTrustBundleAnnotation is set on the pod template of the system workloads to a hash of the CA
+	// trust bundle, a new value restarts their pods with service account tokens carrying the bundle
+	caTrustBundleAnnotation = "engine.azk.io/ca-trust-bundle"
+)
+
+// caTrustBundleNamespaces are restarted to trust the CA bundle, the in-cluster clients of kube-proxy,
+// the network plugin, coredns and the azk manager read the CA of their service account once on start
+var caTrustBundleNamespaces = []string{metav1.NamespaceSystem, "azk-system"}
+
+// trustCAScript writes the CA trust bundle on a master or worker and points the apiserver, the
+// controller manager, the kubelet and every kubeconfig at it
+func trustCAScript(bundle string) string {
+	return fmt.Sprintf(`
+set -e
+echo '%[1]s' | base64 -d | sudo tee /etc/kubernetes/pki/ca-bundle.crt > /dev/null
+cd /etc/kubernetes
+if [ -f manifests/kube-apiserver.yaml ]; then
+	sudo sed -i 's#--client-ca-file=/etc/kubernetes/pki/ca.crt#--client-ca-file=/etc/kubernetes/pki/ca-bundle.crt#' manifests/kube-apiserver.yaml
+	sudo sed -i 's#--root-ca-file=/etc/kubernetes/pki/ca.crt#--root-ca-file=/etc/kubernetes/pki/ca-bundle.crt#' manifests/kube-controller-manager.yaml
+	kubeconfigs="admin.conf controller-manager.conf scheduler.conf kubelet.conf"
+else
+	sudo cp -f pki/ca-bundle.crt pki/ca.crt
+	kubeconfigs="kubelet.conf"
+fi
+sudo sed -i 's#clientCAFile: /etc/kubernetes/pki/ca.crt#clientCAFile: /etc/kubernetes/pki/ca-bundle.crt#' /var/lib/kubelet/config.yaml
+for f in $kubeconfigs; do
+	cluster=$(sudo kubectl --kubeconfig $f config view -o jsonpath='{.clusters[0].name}')
+	sudo kubectl --kubeconfig $f config set-cluster $cluster --certificate-authority=/etc/kubernetes/pki/ca-bundle.crt --embed-certs=true > /dev/null
+done
+sudo systemctl restart kubelet
+`, base64.StdEncoding.EncodeToString([]byte(bundle)))
+}
+
+// restartControlPlaneScript restarts the control plane containers of a master, which read their
+// CAs only on start, and waits for the apiserver
+const restartControlPlaneScript = `
+for c in kube-apiserver kube-controller-manager kube-scheduler; do
+	sudo docker ps -q --filter name=k8s_${c}_ | xargs -r sudo docker kill > /dev/null
+done
+sleep 10
+for i in $(seq 60); do
+	curl -skf https://localhost:6443/healthz > /dev/null && break
+	sleep 5
+done
+curl -skf https://localhost:6443/healthz > /dev/null
+`
+
+// reissueCAScript installs the cluster CA on a master and reissues every certificate signed by it
+// with the kubeadm of the kubernetes version, the kubelet client certificate is issued right away
+// instead of once it is close to expiry
+func reissueCAScript(caCert, caKey, kubernetesVersion string) (string, error) {
+	renew, err := bootstrap.KubeadmCertsRenewCommand(kubernetesVersion)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`
+set -e
+echo '%[1]s' | base64 -d | sudo tee /etc/kubernetes/pki/ca.crt > /dev/null
+echo '%[2]s' | base64 -d | sudo tee /etc/kubernetes/pki/ca.key > /dev/null
+sudo chmod 600 /etc/kubernetes/pki/ca.key
+for c in apiserver apiserver-kubelet-client admin.conf controller-manager.conf scheduler.conf; do
+	sudo %[3]s $c > /dev/null
+done
+node=$(hostname | tr '[:upper:]' '[:lower:]')
+tmp=$(mktemp -d)
+printf 'keyUsage=critical,digitalSignature,keyEncipherment\nextendedKeyUsage=clientAuth\n' > $tmp/ext
+openssl req -new -newkey rsa:2048 -nodes -keyout $tmp/kubelet.key -subj "/O=system:nodes/CN=system:node:$node" -out $tmp/kubelet.csr 2> /dev/null
+sudo openssl x509 -req -in $tmp/kubelet.csr -CA /etc/kubernetes/pki/ca.crt -CAkey /etc/kubernetes/pki/ca.key -CAcreateserial -CAserial $tmp/ca.srl -days 365 -extfile $tmp/ext -out $tmp/kubelet.crt 2> /dev/null
+pem=/var/lib/kubelet/pki/kubelet-client-$(date +%%Y-%%m-%%d-%%H-%%M-%%S).pem
+cat $tmp/kubelet.crt $tmp/kubelet.key | sudo tee $pem > /dev/null
+sudo chmod 600 $pem
+sudo ln -sf $pem /var/lib/kubelet/pki/kubelet-client-current.pem
+rm -rf $tmp
+`, base64.StdEncoding.EncodeToString([]byte(caCert)),
+		base64.StdEncoding.EncodeToString([]byte(caKey)),
+		renew,
+	), nil
+}
+
+// rejoinNodeScript resets a worker and joins it again, the kubelet bootstraps new certificates
+func rejoinNodeScript(spec *bootstrap.Spec, kubernetesVersion, bootstrapToken string) (string, error) {
+	kubeadmConfig, err := spec.KubeadmJoinConfig(kubernetesVersion, bootstrapToken, false)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`
+set -e
+sudo kubeadm reset -f > /dev/null
+%[1]s
+sudo kubeadm join --config /tmp/kubeadm-config.yaml > /dev/null
+`, kubeadmConfig), nil
+}
+
+// runCommandTail returns the end of the run command output, which carries the error of a failed script
+func runCommandTail(result compute.RunCommandResult) string {
+	var out []string
+	if result.Value != nil {
+		for _, status := range *result.Value {
+			out = append(out, to.String(status.Message))
+		}
+	}
+	tail := strings.TrimSpace(strings.Join(out, "\n"))
+	if len(tail) > 512 {
+		tail = tail[len(tail)-512:]
+	}
+	return tail
+}
+
+// caRotationMachine is a master or worker taking part in a CA rotation
+type caRotationMachine struct {
+	vmssName          string
+	instanceID        string
+	name              string
+	master            bool
+	kubernetesVersion string
+}
+
+// caRotationMachines lists the masters of the control plane followed by the workers of every node set
+func (r *ClusterReconciler) caRotationMachines(ctx context.Context, cluster *enginev1alpha1.Cluster) ([]caRotationMachine, error) {
+	controlPlane, err := getControlPlane(ctx, r.Client, cluster)
+	if err != nil {
+		return nil, err
+	}
+	if controlPlane == nil {
+		return nil, fmt.Errorf("control plane of cluster %s not found", cluster.Name)
+	}
+
+	var machines []caRotationMachine
+	for _, nodeStatus := range controlPlane.Status.NodeStatus {
+		machines = append(machines, caRotationMachine{masterVmssName, nodeStatus.VMInstanceID, nodeStatus.VMComputerName, true, controlPlane.Spec.KubernetesVersion})
+	}
+
+	nodeSetList := enginev1alpha1.NodeSetList{}
+	if err := r.List(ctx, &nodeSetList, client.InNamespace(cluster.Namespace)); err != nil {
+		return nil, err
+	}
+	for _, nodeSet := range nodeSetList.Items {
+		if !isClusterRef(nodeSet.Spec.ClusterRef, cluster.Name) {
+			continue
+		}
+		for _, nodeStatus := range nodeSet.Status.NodeStatus {
+			machines = append(machines, caRotationMachine{nodeSet.Name + "-agentvmss", nodeStatus.VMInstanceID, nodeStatus.VMComputerName, false, nodeSet.Spec.KubernetesVersion})
+		}
+	}
+	return machines, nil
+}
+
+// isCARotationRequested returns true while a requested CA rotation did not complete
+func isCARotationRequested(instance *enginev1alpha1.Cluster) bool {
+	requested := instance.Annotations[enginev1alpha1.RotateCAAnnotation]
+	rotation := instance.Status.CARotation
+	return requested != "" &&
+		(rotation == nil || rotation.Requested != requested || rotation.Phase != enginev1alpha1.CARotationCompleted)
+}
+
+// nextCARotationPhase returns the phase following the given one
+func nextCARotationPhase(phase enginev1alpha1.CARotationPhase) enginev1alpha1.CARotationPhase {
+	switch phase {
+	case enginev1alpha1.CARotationTrusting:
+		return enginev1alpha1.CARotationReissuing
+	case enginev1alpha1.CARotationReissuing:
+		return enginev1alpha1.CARotationRolling
+	case enginev1alpha1.CARotationRolling:
+		return enginev1alpha1.CARotationRetiring
+	}
+	return enginev1alpha1.CARotationCompleted
+}
+
+// reconcileCARotation runs the current phase of the requested CA rotation on every machine and
+// advances to the next phase. Progress is kept in the status, a failed phase resumes with the
+// machines that did not complete it.
+func (r *ClusterReconciler) reconcileCARotation(ctx context.Context, instance *enginev1alpha1.Cluster, cluster *enginev1alpha1.Cluster, provider azhelpers.Provider) (ctrl.Result, error) {
+	log := r.Log.WithValues("cluster", instance.Name)
+
+	requested := instance.Annotations[enginev1alpha1.RotateCAAnnotation]
+	if instance.Status.CARotation == nil || instance.Status.CARotation.Requested != requested {
+		startTime := metav1.Now()
+		instance.Status.CARotation = &enginev1alpha1.CARotationStatus{
+			Requested: requested,
+			Phase:     enginev1alpha1.CARotationTrusting,
+			StartTime: &startTime,
+		}
+		r.EventRecorder.Event(instance, "Normal", "CARotationStarted", requested)
+	}
+	phase := instance.Status.CARotation.Phase
+	setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.CARotationInProgressCondition, corev1.ConditionTrue, string(phase), "")
+	if err := r.Status().Update(ctx, instance); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	log.Info("Rotating CA", "Phase", phase)
+	waiting, err := r.runCARotationPhase(ctx, instance, cluster, provider)
+	if err != nil {
+		instance.Status.CARotation.Message = err.Error()
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.CARotationInProgressCondition, corev1.ConditionTrue, string(phase)+"Failed", err.Error())
+		_ = r.Status().Update(ctx, instance)
+		return ctrl.Result{RequeueAfter: time.Minute}, err
+	}
+
+	rotation := instance.Status.CARotation
+	if waiting {
+		rotation.Message = ""
+		message := "waiting for the system pods to restart trusting the CA bundle"
+		if rotation.WaitingNode != "" {
+			message = fmt.Sprintf("waiting for %s to be ready", rotation.WaitingNode)
+		}
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.CARotationInProgressCondition, corev1.ConditionTrue, string(phase), message)
+		if err := r.Status().Update(ctx, instance); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: caRotationPollPeriod}, nil
+	}
+
+	rotation.Phase = nextCARotationPhase(phase)
+	rotation.Nodes = nil
+	rotation.WaitingNode = ""
+	rotation.WaitingSince = nil
+	rotation.Message = ""
+	if rotation.Phase != enginev1alpha1.CARotationCompleted {
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.CARotationInProgressCondition, corev1.ConditionTrue, string(rotation.Phase), "")
+		if err := r.Status().Update(ctx, instance); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	completionTime := metav1.Now()
+	rotation.CompletionTime = &completionTime
+	setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.CARotationInProgressCondition, corev1.ConditionFalse, "CARotationCompleted", "")
+	if err := r.Status().Update(ctx, instance); err != nil {
+		return ctrl.Result{}, err
+	}
+	r.EventRecorder.Event(instance, "Normal", "CARotationCompleted", requested)
+	return ctrl.Result{RequeueAfter: infrastructureResyncPeriod}, nil
+}
+
+// runCARotationPhase updates the stored CAs, kubeconfigs and discovery hashes for the current
+// phase, then runs the phase on every machine taking part in it. It returns true while waiting
+// for a machine to be Ready. Only the cluster CA is rotated, the front proxy and etcd CAs are kept.
+func (r *ClusterReconciler) runCARotationPhase(ctx context.Context, instance *enginev1alpha1.Cluster, cluster *enginev1alpha1.Cluster, provider azhelpers.Provider) (bool, error) {
+	spec := &cluster.Spec.Spec
+	if spec.ClusterName == "" {
+		spec.ClusterName = cluster.Name
+	}
+	previous := *spec
+
+	switch instance.Status.CARotation.Phase {
+	case enginev1alpha1.CARotationTrusting, enginev1alpha1.CARotationRetiring:
+		if instance.Status.CARotation.Phase == enginev1alpha1.CARotationTrusting {
+			if err := spec.IntroduceCA(); err != nil {
+				return false, err
+			}
+		} else {
+			spec.RetirePreviousCA()
+		}
+		if err := spec.CheckCARotationScope(&previous); err != nil {
+			return false, err
+		}
+		if err := r.updateCATrust(ctx, instance, cluster); err != nil {
+			return false, err
+		}
+		bundle := spec.CATrustBundle()
+		if instance.Status.CARotation.Phase == enginev1alpha1.CARotationRetiring {
+			// the pods stop trusting the retired CA before the masters do
+			if waiting, err := r.trustCAInPods(ctx, instance, bundle); err != nil || waiting {
+				return waiting, err
+			}
+		}
+		return r.runOnMachines(ctx, instance, cluster, provider, false, func(machine caRotationMachine) (string, error) {
+			if machine.master {
+				return trustCAScript(bundle) + restartControlPlaneScript, nil
+			}
+			return trustCAScript(bundle), nil
+		})
+
+	case enginev1alpha1.CARotationReissuing:
+		spec.ActivateNextCA()
+		if err := spec.CheckCARotationScope(&previous); err != nil {
+			return false, err
+		}
+		if err := r.updateCATrust(ctx, instance, cluster); err != nil {
+			return false, err
+		}
+		// the phase is reconciled until every master is reissued, the customer kubeconfig is only
+		// renewed once so the downloaded kubeconfig stays usable
+		signed, err := bootstrap.KubeConfigSignedBy(spec.CustomerKubeConfig, spec.CACertificate)
+		if err != nil {
+			return false, err
+		}
+		if !signed {
+			if err := spec.RenewCustomerKubeConfig(); err != nil {
+				return false, err
+			}
+			if err := updateClusterSecret(ctx, r.Client, cluster.Namespace, cluster.Spec.KubeconfigRef.Name, spec.KubeconfigSecretData()); err != nil {
+				return false, err
+			}
+		}
+		return r.reissueMasters(ctx, instance, cluster, provider)
+
+	case enginev1alpha1.CARotationRolling:
+		return r.runOnMachines(ctx, instance, cluster, provider, true, func(machine caRotationMachine) (string, error) {
+			if machine.master {
+				return "", nil
+			}
+			if err := helpers.CordonDrainAndDeleteNode(spec.CustomerKubeConfig, machine.name); err != nil {
+				return "", fmt.Errorf("cannot drain %s: %v", machine.name, err)
+			}
+			bootstrapToken, err := bootstrap.CreateNewBootstrapToken()
+			if err != nil {
+				return "", err
+			}
+			return rejoinNodeScript(spec, machine.kubernetesVersion, bootstrapToken)
+		})
+	}
+	return false, nil
+}
+
+// reissueMasters reissues the certificates signed by the cluster CA on every master. The pods trust
+// the CA bundle first, in-cluster clients would not trust the reissued apiserver certificate.
+func (r *ClusterReconciler) reissueMasters(ctx context.Context, instance *enginev1alpha1.Cluster, cluster *enginev1alpha1.Cluster, provider azhelpers.Provider) (bool, error) {
+	spec := &cluster.Spec.Spec
+	bundle := spec.CATrustBundle()
+	if waiting, err := r.trustCAInPods(ctx, instance, bundle); err != nil || waiting {
+		return waiting, err
+	}
+	return r.runOnMachines(ctx, instance, cluster, provider, true, func(machine caRotationMachine) (string, error) {
+		if !machine.master {
+			return "", nil
+		}
+		reissue, err := reissueCAScript(spec.CACertificate, spec.CACertificateKey, machine.kubernetesVersion)
+		if err != nil {
+			return "", err
+		}
+		return reissue + trustCAScript(bundle) + restartControlPlaneScript, nil
+	})
+}
+
+// trustCAInPods makes every service account token carry the CA trust bundle and restarts the
+// workloads of caTrustBundleNamespaces, it returns true until they rolled out. The token controller
+// only fills the CA of tokens missing it, existing tokens keep the CA they were created with. Once
+// the phase ran on a machine the pods already trust the bundle.
+func (r *ClusterReconciler) trustCAInPods(ctx context.Context, instance *enginev1alpha1.Cluster, bundle string) (bool, error) {
+	log := r.Log.WithValues("cluster", instance.Name)
+
+	rotation := instance.Status.CARotation
+	if len(rotation.Nodes) > 0 || rotation.WaitingNode != "" {
+		return false, nil
+	}
+
+	secrets := &corev1.SecretList{}
+	if err := r.List(ctx, secrets); err != nil {
+		return false, err
+	}
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		if secret.Type != corev1.SecretTypeServiceAccountToken || string(secret.Data[corev1.ServiceAccountRootCAKey]) == bundle {
+			continue
+		}
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+		secret.Data[corev1.ServiceAccountRootCAKey] = []byte(bundle)
+		if err := r.Update(ctx, secret); err != nil {
+			return false, fmt.Errorf("cannot update the CA of service account token %s/%s: %v", secret.Namespace, secret.Name, err)
+		}
+	}
+
+	hash := fmt.Sprintf("%x", sha256.Sum256([]byte(bundle)))
+	waiting := false
+	for _, namespace := range caTrustBundleNamespaces {
+		deployments := &appsv1.DeploymentList{}
+		if err := r.List(ctx, deployments, client.InNamespace(namespace)); err != nil {
+			return false, err
+		}
+		for i := range deployments.Items {
+			d := &deployments.Items[i]
+			if d.Spec.Template.Annotations[caTrustBundleAnnotation] != hash {
+				log.Info("Restarting to trust the CA bundle", "Deployment", d.Namespace+"/"+d.Name)
+				setCATrustBundleAnnotation(&d.Spec.Template, hash)
+				if err := r.Update(ctx, d); err != nil {
+					return false, err
+				}
+				waiting = true
+			} else if d.Status.ObservedGeneration < d.Generation || d.Status.UpdatedReplicas != d.Status.Replicas || d.Status.UnavailableReplicas > 0 {
+				waiting = true
+			}
+		}
+
+		daemonSets := &appsv1.DaemonSetList{}
+		if err := r.List(ctx, daemonSets, client.InNamespace(namespace)); err != nil {
+			return false, err
+		}
+		for i := range daemonSets.Items {
+			ds := &daemonSets.Items[i]
+			if ds.Spec.Template.Annotations[caTrustBundleAnnotation] != hash {
+				log.Info("Restarting to trust the CA bundle", "DaemonSet", ds.Namespace+"/"+ds.Name)
+				setCATrustBundleAnnotation(&ds.Spec.Template, hash)
+				if err := r.Update(ctx, ds); err != nil {
+					return false, err
+				}
+				waiting = true
+			} else if ds.Status.ObservedGeneration < ds.Generation || ds.Status.UpdatedNumberScheduled != ds.Status.DesiredNumberScheduled || ds.Status.NumberUnavailable > 0 {
+				waiting = true
+			}
+		}
+
+		statefulSets := &appsv1.StatefulSetList{}
+		if err := r.List(ctx, statefulSets, client.InNamespace(namespace)); err != nil {
+			return false, err
+		}
+		for i := range statefulSets.Items {
+			ss := &statefulSets.Items[i]
+			if ss.Spec.Template.Annotations[caTrustBundleAnnotation] != hash {
+				log.Info("Restarting to trust the CA bundle", "StatefulSet", ss.Namespace+"/"+ss.Name)
+				setCATrustBundleAnnotation(&ss.Spec.Template, hash)
+				if err := r.Update(ctx, ss); err != nil {
+					return false, err
+				}
+				waiting = true
+			} else if ss.Status.ObservedGeneration < ss.Generation || ss.Status.UpdateRevision != ss.Status.CurrentRevision || ss.Status.ReadyReplicas != ss.Status.Replicas {
+				waiting = true
+			}
+		}
+	}
+	return waiting, nil
+}
+
+func setCATrustBundleAnnotation(template *corev1.PodTemplateSpec, hash string) {
+	if template.Annotations == nil {
+		template.Annotations = map[string]string{}
+	}
+	template.Annotations[caTrustBundleAnnotation] = hash
+}
+
+// runOnMachines runs the script of every machine that did not complete the current phase yet, one
+// machine at a time, recording each completed machine in the status. Empty scripts skip the machine.
+// With waitForNode a machine completes once it is Ready, the machine is recorded as waiting and true
+// is returned until then, so the reconcile is requeued instead of blocking.
+func (r *ClusterReconciler) runOnMachines(ctx context.Context, instance *enginev1alpha1.Cluster, cluster *enginev1alpha1.Cluster, provider azhelpers.Provider, waitForNode bool, script func(caRotationMachine) (string, error)) (bool, error) {
+	log := r.Log.WithValues("cluster", instance.Name)
+
+	machines, err := r.caRotationMachines(ctx, cluster)
+	if err != nil {
+		return false, err
+	}
+	rotation := instance.Status.CARotation
+	done := map[string]bool{}
+	for _, name := range rotation.Nodes {
+		done[name] = true
+	}
+
+	for _, machine := range machines {
+		if done[machine.name] {
+			continue
+		}
+
+		if rotation.WaitingNode == machine.name {
+			if _, ready, err := helpers.IsNodeReady(r.Client, machine.name); err != nil || !ready {
+				if rotation.WaitingSince != nil && time.Since(rotation.WaitingSince.Time) < caRotationNodeReadyTimeout {
+					return true, nil
+				}
+				// run the phase on the machine again on the next attempt
+				rotation.WaitingNode = ""
+				rotation.WaitingSince = nil
+				return false, fmt.Errorf("node %s is not ready %s after CA rotation", machine.name, caRotationNodeReadyTimeout)
+			}
+		} else {
+			s, err := script(machine)
+			if err != nil {
+				return false, err
+			}
+			if s == "" {
+				continue
+			}
+
+			log.Info("Running CA rotation", "Phase", rotation.Phase, "VM", machine.name)
+			result, err := provider.RunCommandVMSSVM(ctx, machine.vmssName, machine.instanceID, compute.RunCommandInput{
+				CommandID: to.StringPtr("RunShellScript"),
+				Script:    &[]string{s + "\necho " + caRotationDone + "\n"},
+			})
+			if err != nil {
+				return false, fmt.Errorf("cannot run CA rotation on %s: %v", machine.name, err)
+			}
+			if !strings.Contains(runCommandStdout(result), caRotationDone) {
+				return false, fmt.Errorf("CA rotation failed on %s: %s", machine.name, runCommandTail(result))
+			}
+			if waitForNode {
+				waitingSince := metav1.Now()
+				rotation.WaitingNode = machine.name
+				rotation.WaitingSince = &waitingSince
+				return true, nil
+			}
+		}
+
+		rotation.Nodes = append(rotation.Nodes, machine.name)
+		rotation.WaitingNode = ""
+		rotation.WaitingSince = nil
+		if err := r.Status().Update(ctx, instance); err != nil {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// updateCATrust stores the CAs and the discovery hashes of the current trust bundle, and makes
+// kubeadm discovery and the stored kubeconfigs trust the bundle
+func (r *ClusterReconciler) updateCATrust(ctx context.Context, instance *enginev1alpha1.Cluster, cluster *enginev1alpha1.Cluster) error {
+	spec := &cluster.Spec.Spec
+
+	if err := updateClusterSecret(ctx, r.Client, cluster.Namespace, cluster.Spec.CertificatesRef.Name, spec.CertificatesSecretData()); err != nil {
+		return err
+	}
+
+	hashes, err := spec.CAHashes()
+	if err != nil {
+		return err
+	}
+	if !apiequality.Semantic.DeepEqual(hashes, instance.Spec.DiscoveryHashes) {
+		instance.Spec.DiscoveryHashes = hashes
+		// the spec update returns the stored status, keep the progress of the rotation
+		status := instance.Status.DeepCopy()
+		if err := r.Update(ctx, instance); err != nil {
+			return err
+		}
+		instance.Status = *status
+	}
+	spec.DiscoveryHashes = hashes
+
+	bundle := spec.CATrustBundle()
+	clusterInfo := &corev1.ConfigMap{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: metav1.NamespacePublic, Name: bootstrapapi.ConfigMapClusterInfo}, clusterInfo); err != nil {
+		return err
+	}
+	kubeconfig, err := bootstrap.SetKubeConfigCA(clusterInfo.Data[bootstrapapi.KubeConfigKey], bundle)
+	if err != nil {
+		return err
+	}
+	clusterInfo.Data[bootstrapapi.KubeConfigKey] = kubeconfig
+	if err := r.Update(ctx, clusterInfo); err != nil {
+		return err
+	}
+
+	for _, kubeconfig := range []*string{&spec.AdminKubeConfig, &spec.CustomerKubeConfig} {
+		if *kubeconfig == "" {
+			continue
+		}
+		if *kubeconfig, err = bootstrap.SetKubeConfigCA(*kubeconfig, bundle); err != nil {
+			return err
+		}
+	}
+	return updateClusterSecret(ctx, r.Client, cluster.Namespace, cluster.Spec.KubeconfigRef.Name, spec.KubeconfigSecretData())
+}
@@ -0,0 +1,274 @@
+package controllers
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	enginev1alpha1 "github.com/awesomenix/azk/api/v1alpha1"
+	azhelpers "github.com/awesomenix/azk/azure"
+	azfake "github.com/awesomenix/azk/azure/fake"
+	"github.com/awesomenix/azk/bootstrap"
+)
+
+func TestCARotationPhases(t *testing.T) {
+	instance := &enginev1alpha1.Cluster{}
+	if isCARotationRequested(instance) {
+		t.Errorf("Expected no rotation without the annotation")
+	}
+
+	instance.Annotations = map[string]string{enginev1alpha1.RotateCAAnnotation: "first"}
+	if !isCARotationRequested(instance) {
+		t.Errorf("Expected a rotation once requested")
+	}
+
+	var phases []enginev1alpha1.CARotationPhase
+	for phase := enginev1alpha1.CARotationTrusting; phase != enginev1alpha1.CARotationCompleted; phase = nextCARotationPhase(phase) {
+		phases = append(phases, phase)
+	}
+	want := []enginev1alpha1.CARotationPhase{
+		enginev1alpha1.CARotationTrusting,
+		enginev1alpha1.CARotationReissuing,
+		enginev1alpha1.CARotationRolling,
+		enginev1alpha1.CARotationRetiring,
+	}
+	if !reflect.DeepEqual(phases, want) {
+		t.Errorf("Expected phases %v, got %v", want, phases)
+	}
+
+	instance.Status.CARotation = &enginev1alpha1.CARotationStatus{Requested: "first", Phase: enginev1alpha1.CARotationCompleted}
+	if isCARotationRequested(instance) {
+		t.Errorf("Expected no rotation once the request completed")
+	}
+
+	instance.Annotations[enginev1alpha1.RotateCAAnnotation] = "second"
+	if !isCARotationRequested(instance) {
+		t.Errorf("Expected a rotation on a new request")
+	}
+}
+
+func TestRejoinNodeScript(t *testing.T) {
+	spec := &bootstrap.Spec{InternalDNSName: "master.internal", DiscoveryHashes: []string{"sha256:old", "sha256:new"}}
+	script, err := rejoinNodeScript(spec, "1.15.3", "abcdef.0123456789abcdef")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "    caCertHashes:\n    - sha256:old\n    - sha256:new\n"; !strings.Contains(script, want) {
+		t.Errorf("Expected the join configuration to trust every discovery hash, got\n%s", script)
+	}
+}
+
+func TestReissueCAScript(t *testing.T) {
+	tests := []struct {
+		kubernetesVersion string
+		want              string
+	}{
+		{kubernetesVersion: "1.15.3", want: "sudo kubeadm alpha certs renew $c"},
+		{kubernetesVersion: "1.20.1", want: "sudo kubeadm certs renew $c"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kubernetesVersion, func(t *testing.T) {
+			script, err := reissueCAScript("cert", "key", tt.kubernetesVersion)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(script, tt.want) {
+				t.Errorf("Expected the script to run %q, got\n%s", tt.want, script)
+			}
+			if strings.Contains(script, "%!") {
+				t.Errorf("Expected no formatting errors, got\n%s", script)
+			}
+		})
+	}
+}
+
+func TestRunOnMachinesWaitsForNodes(t *testing.T) {
+	ctx := context.TODO()
+	cloud := azfake.NewCloud()
+	cloud.RunCommandOutput = "Enable succeeded: \n[stdout]\n" + caRotationDone + "\n"
+	provider, err := cloud.Provider(&azhelpers.CloudConfiguration{SubscriptionID: "subscription", GroupName: "group", GroupLocation: "westus2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := provider.CreateOrUpdateResourceGroup(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := provider.CreateVMSS(ctx, masterVmssName, "", nil, nil, "", "Standard_D2s_v3", 1, 1); err != nil {
+		t.Fatal(err)
+	}
+	vms, err := provider.ListVMSSVMs(ctx, masterVmssName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	name := to.String(vms[0].OsProfile.ComputerName)
+
+	cluster := &enginev1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "azk"}}
+	cluster.Status.CARotation = &enginev1alpha1.CARotationStatus{Requested: "1", Phase: enginev1alpha1.CARotationReissuing}
+	controlPlane := &enginev1alpha1.ControlPlane{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "azk-controlplane"}}
+	controlPlane.Spec.ClusterRef.Name = cluster.Name
+	controlPlane.Spec.KubernetesVersion = "1.15.3"
+	controlPlane.Status.NodeStatus = []enginev1alpha1.VMStatus{{VMComputerName: name, VMInstanceID: to.String(vms[0].InstanceID)}}
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status:     corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionFalse}}},
+	}
+
+	s := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(s)
+	_ = enginev1alpha1.AddToScheme(s)
+	r := &ClusterReconciler{Client: fake.NewFakeClientWithScheme(s, cluster, controlPlane, node), Log: ctrl.Log.WithName("test")}
+	script := func(machine caRotationMachine) (string, error) { return "true", nil }
+	runCommands := func() int {
+		n := 0
+		for _, op := range cloud.Operations() {
+			if op.Name == "RunCommandVMSSVM" {
+				n++
+			}
+		}
+		return n
+	}
+
+	waiting, err := r.runOnMachines(ctx, cluster, cluster, provider, true, script)
+	if err != nil || !waiting || cluster.Status.CARotation.WaitingNode != name || len(cluster.Status.CARotation.Nodes) != 0 {
+		t.Fatalf("Expected to wait for %s after running the phase, got %v %v %+v", name, waiting, err, cluster.Status.CARotation)
+	}
+	waiting, err = r.runOnMachines(ctx, cluster, cluster, provider, true, script)
+	if err != nil || !waiting || runCommands() != 1 {
+		t.Fatalf("Expected to keep waiting without running the phase again, got %v %v after %d run commands", waiting, err, runCommands())
+	}
+
+	since := metav1.NewTime(time.Now().Add(-caRotationNodeReadyTimeout))
+	cluster.Status.CARotation.WaitingSince = &since
+	if _, err := r.runOnMachines(ctx, cluster, cluster, provider, true, script); err == nil || cluster.Status.CARotation.WaitingNode != "" {
+		t.Fatalf("Expected a node not ready in time to fail the phase, got %v %+v", err, cluster.Status.CARotation)
+	}
+	waiting, err = r.runOnMachines(ctx, cluster, cluster, provider, true, script)
+	if err != nil || !waiting || runCommands() != 2 {
+		t.Fatalf("Expected the phase to run again after the timeout, got %v %v after %d run commands", waiting, err, runCommands())
+	}
+
+	node.Status.Conditions[0].Status = corev1.ConditionTrue
+	if err := r.Update(ctx, node); err != nil {
+		t.Fatal(err)
+	}
+	waiting, err = r.runOnMachines(ctx, cluster, cluster, provider, true, script)
+	if err != nil || waiting || !reflect.DeepEqual(cluster.Status.CARotation.Nodes, []string{name}) || cluster.Status.CARotation.WaitingNode != "" {
+		t.Fatalf("Expected %s to complete the phase once Ready, got %v %v %+v", name, waiting, err, cluster.Status.CARotation)
+	}
+}
+
+func TestReissueMastersTrustsCAInPodsFirst(t *testing.T) {
+	ctx := context.TODO()
+	cloud := azfake.NewCloud()
+	cloud.RunCommandOutput = "Enable succeeded: \n[stdout]\n" + caRotationDone + "\n"
+	provider, err := cloud.Provider(&azhelpers.CloudConfiguration{SubscriptionID: "subscription", GroupName: "group", GroupLocation: "westus2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := provider.CreateOrUpdateResourceGroup(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := provider.CreateVMSS(ctx, masterVmssName, "", nil, nil, "", "Standard_D2s_v3", 1, 1); err != nil {
+		t.Fatal(err)
+	}
+	vms, err := provider.ListVMSSVMs(ctx, masterVmssName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	oldCA, _, err := bootstrap.NewCertificateAuthority()
+	if err != nil {
+		t.Fatal(err)
+	}
+	newCA, newCAKey, err := bootstrap.NewCertificateAuthority()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cluster := &enginev1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "azk"}}
+	cluster.Spec.CACertificate = newCA
+	cluster.Spec.CACertificateKey = newCAKey
+	cluster.Spec.PreviousCACertificate = oldCA
+	cluster.Status.CARotation = &enginev1alpha1.CARotationStatus{Requested: "1", Phase: enginev1alpha1.CARotationReissuing}
+	bundle := cluster.Spec.CATrustBundle()
+
+	controlPlane := &enginev1alpha1.ControlPlane{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "azk-controlplane"}}
+	controlPlane.Spec.ClusterRef.Name = cluster.Name
+	controlPlane.Spec.KubernetesVersion = "1.15.3"
+	controlPlane.Status.NodeStatus = []enginev1alpha1.VMStatus{{VMComputerName: to.String(vms[0].OsProfile.ComputerName), VMInstanceID: to.String(vms[0].InstanceID)}}
+	token := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: "kube-proxy-token"},
+		Type:       corev1.SecretTypeServiceAccountToken,
+		Data:       map[string][]byte{corev1.ServiceAccountRootCAKey: []byte(oldCA), corev1.ServiceAccountTokenKey: []byte("token")},
+	}
+	kubeProxy := &appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: "kube-proxy"}}
+	kubeProxy.Status.DesiredNumberScheduled = 1
+	kubeProxy.Status.UpdatedNumberScheduled = 1
+	manager := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "azk-system", Name: "azk-controller-manager"}}
+	manager.Status.Replicas = 1
+	manager.Status.UpdatedReplicas = 1
+
+	s := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(s)
+	_ = enginev1alpha1.AddToScheme(s)
+	r := &ClusterReconciler{Client: fake.NewFakeClientWithScheme(s, cluster, controlPlane, token, kubeProxy, manager), Log: ctrl.Log.WithName("test")}
+	runCommands := func() int {
+		n := 0
+		for _, op := range cloud.Operations() {
+			if op.Name == "RunCommandVMSSVM" {
+				n++
+			}
+		}
+		return n
+	}
+
+	waiting, err := r.reissueMasters(ctx, cluster, cluster, provider)
+	if err != nil || !waiting || runCommands() != 0 {
+		t.Fatalf("Expected to wait for the system pods before reissuing, got %v %v after %d run commands", waiting, err, runCommands())
+	}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: token.Namespace, Name: token.Name}, token); err != nil {
+		t.Fatal(err)
+	}
+	if string(token.Data[corev1.ServiceAccountRootCAKey]) != bundle || string(token.Data[corev1.ServiceAccountTokenKey]) != "token" {
+		t.Errorf("Expected the service account token to carry the CA bundle, got %q", token.Data[corev1.ServiceAccountRootCAKey])
+	}
+	for _, obj := range []runtime.Object{kubeProxy, manager} {
+		key, _ := client.ObjectKeyFromObject(obj)
+		if err := r.Get(ctx, key, obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if kubeProxy.Spec.Template.Annotations[caTrustBundleAnnotation] == "" || manager.Spec.Template.Annotations[caTrustBundleAnnotation] == "" {
+		t.Errorf("Expected the system workloads to be restarted, got %v %v", kubeProxy.Spec.Template.Annotations, manager.Spec.Template.Annotations)
+	}
+
+	manager.Status.UpdatedReplicas = 0
+	if err := r.Status().Update(ctx, manager); err != nil {
+		t.Fatal(err)
+	}
+	waiting, err = r.reissueMasters(ctx, cluster, cluster, provider)
+	if err != nil || !waiting || runCommands() != 0 {
+		t.Fatalf("Expected to wait for the rollout of the system pods, got %v %v after %d run commands", waiting, err, runCommands())
+	}
+
+	manager.Status.UpdatedReplicas = 1
+	if err := r.Status().Update(ctx, manager); err != nil {
+		t.Fatal(err)
+	}
+	waiting, err = r.reissueMasters(ctx, cluster, cluster, provider)
+	if err != nil || !waiting || runCommands() != 1 || cluster.Status.CARotation.WaitingNode == "" {
+		t.Fatalf("Expected the masters to be reissued once the system pods trust the CA bundle, got %v %v after %d run commands", waiting, err, runCommands())
+	}
+}
@@ -0,0 +1,234 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	enginev1alpha1 "github.com/awesomenix/azk/api/v1alpha1"
+	azhelpers "github.com/awesomenix/azk/azure"
+	"github.com/awesomenix/azk/bootstrap"
+)
+
+const (
+	// certificatesCheckPeriod is how often settled control planes read the certificates of their masters
+	certificatesCheckPeriod = 24 * time.Hour
+	// certificatesRenewBefore is how long before expiry master certificates are renewed
+	certificatesRenewBefore = 30 * 24 * time.Hour
+	// certificatesRenewed is printed by renewCertificatesScript once the renewal succeeded, run
+	// command reports success for failing scripts
+	certificatesRenewed = "azk-certificates-renewed"
+)
+
+// checkCertificatesScript prints the path and expiry in unix seconds of every leaf certificate
+// kubeadm created on a master, CAs are long lived and the kubelet rotates its own certificate
+const checkCertificatesScript = `
+cd /etc/kubernetes
+for f in pki/*.crt pki/etcd/*.crt; do
+	case $f in *ca.crt) continue;; esac
+	echo "$f $(date -d "$(sudo openssl x509 -enddate -noout -in $f | cut -d= -f2)" +%s)"
+done
+for f in admin.conf controller-manager.conf scheduler.conf; do
+	echo "$f $(date -d "$(sudo grep client-certificate-data $f | awk '{print $2}' | base64 -d | openssl x509 -enddate -noout | cut -d= -f2)" +%s)"
+done
+`
+
+// renewCertificatesScript renews the leaf certificates and kubeconfigs of a master with the kubeadm
+// of the kubernetes version, restarts the static pods to load them and waits for the local apiserver
+// before printing certificatesRenewed and the new expiries
+func renewCertificatesScript(kubernetesVersion string) (string, error) {
+	renew, err := bootstrap.KubeadmCertsRenewCommand(kubernetesVersion)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`
+set -e
+sudo %s all
+for c in kube-apiserver kube-controller-manager kube-scheduler etcd; do
+	sudo docker ps -q --filter name=k8s_${c}_ | xargs -r sudo docker kill
+done
+for i in $(seq 60); do
+	curl -skf https://localhost:6443/healthz > /dev/null && break
+	sleep 5
+done
+echo %s
+set +e
+`, renew, certificatesRenewed) + checkCertificatesScript, nil
+}
+
+// runCommandStdout returns the stdout a run command reported, the message carries stdout and stderr
+// as "Enable succeeded: \n[stdout]\n...\n[stderr]\n..."
+func runCommandStdout(result compute.RunCommandResult) string {
+	if result.Value == nil {
+		return ""
+	}
+	var out []string
+	for _, status := range *result.Value {
+		message := to.String(status.Message)
+		if i := strings.Index(message, "[stdout]\n"); i >= 0 {
+			message = message[i+len("[stdout]\n"):]
+		}
+		if i := strings.Index(message, "[stderr]"); i >= 0 {
+			message = message[:i]
+		}
+		out = append(out, message)
+	}
+	return strings.Join(out, "\n")
+}
+
+// parseCertificates parses the "<name> <unix seconds>" lines of checkCertificatesScript, lines of
+// missing or unreadable certificates are skipped
+func parseCertificates(output string) []enginev1alpha1.CertificateStatus {
+	var certificates []enginev1alpha1.CertificateStatus
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		seconds, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil || seconds <= 0 {
+			continue
+		}
+		certificates = append(certificates, enginev1alpha1.CertificateStatus{
+			Name:     fields[0],
+			NotAfter: metav1.NewTime(time.Unix(seconds, 0).UTC()),
+		})
+	}
+	sort.Slice(certificates, func(i, j int) bool { return certificates[i].Name < certificates[j].Name })
+	return certificates
+}
+
+// earliestExpiry returns the certificate expiring first, nil without certificates
+func earliestExpiry(certificates []enginev1alpha1.CertificateStatus) *enginev1alpha1.CertificateStatus {
+	var earliest *enginev1alpha1.CertificateStatus
+	for i := range certificates {
+		if earliest == nil || certificates[i].NotAfter.Before(&earliest.NotAfter) {
+			earliest = &certificates[i]
+		}
+	}
+	return earliest
+}
+
+// isCertificatesCheckDue returns true when the masters were not checked within certificatesCheckPeriod,
+// or a renewal was requested through the renew-certificates annotation
+func isCertificatesCheckDue(instance *enginev1alpha1.ControlPlane, now time.Time) bool {
+	if renewal := instance.Annotations[enginev1alpha1.RenewCertificatesAnnotation]; renewal != "" && renewal != instance.Status.CertificatesRenewal {
+		return true
+	}
+	checked := instance.Status.CertificatesCheckedTime
+	return checked == nil || now.Sub(checked.Time) >= certificatesCheckPeriod
+}
+
+// reconcileCertificates reads the certificate expiries of every master and renews the certificates
+// of masters expiring within certificatesRenewBefore, or of every master when a renewal was
+// requested. Masters are renewed one at a time and only while etcd keeps quorum.
+func (r *ControlPlaneReconciler) reconcileCertificates(ctx context.Context, instance *enginev1alpha1.ControlPlane, cluster *enginev1alpha1.Cluster, provider azhelpers.Provider) error {
+	renewal := instance.Annotations[enginev1alpha1.RenewCertificatesAnnotation]
+	renewAll := renewal != "" && renewal != instance.Status.CertificatesRenewal
+	now := time.Now()
+
+	checkCommand := compute.RunCommandInput{
+		CommandID: to.StringPtr("RunShellScript"),
+		Script:    &[]string{checkCertificatesScript},
+	}
+
+	var masters []enginev1alpha1.MasterCertificatesStatus
+	var expiring []string
+	var renewErr error
+	for _, nodeStatus := range instance.Status.NodeStatus {
+		result, err := provider.RunCommandVMSSVM(ctx, masterVmssName, nodeStatus.VMInstanceID, checkCommand)
+		if err != nil {
+			return fmt.Errorf("cannot read certificates of %s: %v", nodeStatus.VMComputerName, err)
+		}
+		certificates := parseCertificates(runCommandStdout(result))
+
+		earliest := earliestExpiry(certificates)
+		renew := renewAll || (earliest != nil && earliest.NotAfter.Time.Sub(now) < certificatesRenewBefore)
+		if renew && renewErr == nil {
+			if err := r.checkEtcdDisruption(ctx, instance, cluster, nodeStatus.VMComputerName, false); err != nil {
+				renewErr = fmt.Errorf("cannot renew certificates of %s: %v", nodeStatus.VMComputerName, err)
+			} else if renewed, err := r.renewCertificates(ctx, instance, provider, nodeStatus); err != nil {
+				// the certificates read before the renewal stay reported
+				renewErr = fmt.Errorf("cannot renew certificates of %s: %v", nodeStatus.VMComputerName, err)
+			} else {
+				certificates = renewed
+				earliest = earliestExpiry(certificates)
+				r.EventRecorder.Event(instance, "Normal", "CertificatesRenewed", nodeStatus.VMComputerName)
+			}
+		}
+		if earliest != nil && earliest.NotAfter.Time.Sub(now) < certificatesRenewBefore {
+			expiring = append(expiring, fmt.Sprintf("%s %s expires %s", nodeStatus.VMComputerName, earliest.Name, earliest.NotAfter.Format(time.RFC3339)))
+		}
+		masters = append(masters, enginev1alpha1.MasterCertificatesStatus{
+			Name:         nodeStatus.VMComputerName,
+			Certificates: certificates,
+		})
+	}
+
+	instance.Status.Certificates = masters
+	checked := metav1.NewTime(now)
+	instance.Status.CertificatesCheckedTime = &checked
+	instance.Status.CertificatesNotAfter = nil
+	for _, master := range masters {
+		if earliest := earliestExpiry(master.Certificates); earliest != nil &&
+			(instance.Status.CertificatesNotAfter == nil || earliest.NotAfter.Before(instance.Status.CertificatesNotAfter)) {
+			notAfter := earliest.NotAfter
+			instance.Status.CertificatesNotAfter = &notAfter
+		}
+	}
+
+	switch {
+	case renewErr != nil:
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.CertificatesExpiringCondition, corev1.ConditionTrue, "RenewFailed", renewErr.Error())
+	case len(expiring) > 0:
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.CertificatesExpiringCondition, corev1.ConditionTrue, "CertificatesExpiring", strings.Join(expiring, ", "))
+	default:
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.CertificatesExpiringCondition, corev1.ConditionFalse, "CertificatesValid", "")
+		if renewAll {
+			instance.Status.CertificatesRenewal = renewal
+		}
+	}
+	return renewErr
+}
+
+// renewCertificates renews the certificates of a master with the kubeadm of the kubernetes version
+// its kubelet runs, masters differ in version during an upgrade, and returns the new expiries
+func (r *ControlPlaneReconciler) renewCertificates(ctx context.Context, instance *enginev1alpha1.ControlPlane, provider azhelpers.Provider, nodeStatus enginev1alpha1.VMStatus) ([]enginev1alpha1.CertificateStatus, error) {
+	log := r.Log.WithValues("controlplane", instance.Name)
+
+	kubernetesVersion := instance.Status.KubernetesVersion
+	node := &corev1.Node{}
+	if err := r.Get(ctx, types.NamespacedName{Name: nodeStatus.VMComputerName}, node); err == nil && node.Status.NodeInfo.KubeletVersion != "" {
+		kubernetesVersion = node.Status.NodeInfo.KubeletVersion
+	} else if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	renewScript, err := renewCertificatesScript(kubernetesVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("Renewing certificates", "VM", nodeStatus.VMComputerName, "KubernetesVersion", kubernetesVersion)
+	result, err := provider.RunCommandVMSSVM(ctx, masterVmssName, nodeStatus.VMInstanceID, compute.RunCommandInput{
+		CommandID: to.StringPtr("RunShellScript"),
+		Script:    &[]string{renewScript},
+	})
+	if err != nil {
+		return nil, err
+	}
+	stdout := runCommandStdout(result)
+	if !strings.Contains(stdout, certificatesRenewed) {
+		return nil, fmt.Errorf("renewal failed: %s", runCommandTail(result))
+	}
+	return parseCertificates(stdout), nil
+}
@@ -0,0 +1,182 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	enginev1alpha1 "github.com/awesomenix/azk/api/v1alpha1"
+	azhelpers "github.com/awesomenix/azk/azure"
+	azfake "github.com/awesomenix/azk/azure/fake"
+)
+
+func TestParseCertificates(t *testing.T) {
+	result := compute.RunCommandResult{
+		Value: &[]compute.InstanceViewStatus{{
+			Message: to.StringPtr("Enable succeeded: \n[stdout]\npki/apiserver.crt 1600000000\nadmin.conf 1500000000\npki/etcd/peer.crt \n\n[stderr]\nunable to load certificate\n"),
+		}},
+	}
+	certificates := parseCertificates(runCommandStdout(result))
+	want := []enginev1alpha1.CertificateStatus{
+		{Name: "admin.conf", NotAfter: metav1.NewTime(time.Unix(1500000000, 0).UTC())},
+		{Name: "pki/apiserver.crt", NotAfter: metav1.NewTime(time.Unix(1600000000, 0).UTC())},
+	}
+	if !reflect.DeepEqual(certificates, want) {
+		t.Fatalf("parseCertificates() = %v, want %v", certificates, want)
+	}
+	if earliest := earliestExpiry(certificates); earliest.Name != "admin.conf" {
+		t.Errorf("Expected admin.conf to expire first, got %s", earliest.Name)
+	}
+	if earliest := earliestExpiry(nil); earliest != nil {
+		t.Errorf("Expected no expiry without certificates, got %v", earliest)
+	}
+}
+
+func TestIsCertificatesCheckDue(t *testing.T) {
+	now := time.Now()
+	instance := &enginev1alpha1.ControlPlane{}
+	if !isCertificatesCheckDue(instance, now) {
+		t.Errorf("Expected a check of masters never checked")
+	}
+
+	checked := metav1.NewTime(now.Add(-time.Hour))
+	instance.Status.CertificatesCheckedTime = &checked
+	if isCertificatesCheckDue(instance, now) {
+		t.Errorf("Expected no check within the check period")
+	}
+	if !isCertificatesCheckDue(instance, now.Add(certificatesCheckPeriod)) {
+		t.Errorf("Expected a check once the check period passed")
+	}
+
+	instance.Annotations = map[string]string{enginev1alpha1.RenewCertificatesAnnotation: "1"}
+	if !isCertificatesCheckDue(instance, now) {
+		t.Errorf("Expected a check on a renewal request")
+	}
+	instance.Status.CertificatesRenewal = "1"
+	if isCertificatesCheckDue(instance, now) {
+		t.Errorf("Expected no check once the renewal request was handled")
+	}
+}
+
+func TestRenewCertificatesScript(t *testing.T) {
+	tests := []struct {
+		kubernetesVersion string
+		want              string
+		wantErr           bool
+	}{
+		{kubernetesVersion: "1.15.3", want: "sudo kubeadm alpha certs renew all\n"},
+		{kubernetesVersion: "v1.19.16", want: "sudo kubeadm alpha certs renew all\n"},
+		{kubernetesVersion: "1.20.0", want: "sudo kubeadm certs renew all\n"},
+		{kubernetesVersion: "v1.22.4", want: "sudo kubeadm certs renew all\n"},
+		{kubernetesVersion: "latest", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kubernetesVersion, func(t *testing.T) {
+			script, err := renewCertificatesScript(tt.kubernetesVersion)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("renewCertificatesScript() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !strings.Contains(script, tt.want) {
+				t.Errorf("Expected the script to run %q, got\n%s", tt.want, script)
+			}
+		})
+	}
+}
+
+func TestReconcileCertificates(t *testing.T) {
+	soon := time.Now().Add(24 * time.Hour).Unix()
+	later := time.Now().Add(365 * 24 * time.Hour).Unix()
+	tests := []struct {
+		name         string
+		renewOutput  string
+		wantErr      bool
+		wantNotAfter int64
+		wantReason   string
+	}{
+		{
+			name:         "renewed",
+			renewOutput:  fmt.Sprintf("Enable succeeded: \n[stdout]\n%s\npki/apiserver.crt %d\n", certificatesRenewed, later),
+			wantNotAfter: later,
+			wantReason:   "CertificatesValid",
+		},
+		{
+			name:         "renewal failed",
+			renewOutput:  "Enable succeeded: \n[stdout]\n\n[stderr]\nerror execution phase certs/renew\n",
+			wantErr:      true,
+			wantNotAfter: soon,
+			wantReason:   "RenewFailed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			var renewScripts []string
+			cloud := azfake.NewCloud()
+			cloud.RunCommand = func(vmssName, instanceID, script string) string {
+				if strings.Contains(script, "certs renew") {
+					renewScripts = append(renewScripts, script)
+					return tt.renewOutput
+				}
+				return fmt.Sprintf("Enable succeeded: \n[stdout]\npki/apiserver.crt %d\n", soon)
+			}
+			provider, err := cloud.Provider(&azhelpers.CloudConfiguration{SubscriptionID: "subscription", GroupName: "group", GroupLocation: "westus2"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := provider.CreateOrUpdateResourceGroup(ctx); err != nil {
+				t.Fatal(err)
+			}
+			if err := provider.CreateVMSS(ctx, masterVmssName, "", nil, nil, "", "Standard_D2s_v3", 1, 1); err != nil {
+				t.Fatal(err)
+			}
+			vms, err := provider.ListVMSSVMs(ctx, masterVmssName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			name := to.String(vms[0].OsProfile.ComputerName)
+
+			instance := &enginev1alpha1.ControlPlane{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "azk-controlplane"}}
+			instance.Status.KubernetesVersion = "1.15.3"
+			instance.Status.NodeStatus = []enginev1alpha1.VMStatus{{VMComputerName: name, VMInstanceID: to.String(vms[0].InstanceID)}}
+			// the master was upgraded already, its kubeadm has no alpha certs command
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+			node.Status.NodeInfo.KubeletVersion = "v1.20.2"
+
+			s := runtime.NewScheme()
+			_ = clientgoscheme.AddToScheme(s)
+			_ = enginev1alpha1.AddToScheme(s)
+			r := &ControlPlaneReconciler{
+				Client:        fake.NewFakeClientWithScheme(s, instance, node),
+				Log:           ctrl.Log.WithName("test"),
+				EventRecorder: record.NewFakeRecorder(10),
+				Etcd:          newFakeEtcdCluster(name).factory,
+			}
+			err = r.reconcileCertificates(ctx, instance, &enginev1alpha1.Cluster{}, provider)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("reconcileCertificates() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(renewScripts) != 1 || !strings.Contains(renewScripts[0], "sudo kubeadm certs renew all\n") {
+				t.Errorf("Expected the certificates to be renewed with the kubeadm of the master, got %v", renewScripts)
+			}
+			if notAfter := instance.Status.CertificatesNotAfter; notAfter == nil || notAfter.Unix() != tt.wantNotAfter {
+				t.Errorf("Expected the certificates to expire at %d, got %v", tt.wantNotAfter, notAfter)
+			}
+			if condition := enginev1alpha1.FindCondition(instance.Status.Conditions, enginev1alpha1.CertificatesExpiringCondition); condition == nil || condition.Reason != tt.wantReason {
+				t.Errorf("Expected CertificatesExpiring reason %s, got %+v", tt.wantReason, condition)
+			}
+		})
+	}
+}
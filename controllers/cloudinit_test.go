@@ -0,0 +1,85 @@
+package controllers
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	enginev1alpha1 "github.com/awesomenix/azk/api/v1alpha1"
+	azhelpers "github.com/awesomenix/azk/azure"
+	"github.com/awesomenix/azk/bootstrap"
+	"github.com/awesomenix/azk/cloudinit"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files in testdata")
+
+// goldenCluster is a cluster with fixed credentials, the payloads rendered for it are compared
+// with the golden files
+func goldenCluster() *enginev1alpha1.Cluster {
+	cluster := &enginev1alpha1.Cluster{}
+	cluster.Spec.Spec = bootstrap.Spec{
+		CloudConfiguration: azhelpers.CloudConfiguration{
+			CloudName:      azhelpers.AzurePublicCloudName,
+			SubscriptionID: "subscription",
+			GroupName:      "group",
+			GroupLocation:  "westus2",
+		},
+		CACertificate:              "ca.crt\n",
+		CACertificateKey:           "ca.key\n",
+		ServiceAccountKey:          "sa.key\n",
+		ServiceAccountPub:          "sa.pub\n",
+		FrontProxyCACertificate:    "front-proxy-ca.crt\n",
+		FrontProxyCACertificateKey: "front-proxy-ca.key\n",
+		EtcdCACertificate:          "etcd/ca.crt\n",
+		EtcdCACertificateKey:       "etcd/ca.key\n",
+		AzureCloudProviderConfig:   "{\"cloud\": \"AzurePublicCloud\"}\n",
+		InternalDNSName:            "azk0123abcd.internal",
+		DiscoveryHashes:            []string{"sha256:0123456789abcdef"},
+	}
+	return cluster
+}
+
+func expectGolden(t *testing.T, config *cloudinit.Config, name string) {
+	payload, err := config.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden := filepath.Join("testdata", name)
+	if *updateGolden {
+		if err := ioutil.WriteFile(golden, payload, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expected, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(payload) != string(expected) {
+		t.Errorf("Expected the payload to match %s, run the tests with -update to accept it, got\n%s", golden, payload)
+	}
+
+	if _, err := config.CustomData(); err != nil {
+		t.Errorf("Failed to encode the payload: %v", err)
+	}
+}
+
+func TestControlPlaneCloudConfig(t *testing.T) {
+	instance := &enginev1alpha1.ControlPlane{}
+	instance.Spec.KubernetesVersion = "1.15.3"
+	config, err := getControlPlaneCloudConfig(instance, goldenCluster(), "abcdef.0123456789abcdef")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectGolden(t, config, "controlplane.cloud-config")
+}
+
+func TestNodeSetCloudConfig(t *testing.T) {
+	instance := &enginev1alpha1.NodeSet{}
+	instance.Spec.KubernetesVersion = "1.15.3"
+	config, err := getNodeSetCloudConfig(instance, goldenCluster(), "abcdef.0123456789abcdef")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectGolden(t, config, "nodeset.cloud-config")
+}
@@ -3,9 +3,12 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/awesomenix/azk/helpers"
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -13,10 +16,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	enginev1alpha1 "github.com/awesomenix/azk/api/v1alpha1"
+	azhelpers "github.com/awesomenix/azk/azure"
 )
 
 const (
 	clusterFinalizerName = "cluster.finalizers.engine.azk.io"
+	// infrastructureResyncPeriod is how often the base infrastructure is checked for drift
+	infrastructureResyncPeriod = 5 * time.Minute
 )
 
 // ClusterReconciler reconciles a Cluster object
@@ -24,18 +30,21 @@ type ClusterReconciler struct {
 	client.Client
 	Log logr.Logger
 	record.EventRecorder
+	// AzureProvider builds the azure provider for a cluster, the azure apis are used when nil
+	AzureProvider azhelpers.ProviderFactory
 }
 
 // +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps,resources=deployments;daemonsets;statefulsets,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=engine.azk.io,resources=clusters,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=engine.azk.io,resources=clusters/status,verbs=get;update;patch
 
 func (r *ClusterReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	ctx := context.Background()
-	_ = r.Log.WithValues("cluster", req.NamespacedName)
+	log := r.Log.WithValues("cluster", req.NamespacedName)
 
 	defer helpers.Recover()
 	// Fetch the Cluster instance
@@ -62,10 +71,25 @@ func (r *ClusterReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 			// Once updates object changes we need to requeue
 			return ctrl.Result{Requeue: true}, nil
 		}
+
+		// Credentials and PKI are only kept in Secrets, never in the cluster spec
+		if changed, err := reconcileClusterSecrets(ctx, r.Client, instance); err != nil {
+			setFailedCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.InfrastructureReadyCondition, "SecretsNotReconciled", err)
+			_ = r.Status().Update(ctx, instance)
+			return ctrl.Result{}, err
+		} else if changed {
+			if err := r.Update(ctx, instance); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{Requeue: true}, nil
+		}
 	} else {
 		if helpers.ContainsFinalizer(instance.ObjectMeta.Finalizers, clusterFinalizerName) {
-			if err == nil && instance.Spec.IsValid() {
-				instance.Spec.CleanupInfrastructure()
+			cluster := instance.DeepCopy()
+			if err := cluster.LoadSecrets(ctx, r.Client); err == nil && cluster.Spec.IsValid() {
+				if provider, err := newProvider(r.AzureProvider, &cluster.Spec.CloudConfiguration); err == nil {
+					cluster.Spec.CleanupInfrastructure(provider)
+				}
 			}
 
 			// remove our finalizer from the list and update it.
@@ -78,25 +102,98 @@ func (r *ClusterReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		return ctrl.Result{}, nil
 	}
 
-	// instance.Status.ProvisioningState = "Updating"
-	// if err := r.Status().Update(ctx, instance); err != nil {
-	// 	return ctrl.Result{}, err
-	// }
+	wasReady := enginev1alpha1.IsConditionTrue(instance.Status.Conditions, enginev1alpha1.ReadyCondition)
+	instance.Status.ObservedGeneration = instance.Generation
+
+	// Secrets are only loaded into a copy, the instance is written back without them
+	cluster := instance.DeepCopy()
+	if err := cluster.LoadSecrets(ctx, r.Client); err != nil {
+		setFailedCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.InfrastructureReadyCondition, "SecretsUnavailable", err)
+		_ = r.Status().Update(ctx, instance)
+		return ctrl.Result{}, err
+	}
+	if !cluster.Spec.IsValid() {
+		setFailedCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.InfrastructureReadyCondition, "InvalidSpec", fmt.Errorf("azure credentials are incomplete"))
+		_ = r.Status().Update(ctx, instance)
+		return ctrl.Result{}, nil
+	}
+
+	provider, err := newProvider(r.AzureProvider, &cluster.Spec.CloudConfiguration)
+	if err != nil {
+		setFailedCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.InfrastructureReadyCondition, "ProviderUnavailable", err)
+		_ = r.Status().Update(ctx, instance)
+		return ctrl.Result{}, err
+	}
+
+	states, err := cluster.Spec.CheckBaseInfrastructure(ctx, provider)
+	if err != nil {
+		setFailedCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.InfrastructureReadyCondition, "CheckFailed", err)
+		_ = r.Status().Update(ctx, instance)
+		return ctrl.Result{}, err
+	}
+
+	resources, drifted := resourceStatuses(states)
+	if len(drifted) > 0 {
+		log.Info("Repairing base infrastructure", "Drifted", drifted)
+		r.EventRecorder.Event(instance, "Warning", "Drifted", fmt.Sprintf("Repairing Base Infrastructure %s", strings.Join(drifted, "; ")))
+
+		instance.Status.ProvisioningState = "Updating"
+		instance.Status.Resources = resources
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.InfrastructureReadyCondition, corev1.ConditionFalse, "Drifted", strings.Join(drifted, "; "))
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.ReadyCondition, corev1.ConditionFalse, "Drifted", "base infrastructure is being repaired")
+		if err := r.Status().Update(ctx, instance); err != nil {
+			return ctrl.Result{}, err
+		}
 
-	// if instance.Spec.IsValid() {
-	// 	if err := instance.Spec.Bootstrap(); err != nil {
-	// 		r.recorder.Event(instance, "Warning", "Error", fmt.Sprintf("Bootstrap Failed %s", err.Error()))
-	// 		return ctrl.Result{RequeueAfter: 10 * time.Second}, err
-	// 	}
-	// }
+		if err := cluster.Spec.CreateBaseInfrastructure(provider); err != nil {
+			r.EventRecorder.Event(instance, "Warning", "Error", fmt.Sprintf("Base Infrastructure Failed %s", err.Error()))
+			instance.Status.ProvisioningState = "Failed"
+			setFailedCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.InfrastructureReadyCondition, "ProvisioningFailed", err)
+			_ = r.Status().Update(ctx, instance)
+			return ctrl.Result{}, err
+		}
+
+		// A recreated public ip comes with a new address
+		if cluster.Spec.PublicIPAdress != instance.Spec.PublicIPAdress {
+			instance.Spec.PublicIPAdress = cluster.Spec.PublicIPAdress
+			if err := r.Update(ctx, instance); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+
+		if states, err = cluster.Spec.CheckBaseInfrastructure(ctx, provider); err != nil {
+			setFailedCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.InfrastructureReadyCondition, "CheckFailed", err)
+			_ = r.Status().Update(ctx, instance)
+			return ctrl.Result{}, err
+		}
+		resources, drifted = resourceStatuses(states)
+	}
+
+	instance.Status.Resources = resources
+	if len(drifted) > 0 {
+		instance.Status.ProvisioningState = "Failed"
+		setFailedCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.InfrastructureReadyCondition, "Drifted", fmt.Errorf("%s", strings.Join(drifted, "; ")))
+		if err := r.Status().Update(ctx, instance); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, fmt.Errorf("base infrastructure not repaired: %s", strings.Join(drifted, "; "))
+	}
 
 	instance.Status.ProvisioningState = "Succeeded"
+	setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.InfrastructureReadyCondition, corev1.ConditionTrue, "InfrastructureProvisioned", "")
+	setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.ReadyCondition, corev1.ConditionTrue, "Succeeded", "")
 	if err := r.Status().Update(ctx, instance); err != nil {
 		return ctrl.Result{}, err
 	}
 
-	r.EventRecorder.Event(instance, "Normal", "Created", fmt.Sprintf("Completed Cluster Setup %s/%s", req.Namespace, req.Name))
-	return ctrl.Result{}, nil
+	if !wasReady {
+		r.EventRecorder.Event(instance, "Normal", "Created", fmt.Sprintf("Completed Cluster Setup %s/%s", req.Namespace, req.Name))
+	}
+
+	if isCARotationRequested(instance) {
+		return r.reconcileCARotation(ctx, instance, cluster, provider)
+	}
+	return ctrl.Result{RequeueAfter: infrastructureResyncPeriod}, nil
 }
 
 func (r *ClusterReconciler) SetupWithManager(mgr ctrl.Manager) error {
@@ -0,0 +1,111 @@
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	enginev1alpha1 "github.com/awesomenix/azk/api/v1alpha1"
+	azhelpers "github.com/awesomenix/azk/azure"
+	"github.com/awesomenix/azk/azure/fake"
+	"github.com/awesomenix/azk/bootstrap"
+)
+
+var _ = Describe("ClusterReconciler", func() {
+	var (
+		ctx        context.Context
+		cloud      *fake.Cloud
+		reconciler *ClusterReconciler
+		key        types.NamespacedName
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		cloud = fake.NewCloud()
+		reconciler = &ClusterReconciler{
+			Client:        k8sClient,
+			Log:           ctrl.Log.WithName("controllers").WithName("Cluster"),
+			EventRecorder: record.NewFakeRecorder(100),
+			AzureProvider: cloud.Provider,
+		}
+		key = types.NamespacedName{Namespace: "default", Name: "azk"}
+
+		cluster := &enginev1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+			Spec: enginev1alpha1.ClusterSpec{
+				Spec: bootstrap.Spec{
+					CloudConfiguration: azhelpers.CloudConfiguration{
+						CloudName:      azhelpers.AzurePublicCloudName,
+						SubscriptionID: "subscription",
+						ClientID:       "client",
+						ClientSecret:   "secret",
+						TenantID:       "tenant",
+						GroupName:      "group",
+						GroupLocation:  "westus2",
+					},
+					ClusterName: key.Name,
+					DNSPrefix:   "azk",
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, cluster)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		cluster := &enginev1alpha1.Cluster{}
+		Expect(k8sClient.Get(ctx, key, cluster)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, cluster)).To(Succeed())
+		_, err := reconciler.Reconcile(ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	reconcileUntilReady := func() *enginev1alpha1.Cluster {
+		cluster := &enginev1alpha1.Cluster{}
+		for i := 0; i < 5; i++ {
+			_, err := reconciler.Reconcile(ctrl.Request{NamespacedName: key})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, key, cluster)).To(Succeed())
+			if enginev1alpha1.IsConditionTrue(cluster.Status.Conditions, enginev1alpha1.ReadyCondition) {
+				break
+			}
+		}
+		return cluster
+	}
+
+	It("provisions the base infrastructure and reports it ready", func() {
+		cluster := reconcileUntilReady()
+		Expect(enginev1alpha1.IsConditionTrue(cluster.Status.Conditions, enginev1alpha1.InfrastructureReadyCondition)).To(BeTrue())
+		Expect(cluster.Status.ProvisioningState).To(Equal("Succeeded"))
+		Expect(cluster.Spec.PublicIPAdress).NotTo(BeEmpty())
+		Expect(cluster.Spec.ClientSecret).To(BeEmpty())
+		for _, resource := range cluster.Status.Resources {
+			Expect(resource.Ready).To(BeTrue(), "%s %s: %s", resource.Type, resource.Name, resource.Message)
+		}
+	})
+
+	It("repairs drifted resources on resync", func() {
+		reconcileUntilReady()
+
+		provider, err := cloud.Provider(&azhelpers.CloudConfiguration{SubscriptionID: "subscription", GroupName: "group"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(provider.DeleteNetworkSecurityGroup(ctx, "azk-master-nsg")).To(Succeed())
+
+		result, err := reconciler.Reconcile(ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(infrastructureResyncPeriod))
+
+		_, err = provider.GetNetworkSecurityGroup(ctx, "azk-master-nsg")
+		Expect(err).NotTo(HaveOccurred())
+
+		cluster := &enginev1alpha1.Cluster{}
+		Expect(k8sClient.Get(ctx, key, cluster)).To(Succeed())
+		Expect(enginev1alpha1.FindCondition(cluster.Status.Conditions, enginev1alpha1.ReadyCondition).Status).To(Equal(corev1.ConditionTrue))
+	})
+})
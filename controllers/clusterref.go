@@ -0,0 +1,73 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	enginev1alpha1 "github.com/awesomenix/azk/api/v1alpha1"
+)
+
+// getCluster returns the Cluster referenced by clusterRef with its secrets loaded, objects
+// created without a reference fall back to the only Cluster defined in the namespace
+func getCluster(ctx context.Context, c client.Client, namespace string, clusterRef corev1.LocalObjectReference) (*enginev1alpha1.Cluster, error) {
+	if clusterRef.Name != "" {
+		cluster := &enginev1alpha1.Cluster{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: clusterRef.Name}, cluster); err != nil {
+			return nil, err
+		}
+		if err := cluster.LoadSecrets(ctx, c); err != nil {
+			return nil, err
+		}
+		return cluster, nil
+	}
+
+	clusterList := enginev1alpha1.ClusterList{}
+	if err := c.List(ctx, &clusterList, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	switch len(clusterList.Items) {
+	case 0:
+		return nil, fmt.Errorf("no clusters defined")
+	case 1:
+		cluster := &clusterList.Items[0]
+		if err := cluster.LoadSecrets(ctx, c); err != nil {
+			return nil, err
+		}
+		return cluster, nil
+	default:
+		return nil, fmt.Errorf("multiple clusters defined, set clusterRef")
+	}
+}
+
+// isClusterReady returns true once the cluster base infrastructure is provisioned
+func isClusterReady(cluster *enginev1alpha1.Cluster) bool {
+	return enginev1alpha1.IsConditionTrue(cluster.Status.Conditions, enginev1alpha1.ReadyCondition) ||
+		cluster.Status.ProvisioningState == "Succeeded"
+}
+
+// isClusterRef returns true if clusterRef resolves to the named cluster
+func isClusterRef(clusterRef corev1.LocalObjectReference, clusterName string) bool {
+	return clusterRef.Name == "" || clusterRef.Name == clusterName
+}
+
+// setClusterOwnerReference adds cluster as an owner of object, returns true if object was changed
+func setClusterOwnerReference(cluster *enginev1alpha1.Cluster, object metav1.Object) bool {
+	for _, ref := range object.GetOwnerReferences() {
+		if ref.UID == cluster.UID {
+			return false
+		}
+	}
+	object.SetOwnerReferences(append(object.GetOwnerReferences(), metav1.OwnerReference{
+		APIVersion: enginev1alpha1.GroupVersion.String(),
+		Kind:       "Cluster",
+		Name:       cluster.Name,
+		UID:        cluster.UID,
+	}))
+	return true
+}
@@ -0,0 +1,24 @@
+package controllers
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	enginev1alpha1 "github.com/awesomenix/azk/api/v1alpha1"
+)
+
+// setCondition records the condition of given type, observed at the given object generation
+func setCondition(conditions *[]enginev1alpha1.Condition, generation int64, conditionType enginev1alpha1.ConditionType, status corev1.ConditionStatus, reason, message string) {
+	enginev1alpha1.SetCondition(conditions, enginev1alpha1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		ObservedGeneration: generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// setFailedCondition marks the condition of given type and Ready as False, carrying the error
+func setFailedCondition(conditions *[]enginev1alpha1.Condition, generation int64, conditionType enginev1alpha1.ConditionType, reason string, err error) {
+	setCondition(conditions, generation, conditionType, corev1.ConditionFalse, reason, err.Error())
+	setCondition(conditions, generation, enginev1alpha1.ReadyCondition, corev1.ConditionFalse, reason, err.Error())
+}
@@ -2,7 +2,6 @@ package controllers
 
 import (
 	"context"
-	"encoding/base64"
 	"fmt"
 	"time"
 
@@ -11,15 +10,23 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	enginev1alpha1 "github.com/awesomenix/azk/api/v1alpha1"
 	azhelpers "github.com/awesomenix/azk/azure"
 	"github.com/awesomenix/azk/bootstrap"
+	"github.com/awesomenix/azk/cloudinit"
+	"github.com/awesomenix/azk/etcd"
 	"github.com/awesomenix/azk/helpers"
 )
 
@@ -27,62 +34,45 @@ const (
 	masterVmssName = "azk-master-vmss"
 )
 
-func preRequisites(kubernetesVersion, apiServerIP, internalDNSName string) string {
-	return fmt.Sprintf(`
-%[1]s
-sudo cp -f /etc/hosts /tmp/hostsupdate
-sudo chown $(id -u):$(id -g) /tmp/hostsupdate
-echo '%[2]s %[3]s' >> /tmp/hostsupdate
-sudo mv /etc/hosts /etc/hosts.bak
-sudo mv /tmp/hostsupdate /etc/hosts
-`, helpers.PreRequisitesInstallScript(kubernetesVersion), apiServerIP, internalDNSName)
+func getControlPlaneCustomData(instance *enginev1alpha1.ControlPlane, cluster *enginev1alpha1.Cluster, bootstrapToken string) (string, error) {
+	cloudConfig, err := getControlPlaneCloudConfig(instance, cluster, bootstrapToken)
+	if err != nil {
+		return "", err
+	}
+	return cloudConfig.CustomData()
 }
 
-func kubeadmCPJoinConfig(bootstrapToken, internalDNSName, discoveryHash string) string {
-	return fmt.Sprintf(`
-cat <<EOF >/tmp/kubeadm-config.yaml
-apiVersion: kubeadm.k8s.io/v1beta1
-kind: JoinConfiguration
-nodeRegistration:
-  kubeletExtraArgs:
-    cloud-provider: azure
-    cloud-config: /etc/kubernetes/azure.json
-discovery:
-  bootstrapToken:
-    token: %[1]s
-    apiServerEndpoint: "%[2]s:6443"
-    caCertHashes:
-    - %[3]s
-controlPlane:
-  localAPIEndpoint:
-EOF
-`, bootstrapToken,
-		internalDNSName,
-		discoveryHash,
-	)
+// getControlPlaneCloudConfig joins masters of the control plane with the bootstrap token
+func getControlPlaneCloudConfig(instance *enginev1alpha1.ControlPlane, cluster *enginev1alpha1.Cluster, bootstrapToken string) (*cloudinit.Config, error) {
+	startupScript, err := getMasterStartupScript(&cluster.Spec.Spec, instance.Spec.KubernetesVersion, bootstrapToken)
+	if err != nil {
+		return nil, err
+	}
+	return cluster.Spec.MasterCloudConfig(startupScript)
 }
 
-func getMasterStartupScript(kubernetesVersion, apiServerIP, internalDNSName, bootstrapToken, discoveryHash, etcdEndpoints string) string {
+// getMasterStartupScript joins a master through the internal endpoint, resolved by the private dns
+// zone of the cluster, then redirects the endpoint to the local api server
+func getMasterStartupScript(spec *bootstrap.Spec, kubernetesVersion, bootstrapToken string) (string, error) {
+	kubeadmConfig, err := spec.KubeadmJoinConfig(kubernetesVersion, bootstrapToken, true)
+	if err != nil {
+		return "", err
+	}
 	return fmt.Sprintf(`
 set -eux
 %[1]s
 %[2]s
 #Setup using kubeadm
 until sudo kubeadm join --config /tmp/kubeadm-config.yaml > /dev/null; do
-	MEMBER_ID=$(sudo etcdctl --cert-file /etc/kubernetes/pki/etcd/server.crt --key-file /etc/kubernetes/pki/etcd/server.key --ca-file /etc/kubernetes/pki/etcd/ca.crt --endpoints \"%[4]s\" member list | grep -i $(uname -n) | cut -d ':' -f1)
-	[ ! -z "$MEMBER_ID" ] && sudo etcdctl --cert-file /etc/kubernetes/pki/etcd/server.crt --key-file /etc/kubernetes/pki/etcd/server.key --ca-file /etc/kubernetes/pki/etcd/ca.crt --endpoints \"%[4]s\" member remove $MEMBER_ID
-	sudo rm -rf /etc/kubernetes/manifests
+	# the control plane controller removes the stale etcd member of a failed join
+	sudo rm -rf /etc/kubernetes/manifests /var/lib/etcd
 	sleep 30
 done
-sudo cp -f /etc/hosts.bak /tmp/hostsupdate
-sudo chown $(id -u):$(id -g) /tmp/hostsupdate
-echo '127.0.0.1 %[3]s' >> /tmp/hostsupdate
-sudo mv /tmp/hostsupdate /etc/hosts
-`, preRequisites(kubernetesVersion, apiServerIP, internalDNSName),
-		kubeadmCPJoinConfig(bootstrapToken, internalDNSName, discoveryHash),
-		internalDNSName,
-		etcdEndpoints,
-	)
+%[3]s
+`, helpers.PreRequisitesInstallScript(kubernetesVersion),
+		kubeadmConfig,
+		helpers.APIServerHairpinScript(spec.Networking.WithDefaults().InternalLoadBalancerIP),
+	), nil
 }
 
 func getUpgradeScript(instance *enginev1alpha1.ControlPlane) string {
@@ -103,6 +93,10 @@ type ControlPlaneReconciler struct {
 	client.Client
 	Log logr.Logger
 	record.EventRecorder
+	// AzureProvider builds the azure provider for a cluster, the azure apis are used when nil
+	AzureProvider azhelpers.ProviderFactory
+	// Etcd builds the client of the control plane etcd cluster, the etcd json gateway is used when nil
+	Etcd etcd.ClusterFactory
 }
 
 // +kubebuilder:rbac:groups=engine.azk.io,resources=controlplanes,verbs=get;list;watch;create;update;patch;delete
@@ -126,49 +120,106 @@ func (r *ControlPlaneReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error
 		return ctrl.Result{}, err
 	}
 
-	if instance.Spec.KubernetesVersion == instance.Status.KubernetesVersion {
+	replicas := instance.Spec.DesiredReplicas()
+	if err := enginev1alpha1.ValidateControlPlaneReplicas(replicas); err != nil {
+		setFailedCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.ScalingInProgressCondition, "InvalidReplicas", err)
+		_ = r.Status().Update(ctx, instance)
+		// nothing to retry until the spec is fixed
 		return ctrl.Result{}, nil
 	}
 
-	cluster, err := r.getCluster(ctx, instance.Namespace)
+	cluster, err := getCluster(ctx, r.Client, instance.Namespace, instance.Spec.ClusterRef)
 	if err != nil {
 		return ctrl.Result{Requeue: true, RequeueAfter: 10 * time.Second}, err
 	}
 
-	if cluster.Status.ProvisioningState != "Succeeded" {
-		// Wait for cluster to initialize
-		return ctrl.Result{Requeue: true, RequeueAfter: 10 * time.Second}, nil
+	if instance.Spec.ClusterRef.Name == "" || setClusterOwnerReference(cluster, instance) {
+		instance.Spec.ClusterRef.Name = cluster.Name
+		if err := r.Update(ctx, instance); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if !isClusterReady(cluster) {
+		// Wait for cluster to initialize, cluster watch requeues once its ready
+		return ctrl.Result{}, nil
 	}
 
-	if err := r.updateVMSSStatus(instance, cluster); err != nil {
+	provider, err := newProvider(r.AzureProvider, &cluster.Spec.CloudConfiguration)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	observed := instance.Status.DeepCopy()
+	if err := r.updateVMSSStatus(instance, provider); err != nil {
 		return ctrl.Result{}, err
 	}
 
+	if err := r.reconcileEtcdMembers(ctx, instance, cluster); err != nil {
+		log.Info("Cannot check etcd members", "Error", err)
+		instance.Status.EtcdMembers = nil
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.EtcdHealthyCondition, corev1.ConditionUnknown, "EtcdUnreachable", err.Error())
+	}
+
 	if instance.Status.ProvisioningState == "Succeeded" &&
-		instance.Spec.KubernetesVersion == instance.Status.KubernetesVersion {
-		return ctrl.Result{}, nil
+		instance.Spec.KubernetesVersion == instance.Status.KubernetesVersion &&
+		int(replicas) == len(instance.Status.NodeStatus) {
+		// control planes created before replicas were configurable
+		instance.Status.Replicas = replicas
+		if isCertificatesCheckDue(instance, time.Now()) {
+			if err := r.reconcileCertificates(ctx, instance, cluster, provider); err != nil {
+				log.Info("Cannot renew certificates", "Error", err)
+			}
+		}
+		if !apiequality.Semantic.DeepEqual(observed, &instance.Status) {
+			if err := r.Status().Update(ctx, instance); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{RequeueAfter: etcdResyncPeriod}, nil
 	}
 
 	log.Info("Updating Control Plane",
 		"CurrentKubernetesVersion", instance.Status.KubernetesVersion,
-		"ExpectedKubernetesVersion", instance.Spec.KubernetesVersion)
+		"ExpectedKubernetesVersion", instance.Spec.KubernetesVersion,
+		"CurrentReplicas", len(instance.Status.NodeStatus),
+		"ExpectedReplicas", replicas)
+
+	isUpgrade := instance.Status.KubernetesVersion != "" &&
+		instance.Status.KubernetesVersion != instance.Spec.KubernetesVersion
 
 	instance.Status.ProvisioningState = "Updating"
+	setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.ReadyCondition, corev1.ConditionFalse, "Updating", "")
+	if isUpgrade {
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.UpgradeInProgressCondition, corev1.ConditionTrue, "Upgrading",
+			fmt.Sprintf("Upgrading from %s to %s", instance.Status.KubernetesVersion, instance.Spec.KubernetesVersion))
+	}
+	isScaling := instance.Status.Replicas != 0 && int(replicas) != len(instance.Status.NodeStatus)
+	if isScaling {
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.ScalingInProgressCondition, corev1.ConditionTrue, "Scaling",
+			fmt.Sprintf("Scaling from %d to %d", len(instance.Status.NodeStatus), replicas))
+	}
 	if err := r.Status().Update(ctx, instance); err != nil {
 		return ctrl.Result{}, err
 	}
 
-	customData := map[string]string{
-		"/etc/kubernetes/pki/ca.crt":             cluster.Spec.CACertificate,
-		"/etc/kubernetes/pki/ca.key":             cluster.Spec.CACertificateKey,
-		"/etc/kubernetes/pki/sa.key":             cluster.Spec.ServiceAccountKey,
-		"/etc/kubernetes/pki/sa.pub":             cluster.Spec.ServiceAccountPub,
-		"/etc/kubernetes/pki/front-proxy-ca.crt": cluster.Spec.FrontProxyCACertificate,
-		"/etc/kubernetes/pki/front-proxy-ca.key": cluster.Spec.FrontProxyCACertificateKey,
-		"/etc/kubernetes/pki/etcd/ca.crt":        cluster.Spec.EtcdCACertificate,
-		"/etc/kubernetes/pki/etcd/ca.key":        cluster.Spec.EtcdCACertificateKey,
-		"/etc/kubernetes/azure.json":             cluster.Spec.AzureCloudProviderConfig,
-		//"/etc/kubernetes/admin.conf":             cluster.Status.AdminKubeConfig,
+	if len(instance.Status.NodeStatus) > int(replicas) {
+		if err := r.scaleDownControlPlane(ctx, instance, cluster, provider); err != nil {
+			if _, refused := err.(scaleDownRefusedError); refused {
+				log.Info("Waiting to scale down", "Reason", err.Error())
+				setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.ScalingInProgressCondition, corev1.ConditionTrue, "WaitingForQuorum", err.Error())
+				if err := r.Status().Update(ctx, instance); err != nil {
+					return ctrl.Result{}, err
+				}
+				return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+			}
+			setFailedCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.ScalingInProgressCondition, "ScaleDownFailed", err)
+			_ = r.Status().Update(ctx, instance)
+			return ctrl.Result{Requeue: true, RequeueAfter: 30 * time.Second}, err
+		}
+		if err := r.updateVMSSStatus(instance, provider); err != nil {
+			return ctrl.Result{}, err
+		}
 	}
 
 	vmSKUType := instance.Spec.VMSKUType
@@ -178,72 +229,59 @@ func (r *ControlPlaneReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error
 
 	bootstrapToken, err := bootstrap.CreateNewBootstrapToken()
 	if err != nil {
+		setFailedCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.BootstrapTokenReadyCondition, "CreateBootstrapTokenFailed", err)
+		_ = r.Status().Update(ctx, instance)
 		return ctrl.Result{Requeue: true, RequeueAfter: 30 * time.Second}, err
 	}
+	setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.BootstrapTokenReadyCondition, corev1.ConditionTrue, "BootstrapTokenCreated", "")
 
-	var etcdEndpoints string
-	for _, nodeStatus := range instance.Status.NodeStatus {
-		if etcdEndpoints == "" {
-			etcdEndpoints = fmt.Sprintf("https://%s:2379", nodeStatus.VMComputerName)
-			continue
-		}
-		etcdEndpoints = fmt.Sprintf("%s,https://%s:2379", etcdEndpoints, nodeStatus.VMComputerName)
-	}
-
-	startupScript := getMasterStartupScript(
-		instance.Spec.KubernetesVersion,
-		cluster.Spec.PublicIPAdress,
-		cluster.Spec.InternalDNSName,
-		bootstrapToken,
-		cluster.Spec.DiscoveryHashes[0],
-		etcdEndpoints,
-	)
-
-	customRunData := map[string]string{
-		"/etc/kubernetes/init-azure-bootstrap.sh": startupScript,
-	}
-
-	prefix := fmt.Sprintf(
-		"/subscriptions/%s/resourceGroups/%s/providers",
-		cluster.Spec.SubscriptionID,
-		cluster.Spec.GroupName)
-
-	subnetID := prefix + "/Microsoft.Network/virtualNetworks/azk-vnet/subnets/master-subnet"
-
-	loadbalancerIDs := []string{
-		prefix + "/Microsoft.Network/loadBalancers/azk-lb/backendAddressPools/master-backEndPool",
-		prefix + "/Microsoft.Network/loadBalancers/azk-internal-lb/backendAddressPools/master-internal-backEndPool",
+	customData, err := getControlPlaneCustomData(instance, cluster, bootstrapToken)
+	if err != nil {
+		setFailedCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.VMSSReadyCondition, "CustomDataFailed", err)
+		_ = r.Status().Update(ctx, instance)
+		return ctrl.Result{}, err
 	}
 
-	natPoolIDs := []string{
-		prefix + "/Microsoft.Network/loadBalancers/azk-lb/inboundNatPools/natSSHPool",
-	}
+	loadbalancerIDs, natPoolIDs := cluster.Spec.MasterLoadBalancerPools()
 
 	log.Info("Creating or Updating", "VMSS", masterVmssName)
-	if err := cluster.Spec.CreateVMSS(
+	if err := provider.CreateVMSS(
 		ctx,
 		masterVmssName,
-		subnetID,
+		cluster.Spec.MasterSubnetID(),
 		loadbalancerIDs,
 		natPoolIDs,
-		base64.StdEncoding.EncodeToString([]byte(azhelpers.GetCustomData(customData, customRunData))),
+		customData,
 		vmSKUType,
-		3); err != nil {
+		int(replicas),
+		cluster.Spec.Networking.IPConfigurations()); err != nil {
+		setFailedCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.VMSSReadyCondition, "CreateVMSSFailed", err)
+		_ = r.Status().Update(ctx, instance)
 		return ctrl.Result{}, err
 	}
 	log.Info("Successfully Created or Updated", "VMSS", masterVmssName)
-	if instance.Status.KubernetesVersion != "" &&
-		instance.Status.KubernetesVersion != instance.Spec.KubernetesVersion {
-		if err := r.upgradeVMSS(instance, cluster); err != nil {
+	setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.VMSSReadyCondition, corev1.ConditionTrue, "VMSSProvisioned", "")
+	if isUpgrade {
+		if err := r.upgradeVMSS(instance, cluster, provider); err != nil {
+			setFailedCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.NodesReadyCondition, "UpgradeFailed", err)
+			_ = r.Status().Update(ctx, instance)
 			return ctrl.Result{}, err
 		}
 	}
-	if err := helpers.WaitForNodesReady(r.Client, masterVmssName, 3); err != nil {
+	if err := helpers.WaitForNodesReady(r.Client, masterVmssName, int(replicas)); err != nil {
+		setFailedCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.NodesReadyCondition, "NodesNotReady", err)
+		_ = r.Status().Update(ctx, instance)
 		return ctrl.Result{Requeue: true, RequeueAfter: 30 * time.Second}, err
 	}
 
 	instance.Status.KubernetesVersion = instance.Spec.KubernetesVersion
+	instance.Status.Replicas = replicas
 	instance.Status.ProvisioningState = "Succeeded"
+	instance.Status.ObservedGeneration = instance.Generation
+	setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.NodesReadyCondition, corev1.ConditionTrue, "NodesReady", "")
+	setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.UpgradeInProgressCondition, corev1.ConditionFalse, "UpgradeCompleted", "")
+	setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.ScalingInProgressCondition, corev1.ConditionFalse, "ScalingCompleted", "")
+	setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.ReadyCondition, corev1.ConditionTrue, "Succeeded", "")
 	if err := r.Status().Update(ctx, instance); err != nil {
 		return ctrl.Result{}, err
 	}
@@ -253,40 +291,18 @@ func (r *ControlPlaneReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error
 	return ctrl.Result{}, nil
 }
 
-func (r *ControlPlaneReconciler) getCluster(ctx context.Context, namespace string) (*enginev1alpha1.Cluster, error) {
-	clusterList := enginev1alpha1.ClusterList{}
-	if err := r.Client.List(ctx, &clusterList, client.InNamespace(namespace)); err != nil {
-		return nil, err
-	}
-
-	switch len(clusterList.Items) {
-	case 0:
-		return nil, fmt.Errorf("no clusters defined")
-	case 1:
-		return &clusterList.Items[0], nil
-	default:
-		return nil, fmt.Errorf("multiple clusters defined")
-	}
-}
-
-func (r *ControlPlaneReconciler) updateVMSSStatus(instance *enginev1alpha1.ControlPlane, cluster *enginev1alpha1.Cluster) error {
+func (r *ControlPlaneReconciler) updateVMSSStatus(instance *enginev1alpha1.ControlPlane, provider azhelpers.Provider) error {
 	ctx := context.Background()
 	log := r.Log.WithValues("controlplane", instance.Name)
 
-	vmssVMClient, err := cluster.Spec.GetVMSSVMsClient()
-	if err != nil {
-		log.Error(err, "Error GetVMSSVMsClient", "VMSS", masterVmssName)
-		return err
-	}
-
-	result, err := vmssVMClient.List(ctx, cluster.Spec.GroupName, masterVmssName, "", "", string(compute.InstanceView))
+	vms, err := provider.ListVMSSVMs(ctx, masterVmssName)
 	if err != nil {
-		log.Error(err, "Error vmssVMClient List", "VMSS", masterVmssName)
+		log.Error(err, "Error ListVMSSVMs", "VMSS", masterVmssName)
 		return err
 	}
 
 	var vmStatus []enginev1alpha1.VMStatus
-	for _, vmID := range result.Values() {
+	for _, vmID := range vms {
 		log.Info("Appending to VMSS ControlPlane list", "VM", *vmID.OsProfile.ComputerName)
 		var status enginev1alpha1.VMStatus
 		status.VMComputerName = *vmID.OsProfile.ComputerName
@@ -297,16 +313,76 @@ func (r *ControlPlaneReconciler) updateVMSSStatus(instance *enginev1alpha1.Contr
 	return nil
 }
 
-func (r *ControlPlaneReconciler) upgradeVMSS(instance *enginev1alpha1.ControlPlane, cluster *enginev1alpha1.Cluster) error {
-	ctx := context.Background()
+// scaleDownRefusedError reports a master that cannot be removed until enough masters are healthy to
+// keep etcd quorum, the scale down waits for them rather than failing
+type scaleDownRefusedError struct {
+	error
+}
+
+// scaleDownControlPlane removes masters beyond the desired replicas one at a time, not ready masters
+// first. Each master is drained and removed from etcd before its instance is deleted, and the
+// removal is refused with a scaleDownRefusedError when the remaining masters could not keep etcd
+// quorum.
+func (r *ControlPlaneReconciler) scaleDownControlPlane(ctx context.Context, instance *enginev1alpha1.ControlPlane, cluster *enginev1alpha1.Cluster, provider azhelpers.Provider) error {
 	log := r.Log.WithValues("controlplane", instance.Name)
 
-	vmssVMClient, err := cluster.Spec.GetVMSSVMsClient()
-	if err != nil {
-		log.Error(err, "Error GetVMSSVMsClient", "VMSS", masterVmssName)
-		return err
+	var ready, notReady []enginev1alpha1.VMStatus
+	registered := map[string]bool{}
+	for _, nodeStatus := range instance.Status.NodeStatus {
+		found, isReady, err := helpers.IsNodeReady(r.Client, nodeStatus.VMComputerName)
+		if err != nil {
+			return err
+		}
+		registered[nodeStatus.VMComputerName] = found
+		if isReady {
+			ready = append(ready, nodeStatus)
+		} else {
+			notReady = append(notReady, nodeStatus)
+		}
+	}
+
+	for len(ready)+len(notReady) > int(instance.Spec.DesiredReplicas()) {
+		var nodeStatus enginev1alpha1.VMStatus
+		if len(notReady) > 0 {
+			nodeStatus, notReady = notReady[len(notReady)-1], notReady[:len(notReady)-1]
+		} else {
+			nodeStatus, ready = ready[len(ready)-1], ready[:len(ready)-1]
+		}
+
+		members := len(ready) + len(notReady)
+		if quorum := members/2 + 1; len(ready) < quorum {
+			return scaleDownRefusedError{fmt.Errorf("cannot remove %s, only %d of the remaining %d control plane nodes are ready and etcd quorum needs %d",
+				nodeStatus.VMComputerName, len(ready), members, quorum)}
+		}
+
+		if err := r.checkEtcdDisruption(ctx, instance, cluster, nodeStatus.VMComputerName, true); err != nil {
+			return scaleDownRefusedError{fmt.Errorf("cannot remove %s: %v", nodeStatus.VMComputerName, err)}
+		}
+
+		if registered[nodeStatus.VMComputerName] {
+			if err := helpers.CordonDrainAndDeleteNode(cluster.Spec.CustomerKubeConfig, nodeStatus.VMComputerName); err != nil {
+				return err
+			}
+		}
+
+		if err := r.removeEtcdMember(ctx, instance, cluster, nodeStatus.VMComputerName); err != nil {
+			return err
+		}
+
+		log.Info("Scaling down", "VMSS", masterVmssName, "VM", nodeStatus.VMComputerName)
+		if err := provider.DeleteVMSSVM(ctx, masterVmssName, nodeStatus.VMInstanceID); err != nil {
+			return err
+		}
+		r.EventRecorder.Event(instance, "Normal", "ScaledDown", nodeStatus.VMComputerName)
 	}
 
+	return nil
+}
+
+func (r *ControlPlaneReconciler) upgradeVMSS(instance *enginev1alpha1.ControlPlane, cluster *enginev1alpha1.Cluster, provider azhelpers.Provider) error {
+	ctx := context.Background()
+	log := r.Log.WithValues("controlplane", instance.Name)
+
 	upgradeCommand := compute.RunCommandInput{
 		CommandID: to.StringPtr("RunShellScript"),
 		Script: &[]string{
@@ -323,20 +399,13 @@ func (r *ControlPlaneReconciler) upgradeVMSS(instance *enginev1alpha1.ControlPla
 			continue
 		}
 
-		log.Info("Running Custom Script Extension, Upgrading", "VM", nodeStatus.VMComputerName, "KubernetesVersion", instance.Spec.KubernetesVersion)
-
-		future, err := vmssVMClient.RunCommand(ctx, cluster.Spec.GroupName, masterVmssName, nodeStatus.VMInstanceID, upgradeCommand)
-		if err != nil {
-			log.Error(err, "Error vmssVMClient Upgrade", "VMSS", masterVmssName)
-			return err
-		}
-		err = future.WaitForCompletionRef(ctx, vmssVMClient.Client)
-		if err != nil {
-			return fmt.Errorf("cannot get the vmss update future response: %v", err)
+		if err := r.checkEtcdDisruption(ctx, instance, cluster, nodeStatus.VMComputerName, false); err != nil {
+			return fmt.Errorf("cannot upgrade %s: %v", nodeStatus.VMComputerName, err)
 		}
 
-		_, err = future.Result(vmssVMClient)
-		if err != nil {
+		log.Info("Running Custom Script Extension, Upgrading", "VM", nodeStatus.VMComputerName, "KubernetesVersion", instance.Spec.KubernetesVersion)
+
+		if _, err := provider.RunCommandVMSSVM(ctx, masterVmssName, nodeStatus.VMInstanceID, upgradeCommand); err != nil {
 			log.Error(err, "Error Upgrading", "VMSS", masterVmssName, "VM", nodeStatus.VMComputerName)
 			return err
 		}
@@ -352,16 +421,10 @@ func (r *ControlPlaneReconciler) upgradeVMSS(instance *enginev1alpha1.ControlPla
 	return nil
 }
 
-func (r *ControlPlaneReconciler) upgradeVMSSWithReimage(instance *enginev1alpha1.ControlPlane, cluster *enginev1alpha1.Cluster) error {
+func (r *ControlPlaneReconciler) upgradeVMSSWithReimage(instance *enginev1alpha1.ControlPlane, cluster *enginev1alpha1.Cluster, provider azhelpers.Provider) error {
 	ctx := context.Background()
 	log := r.Log.WithValues("controlplane", instance.Name)
 
-	vmssVMClient, err := cluster.Spec.GetVMSSVMsClient()
-	if err != nil {
-		log.Error(err, "Error GetVMSSVMsClient", "VMSS", masterVmssName)
-		return err
-	}
-
 	for _, nodeStatus := range instance.Status.NodeStatus {
 		if isUpdated, err := helpers.IsNodeUpdated(r.Client, nodeStatus.VMComputerName, instance.Spec.KubernetesVersion); err != nil {
 			log.Error(err, "Error checking upgrade version", "VM", nodeStatus.VMComputerName)
@@ -371,6 +434,10 @@ func (r *ControlPlaneReconciler) upgradeVMSSWithReimage(instance *enginev1alpha1
 			continue
 		}
 
+		if err := r.checkEtcdDisruption(ctx, instance, cluster, nodeStatus.VMComputerName, false); err != nil {
+			return fmt.Errorf("cannot reimage %s: %v", nodeStatus.VMComputerName, err)
+		}
+
 		log.Info("Cordon, Drain and Delete Node", "VM", nodeStatus.VMComputerName, "KubernetesVersion", instance.Spec.KubernetesVersion)
 		if err := helpers.CordonDrainAndDeleteNode(cluster.Spec.CustomerKubeConfig, nodeStatus.VMComputerName); err != nil {
 			log.Info("Error in Cordon and Drain", "Error", err, "VM", nodeStatus.VMComputerName)
@@ -378,18 +445,7 @@ func (r *ControlPlaneReconciler) upgradeVMSSWithReimage(instance *enginev1alpha1
 
 		log.Info("Upgrading using Reimage", "VM", nodeStatus.VMComputerName, "KubernetesVersion", instance.Spec.KubernetesVersion)
 
-		future, err := vmssVMClient.Reimage(ctx, cluster.Spec.GroupName, masterVmssName, nodeStatus.VMInstanceID, nil)
-		if err != nil {
-			log.Error(err, "Error vmssVMClient Upgrade", "VMSS", masterVmssName)
-			return err
-		}
-		err = future.WaitForCompletionRef(ctx, vmssVMClient.Client)
-		if err != nil {
-			return fmt.Errorf("cannot get the vmss update future response: %v", err)
-		}
-
-		_, err = future.Result(vmssVMClient)
-		if err != nil {
+		if err := provider.ReimageVMSSVM(ctx, masterVmssName, nodeStatus.VMInstanceID); err != nil {
 			log.Error(err, "Error Upgrading", "VMSS", masterVmssName, "VM", nodeStatus.VMComputerName)
 			return err
 		}
@@ -408,6 +464,27 @@ func (r *ControlPlaneReconciler) upgradeVMSSWithReimage(instance *enginev1alpha1
 func (r *ControlPlaneReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&enginev1alpha1.ControlPlane{}).
+		Watches(&source.Kind{Type: &enginev1alpha1.Cluster{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.clusterToControlPlanes),
+		}).
 		WithOptions(controller.Options{MaxConcurrentReconciles: 30}).
 		Complete(r)
 }
+
+func (r *ControlPlaneReconciler) clusterToControlPlanes(o handler.MapObject) []reconcile.Request {
+	controlPlaneList := enginev1alpha1.ControlPlaneList{}
+	if err := r.List(context.TODO(), &controlPlaneList, client.InNamespace(o.Meta.GetNamespace())); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, controlPlane := range controlPlaneList.Items {
+		if !isClusterRef(controlPlane.Spec.ClusterRef, o.Meta.GetName()) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: controlPlane.Namespace, Name: controlPlane.Name},
+		})
+	}
+	return requests
+}
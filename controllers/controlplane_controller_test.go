@@ -0,0 +1,109 @@
+package controllers
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	enginev1alpha1 "github.com/awesomenix/azk/api/v1alpha1"
+	azhelpers "github.com/awesomenix/azk/azure"
+	azfake "github.com/awesomenix/azk/azure/fake"
+)
+
+func TestScaleDownControlPlane(t *testing.T) {
+	tests := []struct {
+		name     string
+		replicas int32
+		ready    []bool
+		// want lists the indexes of the masters left once the scale down returns
+		want    []int
+		refused bool
+	}{
+		{name: "not ready masters first", replicas: 3, ready: []bool{true, false, true, true, false}, want: []int{0, 2, 3}},
+		{name: "last ready master", replicas: 1, ready: []bool{true, true, true}, want: []int{0}},
+		{name: "quorum lost", replicas: 1, ready: []bool{true, false, false}, want: []int{0, 1, 2}, refused: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			cloud := azfake.NewCloud()
+			provider, err := cloud.Provider(&azhelpers.CloudConfiguration{SubscriptionID: "subscription", GroupName: "group", GroupLocation: "westus2"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := provider.CreateOrUpdateResourceGroup(ctx); err != nil {
+				t.Fatal(err)
+			}
+			if err := provider.CreateVMSS(ctx, masterVmssName, "", nil, nil, "", "Standard_D2s_v3", len(tt.ready), 1); err != nil {
+				t.Fatal(err)
+			}
+			vms, err := provider.ListVMSSVMs(ctx, masterVmssName)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			instance := &enginev1alpha1.ControlPlane{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "azk-controlplane"}}
+			instance.Spec.Replicas = to.Int32Ptr(tt.replicas)
+			objs := []runtime.Object{instance}
+			var names []string
+			for i, vm := range vms {
+				name := to.String(vm.OsProfile.ComputerName)
+				names = append(names, name)
+				instance.Status.NodeStatus = append(instance.Status.NodeStatus, enginev1alpha1.VMStatus{VMComputerName: name, VMInstanceID: to.String(vm.InstanceID)})
+				status := corev1.ConditionFalse
+				if tt.ready[i] {
+					status = corev1.ConditionTrue
+				}
+				objs = append(objs, &corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{Name: name},
+					Status:     corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: status}}},
+				})
+			}
+			etcdCluster := newFakeEtcdCluster(names...)
+			for i, ready := range tt.ready {
+				etcdCluster.unhealthy[names[i]] = !ready
+			}
+
+			s := runtime.NewScheme()
+			_ = clientgoscheme.AddToScheme(s)
+			_ = enginev1alpha1.AddToScheme(s)
+			r := &ControlPlaneReconciler{
+				Client:        fake.NewFakeClientWithScheme(s, objs...),
+				Log:           ctrl.Log.WithName("test"),
+				EventRecorder: record.NewFakeRecorder(10),
+				Etcd:          etcdCluster.factory,
+			}
+			err = r.scaleDownControlPlane(ctx, instance, &enginev1alpha1.Cluster{}, provider)
+			if _, refused := err.(scaleDownRefusedError); refused != tt.refused || (err != nil && !refused) {
+				t.Fatalf("scaleDownControlPlane() error = %v, refused %v", err, tt.refused)
+			}
+
+			vms, err = provider.ListVMSSVMs(ctx, masterVmssName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var remaining, want []string
+			for _, vm := range vms {
+				remaining = append(remaining, to.String(vm.OsProfile.ComputerName))
+			}
+			for _, i := range tt.want {
+				want = append(want, names[i])
+			}
+			if !reflect.DeepEqual(remaining, want) {
+				t.Errorf("Expected masters %v to remain, got %v", want, remaining)
+			}
+			if len(etcdCluster.removed) != len(names)-len(want) {
+				t.Errorf("Expected the etcd members of the removed masters to be removed, got %v", etcdCluster.removed)
+			}
+		})
+	}
+}
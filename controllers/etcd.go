@@ -0,0 +1,238 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	enginev1alpha1 "github.com/awesomenix/azk/api/v1alpha1"
+	"github.com/awesomenix/azk/etcd"
+	"github.com/awesomenix/azk/helpers"
+)
+
+// etcdResyncPeriod is how often settled control planes check their etcd members
+const etcdResyncPeriod = 5 * time.Minute
+
+// newEtcdCluster returns the etcd cluster served by the masters of the control plane, defaulting to the etcd json gateway
+func newEtcdCluster(factory etcd.ClusterFactory, instance *enginev1alpha1.ControlPlane, cluster *enginev1alpha1.Cluster) (etcd.Cluster, error) {
+	if factory == nil {
+		factory = etcd.NewCluster
+	}
+	var endpoints []string
+	for _, nodeStatus := range instance.Status.NodeStatus {
+		endpoints = append(endpoints, fmt.Sprintf("https://%s:2379", nodeStatus.VMComputerName))
+	}
+	return factory(endpoints, cluster.Spec.EtcdCACertificate, cluster.Spec.EtcdCACertificateKey)
+}
+
+// etcdMemberStatuses lists the etcd members and checks the health of each through its client urls
+func etcdMemberStatuses(ctx context.Context, etcdCluster etcd.Cluster) ([]etcd.Member, []enginev1alpha1.EtcdMemberStatus, error) {
+	members, err := etcdCluster.MemberList(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	statuses := make([]enginev1alpha1.EtcdMemberStatus, 0, len(members))
+	for _, member := range members {
+		status := enginev1alpha1.EtcdMemberStatus{
+			Name:    member.Name,
+			ID:      fmt.Sprintf("%x", member.ID),
+			Message: "member not started",
+		}
+		for _, clientURL := range member.ClientURLs {
+			s, err := etcdCluster.Status(ctx, clientURL)
+			if err != nil {
+				status.Message = err.Error()
+				continue
+			}
+			status.Healthy = true
+			status.Leader = s.Leader == member.ID
+			status.Message = ""
+			break
+		}
+		statuses = append(statuses, status)
+	}
+	return members, statuses, nil
+}
+
+// etcdMemberID returns the id of the member running on the named master, empty if it has none
+func etcdMemberID(statuses []enginev1alpha1.EtcdMemberStatus, name string) string {
+	for _, status := range statuses {
+		if status.Name != "" && status.Name == name {
+			return status.ID
+		}
+	}
+	return ""
+}
+
+// checkEtcdQuorum returns an error when etcd would lose quorum once the member is down, or
+// removed from the cluster. A single member has no redundancy to protect and may go down.
+func checkEtcdQuorum(statuses []enginev1alpha1.EtcdMemberStatus, id string, removed bool) error {
+	if !removed && len(statuses) == 1 {
+		return nil
+	}
+
+	size, healthy := 0, 0
+	for _, status := range statuses {
+		if status.ID == id {
+			if !removed {
+				size++
+			}
+			continue
+		}
+		size++
+		if status.Healthy {
+			healthy++
+		}
+	}
+	if size == 0 {
+		return fmt.Errorf("cannot remove the last etcd member")
+	}
+	if quorum := size/2 + 1; healthy < quorum {
+		return fmt.Errorf("etcd quorum at risk, %d of %d etcd members would remain healthy and quorum needs %d", healthy, size, quorum)
+	}
+	return nil
+}
+
+// checkEtcdDisruption returns an error when taking the named master down, or removing its member,
+// would lose etcd quorum. Member health is observed live, an unreachable etcd cluster refuses the
+// disruption like an unhealthy one.
+func (r *ControlPlaneReconciler) checkEtcdDisruption(ctx context.Context, instance *enginev1alpha1.ControlPlane, cluster *enginev1alpha1.Cluster, name string, removed bool) error {
+	etcdCluster, err := newEtcdCluster(r.Etcd, instance, cluster)
+	if err != nil {
+		return err
+	}
+	_, statuses, err := etcdMemberStatuses(ctx, etcdCluster)
+	if err != nil {
+		return fmt.Errorf("cannot check etcd quorum: %v", err)
+	}
+	return checkEtcdQuorum(statuses, etcdMemberID(statuses, name), removed)
+}
+
+// removeEtcdMember removes the member running on the named master
+func (r *ControlPlaneReconciler) removeEtcdMember(ctx context.Context, instance *enginev1alpha1.ControlPlane, cluster *enginev1alpha1.Cluster, name string) error {
+	log := r.Log.WithValues("controlplane", instance.Name)
+
+	etcdCluster, err := newEtcdCluster(r.Etcd, instance, cluster)
+	if err != nil {
+		return err
+	}
+	members, err := etcdCluster.MemberList(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot list etcd members: %v", err)
+	}
+	for _, member := range members {
+		if member.Name != name {
+			continue
+		}
+		log.Info("Removing etcd member", "VM", name, "Member", fmt.Sprintf("%x", member.ID))
+		if err := etcdCluster.RemoveMember(ctx, member.ID); err != nil {
+			return fmt.Errorf("cannot remove etcd member %s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// reconcileEtcdMembers records the health of every etcd member in the status and removes stale
+// members. A member is stale when its master no longer exists, or when it was unhealthy in two
+// consecutive checks while never started or while its master is not a Ready node, which happens
+// when a master failed to join after its member was added. Removals never break quorum.
+func (r *ControlPlaneReconciler) reconcileEtcdMembers(ctx context.Context, instance *enginev1alpha1.ControlPlane, cluster *enginev1alpha1.Cluster) error {
+	log := r.Log.WithValues("controlplane", instance.Name)
+
+	if len(instance.Status.NodeStatus) == 0 {
+		return nil
+	}
+	etcdCluster, err := newEtcdCluster(r.Etcd, instance, cluster)
+	if err != nil {
+		return err
+	}
+	members, statuses, err := etcdMemberStatuses(ctx, etcdCluster)
+	if err != nil {
+		return err
+	}
+
+	masters := map[string]bool{}
+	for _, nodeStatus := range instance.Status.NodeStatus {
+		masters[nodeStatus.VMComputerName] = true
+	}
+	wasUnhealthy := map[string]bool{}
+	for _, status := range instance.Status.EtcdMembers {
+		wasUnhealthy[status.ID] = !status.Healthy
+	}
+
+	current := statuses
+	for i, member := range members {
+		status := statuses[i]
+		var stale string
+		switch {
+		case member.Name != "" && !masters[member.Name]:
+			stale = "master no longer exists"
+		case !status.Healthy && wasUnhealthy[status.ID] && member.Name == "":
+			stale = "member never started"
+		case !status.Healthy && wasUnhealthy[status.ID]:
+			_, ready, err := helpers.IsNodeReady(r.Client, member.Name)
+			if err != nil {
+				return err
+			}
+			if !ready {
+				stale = "member and node not ready"
+			}
+		}
+		if stale == "" {
+			continue
+		}
+
+		if err := checkEtcdQuorum(current, status.ID, true); err != nil {
+			log.Info("Keeping stale etcd member", "Member", status.ID, "Name", member.Name, "Reason", stale, "Error", err)
+			continue
+		}
+		log.Info("Removing stale etcd member", "Member", status.ID, "Name", member.Name, "Reason", stale)
+		if err := etcdCluster.RemoveMember(ctx, member.ID); err != nil {
+			return fmt.Errorf("cannot remove stale etcd member %s: %v", status.ID, err)
+		}
+		r.EventRecorder.Event(instance, "Normal", "EtcdMemberRemoved", fmt.Sprintf("%s %s: %s", status.ID, member.Name, stale))
+
+		var remaining []enginev1alpha1.EtcdMemberStatus
+		for _, s := range current {
+			if s.ID != status.ID {
+				remaining = append(remaining, s)
+			}
+		}
+		current = remaining
+	}
+	instance.Status.EtcdMembers = current
+
+	var unhealthy []string
+	for _, status := range current {
+		if !status.Healthy {
+			name := status.Name
+			if name == "" {
+				name = status.ID
+			}
+			unhealthy = append(unhealthy, fmt.Sprintf("%s: %s", name, status.Message))
+		}
+	}
+	var quorumErr error
+	for _, status := range current {
+		if status.Healthy {
+			// losing any further healthy member must keep quorum
+			quorumErr = checkEtcdQuorum(current, status.ID, false)
+			break
+		}
+	}
+	switch {
+	case quorumErr != nil:
+		message := fmt.Sprintf("%v, %s", quorumErr, strings.Join(unhealthy, ", "))
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.EtcdHealthyCondition, corev1.ConditionFalse, "EtcdQuorumAtRisk", message)
+	case len(unhealthy) > 0:
+		message := fmt.Sprintf("%d of %d etcd members unhealthy, %s", len(unhealthy), len(current), strings.Join(unhealthy, ", "))
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.EtcdHealthyCondition, corev1.ConditionFalse, "EtcdMembersUnhealthy", message)
+	default:
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.EtcdHealthyCondition, corev1.ConditionTrue, "EtcdMembersHealthy", "")
+	}
+	return nil
+}
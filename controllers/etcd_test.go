@@ -0,0 +1,132 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+
+	enginev1alpha1 "github.com/awesomenix/azk/api/v1alpha1"
+	"github.com/awesomenix/azk/etcd"
+)
+
+// fakeEtcdCluster is an etcd cluster whose members are healthy unless listed as unhealthy
+type fakeEtcdCluster struct {
+	members   []etcd.Member
+	unhealthy map[string]bool
+	listErr   error
+	removed   []uint64
+}
+
+var _ etcd.Cluster = &fakeEtcdCluster{}
+
+func newFakeEtcdCluster(names ...string) *fakeEtcdCluster {
+	f := &fakeEtcdCluster{unhealthy: map[string]bool{}}
+	for i, name := range names {
+		f.members = append(f.members, etcd.Member{
+			ID:         uint64(i + 1),
+			Name:       name,
+			ClientURLs: []string{fmt.Sprintf("https://%s:2379", name)},
+		})
+	}
+	return f
+}
+
+func (f *fakeEtcdCluster) factory(endpoints []string, caCert, caKey string) (etcd.Cluster, error) {
+	return f, nil
+}
+
+func (f *fakeEtcdCluster) MemberList(ctx context.Context) ([]etcd.Member, error) {
+	return f.members, f.listErr
+}
+
+func (f *fakeEtcdCluster) RemoveMember(ctx context.Context, id uint64) error {
+	f.removed = append(f.removed, id)
+	for i, member := range f.members {
+		if member.ID == id {
+			f.members = append(f.members[:i], f.members[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func (f *fakeEtcdCluster) Status(ctx context.Context, endpoint string) (etcd.Status, error) {
+	for _, member := range f.members {
+		if member.ClientURLs[0] == endpoint && !f.unhealthy[member.Name] {
+			return etcd.Status{MemberID: member.ID, Leader: 1}, nil
+		}
+	}
+	return etcd.Status{}, fmt.Errorf("%s unreachable", endpoint)
+}
+
+func (f *fakeEtcdCluster) Snapshot(ctx context.Context, endpoint string, w io.Writer) (int64, error) {
+	return 0, fmt.Errorf("snapshots are not supported")
+}
+
+func etcdMembers(healthy ...bool) []enginev1alpha1.EtcdMemberStatus {
+	var statuses []enginev1alpha1.EtcdMemberStatus
+	for i, h := range healthy {
+		statuses = append(statuses, enginev1alpha1.EtcdMemberStatus{ID: string(rune('a' + i)), Healthy: h})
+	}
+	return statuses
+}
+
+func TestCheckEtcdQuorum(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []enginev1alpha1.EtcdMemberStatus
+		id       string
+		removed  bool
+		wantErr  bool
+	}{
+		{name: "restart one of three healthy", statuses: etcdMembers(true, true, true), id: "a"},
+		{name: "remove one of three healthy", statuses: etcdMembers(true, true, true), id: "a", removed: true},
+		{name: "restart the only member", statuses: etcdMembers(true), id: "a"},
+		{name: "restart with one member down", statuses: etcdMembers(true, true, false), id: "a", wantErr: true},
+		{name: "remove with two of five down", statuses: etcdMembers(true, true, false, false, true), id: "a", removed: true, wantErr: true},
+		{name: "remove the last member", statuses: etcdMembers(true), id: "a", removed: true, wantErr: true},
+		{name: "remove an unhealthy member", statuses: etcdMembers(true, true, false), id: "c", removed: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkEtcdQuorum(tt.statuses, tt.id, tt.removed)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("checkEtcdQuorum() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckEtcdDisruption(t *testing.T) {
+	instance := &enginev1alpha1.ControlPlane{}
+	for _, name := range []string{"master-0", "master-1", "master-2"} {
+		instance.Status.NodeStatus = append(instance.Status.NodeStatus, enginev1alpha1.VMStatus{VMComputerName: name})
+	}
+	cluster := &enginev1alpha1.Cluster{}
+
+	tests := []struct {
+		name      string
+		unhealthy []string
+		listErr   error
+		wantErr   bool
+	}{
+		{name: "healthy"},
+		{name: "another member down", unhealthy: []string{"master-1"}, wantErr: true},
+		{name: "member list unreadable", listErr: fmt.Errorf("connection refused"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := newFakeEtcdCluster("master-0", "master-1", "master-2")
+			fake.listErr = tt.listErr
+			for _, name := range tt.unhealthy {
+				fake.unhealthy[name] = true
+			}
+			r := &ControlPlaneReconciler{Etcd: fake.factory}
+			err := r.checkEtcdDisruption(context.Background(), instance, cluster, "master-0", false)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("checkEtcdDisruption() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
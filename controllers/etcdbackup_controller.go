@@ -0,0 +1,243 @@
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	enginev1alpha1 "github.com/awesomenix/azk/api/v1alpha1"
+	"github.com/awesomenix/azk/backup"
+	"github.com/awesomenix/azk/etcd"
+	"github.com/awesomenix/azk/helpers"
+)
+
+// BackupStoreFactory returns the store of the backup storage of a cluster, the cluster is nil when
+// deleting local snapshots whose cluster may be gone
+type BackupStoreFactory func(ctx context.Context, cluster *enginev1alpha1.Cluster, storage enginev1alpha1.EtcdBackupStorage) (backup.Store, error)
+
+// openBackupStore returns the store of the storage backend, blob containers are accessed with a short lived shared access signature
+func openBackupStore(ctx context.Context, cluster *enginev1alpha1.Cluster, storage enginev1alpha1.EtcdBackupStorage) (backup.Store, error) {
+	if err := storage.Validate(); err != nil {
+		return nil, err
+	}
+	if storage.Local != nil {
+		return &backup.LocalStore{Dir: storage.Local.Path}, nil
+	}
+
+	cloudConfig := cluster.Spec.CloudConfiguration
+	cloudConfig.UserAgent = "azk"
+	containerURL, sasToken, err := cloudConfig.GetBlobContainerSAS(ctx, storage.Blob.ResourceGroup, storage.Blob.StorageAccount, storage.Blob.Container, "rwd", time.Hour)
+	if err != nil {
+		return nil, err
+	}
+	return backup.NewBlobStore(containerURL, sasToken), nil
+}
+
+// EtcdBackupReconciler reconciles a EtcdBackup object
+type EtcdBackupReconciler struct {
+	client.Client
+	Log logr.Logger
+	record.EventRecorder
+	// Etcd builds the client of the control plane etcd cluster, the etcd json gateway is used when nil
+	Etcd etcd.ClusterFactory
+	// Store builds the store snapshots are uploaded to, blob containers and local directories are used when nil
+	Store BackupStoreFactory
+}
+
+// +kubebuilder:rbac:groups=engine.azk.io,resources=etcdbackups,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=engine.azk.io,resources=etcdbackups/status,verbs=get;update;patch
+
+func (r *EtcdBackupReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("etcdbackup", req.NamespacedName)
+
+	defer helpers.Recover()
+	// Fetch the EtcdBackup instance
+	instance := &enginev1alpha1.EtcdBackup{}
+	err := r.Get(ctx, req.NamespacedName, instance)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Object not found, return.  Created objects are automatically garbage collected.
+			// For additional cleanup logic use finalizers.
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return ctrl.Result{}, err
+	}
+
+	if instance.Status.Snapshot != nil {
+		// a backup is taken exactly once
+		return ctrl.Result{}, nil
+	}
+	instance.Status.ObservedGeneration = instance.Generation
+
+	if err := instance.Spec.Storage.Validate(); err != nil {
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.ReadyCondition, corev1.ConditionFalse, "InvalidStorage", err.Error())
+		_ = r.Status().Update(ctx, instance)
+		// nothing to retry until the spec is fixed
+		return ctrl.Result{}, nil
+	}
+
+	cluster, err := getCluster(ctx, r.Client, instance.Namespace, instance.Spec.ClusterRef)
+	if err != nil {
+		return ctrl.Result{Requeue: true, RequeueAfter: 10 * time.Second}, err
+	}
+
+	// backups outlive their cluster, so the cluster is not set as owner
+	if instance.Spec.ClusterRef.Name == "" {
+		instance.Spec.ClusterRef.Name = cluster.Name
+		if err := r.Update(ctx, instance); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	controlPlane, err := getControlPlane(ctx, r.Client, cluster)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if controlPlane == nil || len(controlPlane.Status.NodeStatus) == 0 {
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.ReadyCondition, corev1.ConditionFalse, "WaitingForControlPlane", "no control plane nodes to snapshot")
+		_ = r.Status().Update(ctx, instance)
+		return ctrl.Result{RequeueAfter: time.Minute}, nil
+	}
+
+	snapshot, err := r.takeSnapshot(ctx, instance, controlPlane, cluster)
+	if err != nil {
+		log.Error(err, "Failed to take etcd snapshot")
+		r.EventRecorder.Event(instance, "Warning", "SnapshotFailed", err.Error())
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.ReadyCondition, corev1.ConditionFalse, "SnapshotFailed", err.Error())
+		_ = r.Status().Update(ctx, instance)
+		return ctrl.Result{}, err
+	}
+
+	log.Info("Stored etcd snapshot", "Member", snapshot.Member, "Location", snapshot.Location, "Size", snapshot.Size)
+	r.EventRecorder.Event(instance, "Normal", "SnapshotStored", fmt.Sprintf("%s from %s, %d bytes", snapshot.Location, snapshot.Member, snapshot.Size))
+	instance.Status.Snapshot = snapshot
+	setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.ReadyCondition, corev1.ConditionTrue, "SnapshotStored", "")
+	if err := r.Status().Update(ctx, instance); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// takeSnapshot snapshots a healthy etcd member, preferring followers so the leader is not slowed
+// down, and uploads the snapshot to the backup storage
+func (r *EtcdBackupReconciler) takeSnapshot(ctx context.Context, instance *enginev1alpha1.EtcdBackup, controlPlane *enginev1alpha1.ControlPlane, cluster *enginev1alpha1.Cluster) (*enginev1alpha1.EtcdSnapshot, error) {
+	etcdCluster, err := newEtcdCluster(r.Etcd, controlPlane, cluster)
+	if err != nil {
+		return nil, err
+	}
+	members, statuses, err := etcdMemberStatuses(ctx, etcdCluster)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list etcd members: %v", err)
+	}
+	var candidates []etcd.Member
+	for _, leader := range []bool{false, true} {
+		for i, member := range members {
+			if statuses[i].Healthy && statuses[i].Leader == leader {
+				candidates = append(candidates, member)
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("no healthy etcd member to snapshot")
+	}
+
+	f, err := ioutil.TempFile("", "etcd-snapshot")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	var member etcd.Member
+	var size int64
+	checksum := sha256.New()
+	for _, member = range candidates {
+		if err = truncate(f); err != nil {
+			return nil, err
+		}
+		checksum.Reset()
+		for _, clientURL := range member.ClientURLs {
+			if size, err = etcdCluster.Snapshot(ctx, clientURL, io.MultiWriter(f, checksum)); err == nil {
+				break
+			}
+		}
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot snapshot etcd member %s: %v", member.Name, err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	store, err := newBackupStore(ctx, r.Store, cluster, instance.Spec.Storage)
+	if err != nil {
+		return nil, err
+	}
+	name := fmt.Sprintf("%s-%s.db", instance.Namespace, instance.Name)
+	location, err := store.Put(ctx, name, f, size)
+	if err != nil {
+		return nil, err
+	}
+	return &enginev1alpha1.EtcdSnapshot{
+		Name:     name,
+		Location: location,
+		Member:   member.Name,
+		Size:     size,
+		SHA256:   fmt.Sprintf("%x", checksum.Sum(nil)),
+		TakenAt:  metav1.Now(),
+	}, nil
+}
+
+// truncate empties the file so a failed snapshot can be retried from another member
+func truncate(f *os.File) error {
+	if err := f.Truncate(0); err != nil {
+		return err
+	}
+	_, err := f.Seek(0, io.SeekStart)
+	return err
+}
+
+// newBackupStore returns the store of the backup storage, defaulting to openBackupStore
+func newBackupStore(ctx context.Context, factory BackupStoreFactory, cluster *enginev1alpha1.Cluster, storage enginev1alpha1.EtcdBackupStorage) (backup.Store, error) {
+	if factory == nil {
+		factory = openBackupStore
+	}
+	return factory(ctx, cluster, storage)
+}
+
+// getControlPlane returns the control plane of the cluster, nil if it has none yet
+func getControlPlane(ctx context.Context, c client.Client, cluster *enginev1alpha1.Cluster) (*enginev1alpha1.ControlPlane, error) {
+	controlPlaneList := enginev1alpha1.ControlPlaneList{}
+	if err := c.List(ctx, &controlPlaneList, client.InNamespace(cluster.Namespace)); err != nil {
+		return nil, err
+	}
+	for i := range controlPlaneList.Items {
+		if isClusterRef(controlPlaneList.Items[i].Spec.ClusterRef, cluster.Name) {
+			return &controlPlaneList.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *EtcdBackupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&enginev1alpha1.EtcdBackup{}).
+		Complete(r)
+}
@@ -0,0 +1,242 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	enginev1alpha1 "github.com/awesomenix/azk/api/v1alpha1"
+	"github.com/awesomenix/azk/backup"
+	"github.com/awesomenix/azk/helpers"
+)
+
+// retentionRetryPeriod is how soon expired snapshots that could not be deleted are retried
+const retentionRetryPeriod = 10 * time.Minute
+
+// EtcdBackupScheduleReconciler reconciles a EtcdBackupSchedule object
+type EtcdBackupScheduleReconciler struct {
+	client.Client
+	Log logr.Logger
+	record.EventRecorder
+	*runtime.Scheme
+	// Store builds the store expired snapshots are deleted from, blob containers and local directories are used when nil
+	Store BackupStoreFactory
+}
+
+// +kubebuilder:rbac:groups=engine.azk.io,resources=etcdbackupschedules,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=engine.azk.io,resources=etcdbackupschedules/status,verbs=get;update;patch
+
+func (r *EtcdBackupScheduleReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("etcdbackupschedule", req.NamespacedName)
+
+	defer helpers.Recover()
+	// Fetch the EtcdBackupSchedule instance
+	instance := &enginev1alpha1.EtcdBackupSchedule{}
+	err := r.Get(ctx, req.NamespacedName, instance)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Object not found, return.  Created objects are automatically garbage collected.
+			// For additional cleanup logic use finalizers.
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return ctrl.Result{}, err
+	}
+
+	observed := instance.Status.DeepCopy()
+	instance.Status.ObservedGeneration = instance.Generation
+
+	schedule, err := backup.ParseSchedule(instance.Spec.Schedule)
+	if err == nil {
+		err = instance.Spec.Storage.Validate()
+	}
+	if err != nil {
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.ReadyCondition, corev1.ConditionFalse, "InvalidSpec", err.Error())
+		_ = r.Status().Update(ctx, instance)
+		// nothing to retry until the spec is fixed
+		return ctrl.Result{}, nil
+	}
+
+	backupList := enginev1alpha1.EtcdBackupList{}
+	if err := r.List(ctx, &backupList, client.InNamespace(instance.Namespace), client.MatchingLabels{enginev1alpha1.EtcdBackupScheduleLabel: instance.Name}); err != nil {
+		return ctrl.Result{}, err
+	}
+	retained, expired, superseded := retainEtcdBackups(backupList.Items, instance.Spec.DesiredRetention())
+
+	// retention failures must not stop new backups, they are recorded and retried
+	var retentionErrs []string
+	for i := range expired {
+		expiredBackup := &expired[i]
+		if err := r.deleteSnapshot(ctx, expiredBackup); err != nil {
+			log.Error(err, "Cannot delete expired etcd snapshot", "EtcdBackup", expiredBackup.Name)
+			retentionErrs = append(retentionErrs, fmt.Sprintf("%s: %v", expiredBackup.Name, err))
+			continue
+		}
+		log.Info("Deleted expired etcd snapshot", "EtcdBackup", expiredBackup.Name, "Location", expiredBackup.Status.Snapshot.Location)
+		if err := r.Delete(ctx, expiredBackup); err != nil && !errors.IsNotFound(err) {
+			retentionErrs = append(retentionErrs, fmt.Sprintf("%s: %v", expiredBackup.Name, err))
+		}
+	}
+	for i := range superseded {
+		if err := r.Delete(ctx, &superseded[i]); err != nil && !errors.IsNotFound(err) {
+			retentionErrs = append(retentionErrs, fmt.Sprintf("%s: %v", superseded[i].Name, err))
+		}
+	}
+	if len(retentionErrs) > 0 {
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.RetentionAppliedCondition, corev1.ConditionFalse, "DeleteFailed",
+			strings.Join(retentionErrs, "; "))
+	} else {
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.RetentionAppliedCondition, corev1.ConditionTrue, "Applied", "")
+	}
+
+	instance.Status.Snapshots = nil
+	instance.Status.LastSuccessfulTime = nil
+	for _, retainedBackup := range retained {
+		if retainedBackup.Status.Snapshot != nil {
+			instance.Status.Snapshots = append(instance.Status.Snapshots, *retainedBackup.Status.Snapshot)
+		}
+	}
+	if len(instance.Status.Snapshots) > 0 {
+		instance.Status.LastSuccessfulTime = instance.Status.Snapshots[0].TakenAt.DeepCopy()
+	}
+
+	now := time.Now().UTC()
+	last := instance.CreationTimestamp.Time
+	if instance.Status.LastScheduleTime != nil {
+		last = instance.Status.LastScheduleTime.Time
+	}
+	if scheduled := lastScheduledTime(schedule, last.UTC(), now); !scheduled.IsZero() && !instance.Spec.Suspend {
+		if err := r.createBackup(ctx, instance, scheduled); err != nil {
+			return ctrl.Result{}, err
+		}
+		instance.Status.LastScheduleTime = &metav1.Time{Time: scheduled}
+	}
+
+	switch {
+	case len(retained) > 0 && isEtcdBackupFailed(&retained[0]):
+		condition := enginev1alpha1.FindCondition(retained[0].Status.Conditions, enginev1alpha1.ReadyCondition)
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.ReadyCondition, corev1.ConditionFalse, "SnapshotFailed",
+			fmt.Sprintf("%s: %s", retained[0].Name, condition.Message))
+	case instance.Spec.Suspend:
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.ReadyCondition, corev1.ConditionTrue, "Suspended", "")
+	default:
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.ReadyCondition, corev1.ConditionTrue, "Scheduled", "")
+	}
+	if !apiequality.Semantic.DeepEqual(observed, &instance.Status) {
+		if err := r.Status().Update(ctx, instance); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	requeueAfter := time.Duration(0)
+	if next := schedule.Next(now); !next.IsZero() {
+		requeueAfter = next.Sub(now)
+	}
+	if len(retentionErrs) > 0 && (requeueAfter == 0 || requeueAfter > retentionRetryPeriod) {
+		requeueAfter = retentionRetryPeriod
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// createBackup creates the EtcdBackup of the scheduled time, named after it so a retried
+// reconcile does not create a second backup
+func (r *EtcdBackupScheduleReconciler) createBackup(ctx context.Context, instance *enginev1alpha1.EtcdBackupSchedule, scheduled time.Time) error {
+	etcdBackup := &enginev1alpha1.EtcdBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%d", instance.Name, scheduled.Unix()),
+			Namespace: instance.Namespace,
+			Labels:    map[string]string{enginev1alpha1.EtcdBackupScheduleLabel: instance.Name},
+		},
+		Spec: enginev1alpha1.EtcdBackupSpec{
+			ClusterRef: instance.Spec.ClusterRef,
+			Storage:    instance.Spec.Storage,
+		},
+	}
+	if err := controllerutil.SetControllerReference(instance, etcdBackup, r.Scheme); err != nil {
+		return err
+	}
+	if err := r.Create(ctx, etcdBackup); err != nil && !errors.IsAlreadyExists(err) {
+		return fmt.Errorf("cannot create etcd backup %s: %v", etcdBackup.Name, err)
+	}
+	r.Log.Info("Created scheduled etcd backup", "EtcdBackup", etcdBackup.Name)
+	return nil
+}
+
+// deleteSnapshot deletes the stored snapshot of the backup. Backups outlive their cluster, local
+// snapshots are deleted without it, blob snapshots need the cloud credentials of the cluster.
+func (r *EtcdBackupScheduleReconciler) deleteSnapshot(ctx context.Context, etcdBackup *enginev1alpha1.EtcdBackup) error {
+	var cluster *enginev1alpha1.Cluster
+	if etcdBackup.Spec.Storage.Blob != nil {
+		var err error
+		if cluster, err = getCluster(ctx, r.Client, etcdBackup.Namespace, etcdBackup.Spec.ClusterRef); err != nil {
+			return fmt.Errorf("cannot get the cluster credentials of blob storage: %v", err)
+		}
+	}
+	store, err := newBackupStore(ctx, r.Store, cluster, etcdBackup.Spec.Storage)
+	if err != nil {
+		return err
+	}
+	return store.Delete(ctx, etcdBackup.Status.Snapshot.Name)
+}
+
+// isEtcdBackupFailed returns true if the last snapshot attempt of the backup failed
+func isEtcdBackupFailed(etcdBackup *enginev1alpha1.EtcdBackup) bool {
+	condition := enginev1alpha1.FindCondition(etcdBackup.Status.Conditions, enginev1alpha1.ReadyCondition)
+	return etcdBackup.Status.Snapshot == nil && condition != nil && condition.Reason == "SnapshotFailed"
+}
+
+// retainEtcdBackups sorts the backups newest first and splits them into the retained backups, the
+// stored backups exceeding retention and the unfinished backups that are older than the newest
+// stored one or exceed retention themselves
+func retainEtcdBackups(backups []enginev1alpha1.EtcdBackup, retention int) (retained, expired, superseded []enginev1alpha1.EtcdBackup) {
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[j].CreationTimestamp.Before(&backups[i].CreationTimestamp)
+	})
+
+	stored := 0
+	for _, etcdBackup := range backups {
+		switch {
+		case etcdBackup.Status.Snapshot != nil && stored < retention:
+			stored++
+			retained = append(retained, etcdBackup)
+		case etcdBackup.Status.Snapshot != nil:
+			expired = append(expired, etcdBackup)
+		case stored == 0 && len(retained) < retention:
+			retained = append(retained, etcdBackup)
+		default:
+			superseded = append(superseded, etcdBackup)
+		}
+	}
+	return retained, expired, superseded
+}
+
+// lastScheduledTime returns the latest time after last and not after now the schedule is due, the
+// zero time if there is none. Missed runs are not caught up, only the latest one is returned.
+func lastScheduledTime(schedule *backup.Schedule, last, now time.Time) time.Time {
+	var scheduled time.Time
+	for t := schedule.Next(last); !t.IsZero() && !t.After(now); t = schedule.Next(t) {
+		scheduled = t
+	}
+	return scheduled
+}
+
+func (r *EtcdBackupScheduleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&enginev1alpha1.EtcdBackupSchedule{}).
+		Owns(&enginev1alpha1.EtcdBackup{}).
+		Complete(r)
+}
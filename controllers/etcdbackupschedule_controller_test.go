@@ -0,0 +1,210 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	enginev1alpha1 "github.com/awesomenix/azk/api/v1alpha1"
+	"github.com/awesomenix/azk/backup"
+)
+
+var backupsCreated = time.Date(2019, time.October, 1, 0, 0, 0, 0, time.UTC)
+
+func testEtcdBackup(name string, hours int, stored bool) enginev1alpha1.EtcdBackup {
+	b := enginev1alpha1.EtcdBackup{ObjectMeta: metav1.ObjectMeta{
+		Name:              name,
+		CreationTimestamp: metav1.NewTime(backupsCreated.Add(time.Duration(hours) * time.Hour)),
+	}}
+	if stored {
+		b.Status.Snapshot = &enginev1alpha1.EtcdSnapshot{Name: name}
+	}
+	return b
+}
+
+func backupNames(backups []enginev1alpha1.EtcdBackup) []string {
+	var n []string
+	for _, b := range backups {
+		n = append(n, b.Name)
+	}
+	return n
+}
+
+func TestRetainEtcdBackups(t *testing.T) {
+	tests := []struct {
+		name           string
+		backups        []enginev1alpha1.EtcdBackup
+		wantRetained   []string
+		wantExpired    []string
+		wantSuperseded []string
+	}{
+		{
+			name: "newest stored snapshots",
+			backups: []enginev1alpha1.EtcdBackup{
+				testEtcdBackup("a", 0, true),
+				testEtcdBackup("d", 3, true),
+				testEtcdBackup("b", 1, false),
+				testEtcdBackup("e", 4, false),
+				testEtcdBackup("c", 2, true),
+			},
+			wantRetained:   []string{"e", "d", "c"},
+			wantExpired:    []string{"a"},
+			wantSuperseded: []string{"b"},
+		},
+		{
+			name: "failing backups bounded by retention",
+			backups: []enginev1alpha1.EtcdBackup{
+				testEtcdBackup("a", 0, false),
+				testEtcdBackup("b", 1, false),
+				testEtcdBackup("c", 2, false),
+			},
+			wantRetained:   []string{"c", "b"},
+			wantSuperseded: []string{"a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			retained, expired, superseded := retainEtcdBackups(tt.backups, 2)
+			if !reflect.DeepEqual(backupNames(retained), tt.wantRetained) {
+				t.Errorf("Expected retained %v, got %v", tt.wantRetained, backupNames(retained))
+			}
+			if !reflect.DeepEqual(backupNames(expired), tt.wantExpired) {
+				t.Errorf("Expected expired %v, got %v", tt.wantExpired, backupNames(expired))
+			}
+			if !reflect.DeepEqual(backupNames(superseded), tt.wantSuperseded) {
+				t.Errorf("Expected superseded %v, got %v", tt.wantSuperseded, backupNames(superseded))
+			}
+		})
+	}
+}
+
+func TestLastScheduledTime(t *testing.T) {
+	schedule, err := backup.ParseSchedule("0 * * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if scheduled := lastScheduledTime(schedule, backupsCreated, backupsCreated.Add(150*time.Minute)); !scheduled.Equal(backupsCreated.Add(2 * time.Hour)) {
+		t.Errorf("Expected only the latest missed run to be scheduled, got %v", scheduled)
+	}
+	if scheduled := lastScheduledTime(schedule, backupsCreated, backupsCreated.Add(30*time.Minute)); !scheduled.IsZero() {
+		t.Errorf("Expected no run before the first scheduled time, got %v", scheduled)
+	}
+}
+
+// fakeBackupStore records the deleted snapshots
+type fakeBackupStore struct {
+	deleted []string
+}
+
+func (f *fakeBackupStore) Put(ctx context.Context, name string, r io.Reader, size int64) (string, error) {
+	return "", fmt.Errorf("uploads are not supported")
+}
+
+func (f *fakeBackupStore) Delete(ctx context.Context, name string) error {
+	f.deleted = append(f.deleted, name)
+	return nil
+}
+
+func TestEtcdBackupScheduleRetention(t *testing.T) {
+	s := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(s)
+	_ = enginev1alpha1.AddToScheme(s)
+
+	tests := []struct {
+		name        string
+		storage     enginev1alpha1.EtcdBackupStorage
+		wantApplied corev1.ConditionStatus
+		wantDeleted []string
+	}{
+		{
+			name:        "local snapshots are deleted without the cluster",
+			storage:     enginev1alpha1.EtcdBackupStorage{Local: &enginev1alpha1.LocalBackupStorage{Path: "/var/lib/azk"}},
+			wantApplied: corev1.ConditionTrue,
+			wantDeleted: []string{"old"},
+		},
+		{
+			name:        "blob snapshots are kept without the cluster credentials",
+			storage:     enginev1alpha1.EtcdBackupStorage{Blob: &enginev1alpha1.BlobBackupStorage{StorageAccount: "azk", Container: "etcd"}},
+			wantApplied: corev1.ConditionFalse,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			now := time.Now()
+			retention := int32(1)
+			instance := &enginev1alpha1.EtcdBackupSchedule{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "hourly", CreationTimestamp: metav1.NewTime(now.Add(-90 * time.Minute))},
+				Spec: enginev1alpha1.EtcdBackupScheduleSpec{
+					ClusterRef: corev1.LocalObjectReference{Name: "deleted"},
+					Schedule:   "@hourly",
+					Retention:  &retention,
+					Storage:    tt.storage,
+				},
+			}
+			etcdBackup := func(name string, age time.Duration) *enginev1alpha1.EtcdBackup {
+				return &enginev1alpha1.EtcdBackup{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:         "default",
+						Name:              name,
+						Labels:            map[string]string{enginev1alpha1.EtcdBackupScheduleLabel: instance.Name},
+						CreationTimestamp: metav1.NewTime(now.Add(-age)),
+					},
+					Spec:   enginev1alpha1.EtcdBackupSpec{ClusterRef: instance.Spec.ClusterRef, Storage: tt.storage},
+					Status: enginev1alpha1.EtcdBackupStatus{Snapshot: &enginev1alpha1.EtcdSnapshot{Name: name}},
+				}
+			}
+			store := &fakeBackupStore{}
+			r := &EtcdBackupScheduleReconciler{
+				Client: fake.NewFakeClientWithScheme(s, instance, etcdBackup("old", 2*time.Hour), etcdBackup("new", time.Hour)),
+				Log:    ctrl.Log.WithName("test"),
+				Scheme: s,
+				Store: func(ctx context.Context, cluster *enginev1alpha1.Cluster, storage enginev1alpha1.EtcdBackupStorage) (backup.Store, error) {
+					return store, nil
+				},
+			}
+
+			result, err := r.Reconcile(ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: instance.Name}})
+			if err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+			if result.RequeueAfter <= 0 || result.RequeueAfter > time.Hour {
+				t.Errorf("Expected a requeue within the hour, got %v", result.RequeueAfter)
+			}
+			if fmt.Sprint(store.deleted) != fmt.Sprint(tt.wantDeleted) {
+				t.Errorf("Expected deleted snapshots %v, got %v", tt.wantDeleted, store.deleted)
+			}
+
+			got := &enginev1alpha1.EtcdBackupSchedule{}
+			if err := r.Get(ctx, types.NamespacedName{Namespace: "default", Name: instance.Name}, got); err != nil {
+				t.Fatal(err)
+			}
+			condition := enginev1alpha1.FindCondition(got.Status.Conditions, enginev1alpha1.RetentionAppliedCondition)
+			if condition == nil || condition.Status != tt.wantApplied {
+				t.Errorf("Expected RetentionApplied %s, got %+v", tt.wantApplied, condition)
+			}
+			if got.Status.LastScheduleTime == nil {
+				t.Errorf("Expected a backup to be scheduled despite retention failures")
+			}
+			backupList := enginev1alpha1.EtcdBackupList{}
+			if err := r.List(ctx, &backupList, client.InNamespace("default")); err != nil {
+				t.Fatal(err)
+			}
+			if want := 3 - len(tt.wantDeleted); len(backupList.Items) != want {
+				t.Errorf("Expected %d backups, got %d", want, len(backupList.Items))
+			}
+		})
+	}
+}
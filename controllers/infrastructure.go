@@ -0,0 +1,29 @@
+package controllers
+
+import (
+	"fmt"
+
+	"github.com/awesomenix/azk/bootstrap"
+
+	enginev1alpha1 "github.com/awesomenix/azk/api/v1alpha1"
+)
+
+// resourceStatuses converts the observed base infrastructure into cluster status,
+// returning a description of each resource which is missing or drifted
+func resourceStatuses(states []bootstrap.ResourceState) ([]enginev1alpha1.ResourceStatus, []string) {
+	statuses := []enginev1alpha1.ResourceStatus{}
+	drifted := []string{}
+	for _, state := range states {
+		status := enginev1alpha1.ResourceStatus{
+			Type:  state.Type,
+			Name:  state.Name,
+			Ready: state.Err == nil,
+		}
+		if state.Err != nil {
+			status.Message = state.Err.Error()
+			drifted = append(drifted, fmt.Sprintf("%s %s: %s", state.Type, state.Name, state.Err.Error()))
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, drifted
+}
@@ -7,6 +7,7 @@ import (
 	"reflect"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -48,6 +49,18 @@ func (r *NodePoolReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		return ctrl.Result{}, err
 	}
 
+	cluster, err := getCluster(ctx, r.Client, instance.Namespace, instance.Spec.ClusterRef)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if instance.Spec.ClusterRef.Name == "" || setClusterOwnerReference(cluster, instance) {
+		instance.Spec.ClusterRef.Name = cluster.Name
+		if err := r.Update(ctx, instance); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
 	h := fnv.New64a()
 	h.Write([]byte(fmt.Sprintf("%s/%s", instance.Name, instance.Spec.KubernetesVersion)))
 	nodeSetName := fmt.Sprintf("%x", h.Sum64())
@@ -62,6 +75,7 @@ func (r *NodePoolReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 			KubernetesVersion: instance.Spec.KubernetesVersion,
 			Replicas:          instance.Spec.Replicas,
 			VMSKUType:         instance.Spec.VMSKUType,
+			ClusterRef:        instance.Spec.ClusterRef,
 		},
 	}
 	if err := controllerutil.SetControllerReference(instance, nodeSet, r.Scheme); err != nil {
@@ -92,11 +106,28 @@ func (r *NodePoolReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	}
 
 	if instance.Spec.Replicas != nil && int32(len(foundNodeSet.Status.NodeStatus)) == *instance.Spec.Replicas {
-		if err := r.performGarbageCollection(instance.Namespace, nodeSetName); err != nil {
+		if err := r.performGarbageCollection(instance, nodeSetName); err != nil {
 			return ctrl.Result{}, err
 		}
 	}
 
+	oldNodeSets, err := r.countOldNodeSets(instance, nodeSetName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// NodePool conditions mirror the active NodeSet, rollout is tracked by pending old NodeSets
+	for _, condition := range foundNodeSet.Status.Conditions {
+		setCondition(&instance.Status.Conditions, instance.Generation, condition.Type, condition.Status, condition.Reason, condition.Message)
+	}
+	if oldNodeSets > 0 {
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.UpgradeInProgressCondition, corev1.ConditionTrue, "RollingOut",
+			fmt.Sprintf("Waiting for %d old NodeSet(s) to be replaced by %s", oldNodeSets, nodeSetName))
+	} else {
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.UpgradeInProgressCondition, corev1.ConditionFalse, "RolloutCompleted", "")
+	}
+	instance.Status.ObservedGeneration = instance.Generation
+
 	instance.Status.NodeSetName = nodeSetName
 	instance.Status.Replicas = foundNodeSet.Status.Replicas
 	instance.Status.VMReplicas = int32(len(foundNodeSet.Status.NodeStatus))
@@ -109,16 +140,31 @@ func (r *NodePoolReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	return ctrl.Result{}, nil
 }
 
-func (r *NodePoolReconciler) performGarbageCollection(namespace, nodeSetName string) error {
+func (r *NodePoolReconciler) countOldNodeSets(instance *enginev1alpha1.NodePool, nodeSetName string) (int, error) {
+	nodeSetList := enginev1alpha1.NodeSetList{}
+	if err := r.List(context.TODO(), &nodeSetList, client.InNamespace(instance.Namespace)); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for i := range nodeSetList.Items {
+		if nodeSetList.Items[i].Name != nodeSetName && metav1.IsControlledBy(&nodeSetList.Items[i], instance) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *NodePoolReconciler) performGarbageCollection(instance *enginev1alpha1.NodePool, nodeSetName string) error {
 	log := r.Log.WithValues("nodepool", nodeSetName)
 
 	nodeSetList := enginev1alpha1.NodeSetList{}
-	if err := r.List(context.TODO(), &nodeSetList, client.InNamespace(namespace)); err != nil {
+	if err := r.List(context.TODO(), &nodeSetList, client.InNamespace(instance.Namespace)); err != nil {
 		return err
 	}
 
 	for _, nodeSet := range nodeSetList.Items {
-		if nodeSet.Name == nodeSetName {
+		if nodeSet.Name == nodeSetName || !metav1.IsControlledBy(&nodeSet, instance) {
 			continue
 		}
 
@@ -134,6 +180,7 @@ func (r *NodePoolReconciler) performGarbageCollection(namespace, nodeSetName str
 func (r *NodePoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&enginev1alpha1.NodePool{}).
+		Owns(&enginev1alpha1.NodeSet{}).
 		WithOptions(controller.Options{MaxConcurrentReconciles: 30}).
 		Complete(r)
 }
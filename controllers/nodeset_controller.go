@@ -2,22 +2,25 @@ package controllers
 
 import (
 	"context"
-	"encoding/base64"
 	"fmt"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
-
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	enginev1alpha1 "github.com/awesomenix/azk/api/v1alpha1"
 	azhelpers "github.com/awesomenix/azk/azure"
 	"github.com/awesomenix/azk/bootstrap"
+	"github.com/awesomenix/azk/cloudinit"
 	"github.com/awesomenix/azk/helpers"
 )
 
@@ -25,43 +28,19 @@ const (
 	nodesetsFinalizerName = "nodesets.finalizers.engine.azk.io"
 )
 
-func kubeadmNodeJoinConfig(internalDNSName, bootstrapToken, discoveryHash string) string {
-	return fmt.Sprintf(`
-cat <<EOF >/tmp/kubeadm-config.yaml
-apiVersion: kubeadm.k8s.io/v1beta1
-kind: JoinConfiguration
-nodeRegistration:
-  kubeletExtraArgs:
-    cloud-provider: azure
-    cloud-config: /etc/kubernetes/azure.json
-discovery:
-  bootstrapToken:
-    token: %[1]s
-    apiServerEndpoint: "%[2]s:6443"
-    caCertHashes:
-    - %[3]s
-EOF
-`, bootstrapToken,
-		internalDNSName,
-		discoveryHash,
-	)
-}
-
-func getNodeSetStartupScript(kubernetesVersion, internalDNSName, bootstrapToken, discoveryHash string) string {
+func getNodeSetStartupScript(spec *bootstrap.Spec, kubernetesVersion, bootstrapToken string) (string, error) {
+	kubeadmConfig, err := spec.KubeadmJoinConfig(kubernetesVersion, bootstrapToken, false)
+	if err != nil {
+		return "", err
+	}
 	return fmt.Sprintf(`
 %[1]s
-sudo cp -f /etc/hosts /tmp/hostsupdate
-sudo chown $(id -u):$(id -g) /tmp/hostsupdate
-echo '10.0.0.100 %[2]s' >> /tmp/hostsupdate
-sudo mv /etc/hosts /etc/hosts.bak
-sudo mv /tmp/hostsupdate /etc/hosts
-%[3]s
+%[2]s
 #Setup using kubeadm
 sudo kubeadm join --config /tmp/kubeadm-config.yaml
 `, helpers.PreRequisitesInstallScript(kubernetesVersion),
-		internalDNSName,
-		kubeadmNodeJoinConfig(internalDNSName, bootstrapToken, discoveryHash),
-	)
+		kubeadmConfig,
+	), nil
 }
 
 // NodeSetReconciler reconciles a NodeSet object
@@ -69,6 +48,8 @@ type NodeSetReconciler struct {
 	client.Client
 	Log logr.Logger
 	record.EventRecorder
+	// AzureProvider builds the azure provider for a cluster, the azure apis are used when nil
+	AzureProvider azhelpers.ProviderFactory
 }
 
 // +kubebuilder:rbac:groups=engine.azk.io,resources=nodesets,verbs=get;list;watch;create;update;patch;delete
@@ -91,25 +72,37 @@ func (r *NodeSetReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		return ctrl.Result{}, err
 	}
 
-	cluster, err := r.getCluster(ctx, instance.Namespace)
+	cluster, err := getCluster(ctx, r.Client, instance.Namespace, instance.Spec.ClusterRef)
 	if err != nil {
+		if errors.IsNotFound(err) && !instance.ObjectMeta.DeletionTimestamp.IsZero() {
+			// Cluster and its resource group are already gone, nothing left to cleanup
+			instance.ObjectMeta.Finalizers = helpers.RemoveFinalizer(instance.ObjectMeta.Finalizers, nodesetsFinalizerName)
+			if err := r.Update(ctx, instance); err != nil {
+				return ctrl.Result{Requeue: true}, nil
+			}
+			return ctrl.Result{}, nil
+		}
 		return ctrl.Result{}, err
 	}
 
-	if cluster.Status.ProvisioningState != "Succeeded" {
-		// Wait for cluster to initialize
-		return ctrl.Result{Requeue: true, RequeueAfter: 10 * time.Second}, nil
+	if instance.ObjectMeta.DeletionTimestamp.IsZero() &&
+		(instance.Spec.ClusterRef.Name == "" || setClusterOwnerReference(cluster, instance)) {
+		instance.Spec.ClusterRef.Name = cluster.Name
+		if err := r.Update(ctx, instance); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if !isClusterReady(cluster) {
+		// Wait for cluster to initialize, cluster watch requeues once its ready
+		return ctrl.Result{}, nil
 	}
 
-	cloudConfig := azhelpers.CloudConfiguration{
-		CloudName:      azhelpers.AzurePublicCloudName,
-		SubscriptionID: cluster.Spec.SubscriptionID,
-		ClientID:       cluster.Spec.ClientID,
-		ClientSecret:   cluster.Spec.ClientSecret,
-		TenantID:       cluster.Spec.TenantID,
-		GroupName:      cluster.Spec.GroupName,
-		GroupLocation:  cluster.Spec.GroupLocation,
-		UserAgent:      "azk",
+	cloudConfig := cluster.Spec.CloudConfiguration
+	cloudConfig.UserAgent = "azk"
+	provider, err := newProvider(r.AzureProvider, &cloudConfig)
+	if err != nil {
+		return ctrl.Result{}, err
 	}
 
 	if instance.ObjectMeta.DeletionTimestamp.IsZero() {
@@ -127,7 +120,7 @@ func (r *NodeSetReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		if helpers.ContainsFinalizer(instance.ObjectMeta.Finalizers, nodesetsFinalizerName) {
 			if cloudConfig.IsValid() {
 				// our finalizer is present, so lets handle our external dependency
-				if err := r.deleteNodeSet(ctx, instance, cloudConfig); err != nil {
+				if err := r.deleteNodeSet(ctx, instance, cluster, provider); err != nil {
 					// if fail to delete the external dependency here, return with error
 					// so that it can be retried
 					// meh! its fine if it fails, we definitely need to wait here for it to be deleted
@@ -149,43 +142,55 @@ func (r *NodeSetReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		vmSKUType = "Standard_DS2_v2"
 	}
 
-	if err := r.updateNodeSet(instance, cloudConfig); err != nil {
+	if err := r.updateNodeSet(instance, provider); err != nil {
 		instance.Status.ProvisioningState = "Updating"
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.VMSSReadyCondition, corev1.ConditionFalse, "VMSSNotFound", err.Error())
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.ReadyCondition, corev1.ConditionFalse, "Updating", "")
 		if err := r.Status().Update(ctx, instance); err != nil {
 			return ctrl.Result{}, err
 		}
 
 		customDataStr, err := getCustomData(instance, cluster)
 		if err != nil {
+			setFailedCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.BootstrapTokenReadyCondition, "CreateBootstrapTokenFailed", err)
+			_ = r.Status().Update(ctx, instance)
 			return ctrl.Result{Requeue: true, RequeueAfter: 30 * time.Second}, err
 		}
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.BootstrapTokenReadyCondition, corev1.ConditionTrue, "BootstrapTokenCreated", "")
 
-		subnetID := fmt.Sprintf(
-			"/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualNetworks/azk-vnet/subnets/agent-subnet",
-			cluster.Spec.SubscriptionID,
-			cluster.Spec.GroupName)
-
-		if err := cloudConfig.CreateVMSS(
+		if err := provider.CreateVMSS(
 			ctx,
 			instance.Name+"-agentvmss",
-			subnetID,
+			cluster.Spec.AgentSubnetID(),
 			nil,
 			nil,
 			customDataStr,
 			vmSKUType,
 			int(*instance.Spec.Replicas),
+			cluster.Spec.Networking.IPConfigurations(),
 		); err != nil {
+			setFailedCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.VMSSReadyCondition, "CreateVMSSFailed", err)
+			_ = r.Status().Update(ctx, instance)
+			return ctrl.Result{}, err
+		}
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.VMSSReadyCondition, corev1.ConditionTrue, "VMSSProvisioned", "")
+		if err := r.Status().Update(ctx, instance); err != nil {
 			return ctrl.Result{}, err
 		}
 		r.EventRecorder.Event(instance, "Normal", "Created", fmt.Sprintf("%s", instance.Name+"-agentvmss"))
 		return ctrl.Result{Requeue: true}, nil
 	} else if int(*instance.Spec.Replicas) != len(instance.Status.NodeStatus) {
 		instance.Status.ProvisioningState = "Scaling"
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.ScalingInProgressCondition, corev1.ConditionTrue, "Scaling",
+			fmt.Sprintf("Scaling from %d to %d", len(instance.Status.NodeStatus), *instance.Spec.Replicas))
+		setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.ReadyCondition, corev1.ConditionFalse, "Scaling", "")
 		if err := r.Status().Update(ctx, instance); err != nil {
 			return ctrl.Result{}, err
 		}
 
-		if err := r.scaleNodeSet(ctx, instance, cluster); err != nil {
+		if err := r.scaleNodeSet(ctx, instance, cluster, provider); err != nil {
+			setFailedCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.ScalingInProgressCondition, "ScaleFailed", err)
+			_ = r.Status().Update(ctx, instance)
 			return ctrl.Result{}, err
 		}
 		r.EventRecorder.Event(instance, "Normal", "Scaled", fmt.Sprintf("%d to %d", len(instance.Status.NodeStatus), *instance.Spec.Replicas))
@@ -193,12 +198,18 @@ func (r *NodeSetReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	}
 
 	if err := helpers.WaitForNodesReady(r.Client, instance.Name, int(*instance.Spec.Replicas)); err != nil {
+		setFailedCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.NodesReadyCondition, "NodesNotReady", err)
+		_ = r.Status().Update(ctx, instance)
 		return ctrl.Result{Requeue: true, RequeueAfter: 30 * time.Second}, err
 	}
 
 	instance.Status.KubernetesVersion = instance.Spec.KubernetesVersion
 	instance.Status.ProvisioningState = "Succeeded"
-	instance.Status.Kubeconfig = cluster.Spec.CustomerKubeConfig
+	instance.Status.ObservedGeneration = instance.Generation
+	setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.VMSSReadyCondition, corev1.ConditionTrue, "VMSSProvisioned", "")
+	setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.NodesReadyCondition, corev1.ConditionTrue, "NodesReady", "")
+	setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.ScalingInProgressCondition, corev1.ConditionFalse, "ScalingCompleted", "")
+	setCondition(&instance.Status.Conditions, instance.Generation, enginev1alpha1.ReadyCondition, corev1.ConditionTrue, "Succeeded", "")
 	instance.Status.Replicas = int32(len(instance.Status.NodeStatus))
 	if err := r.Status().Update(ctx, instance); err != nil {
 		return ctrl.Result{}, err
@@ -207,57 +218,35 @@ func (r *NodeSetReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	return ctrl.Result{}, nil
 }
 
-func (r *NodeSetReconciler) getCluster(ctx context.Context, namespace string) (*enginev1alpha1.Cluster, error) {
-	clusterList := enginev1alpha1.ClusterList{}
-	if err := r.Client.List(ctx, &clusterList, client.InNamespace(namespace)); err != nil {
-		return nil, err
-	}
-
-	switch len(clusterList.Items) {
-	case 0:
-		return nil, fmt.Errorf("no clusters defined")
-	case 1:
-		return &clusterList.Items[0], nil
-	default:
-		return nil, fmt.Errorf("multiple clusters defined")
-	}
-}
-
-func (r *NodeSetReconciler) deleteNodeSet(ctx context.Context, instance *enginev1alpha1.NodeSet, cloudConfig azhelpers.CloudConfiguration) error {
+func (r *NodeSetReconciler) deleteNodeSet(ctx context.Context, instance *enginev1alpha1.NodeSet, cluster *enginev1alpha1.Cluster, provider azhelpers.Provider) error {
 	log := r.Log.WithValues("nodeset", instance.Name)
 	vmssName := instance.Name + "-agentvmss"
 
 	for _, vms := range instance.Status.NodeStatus {
-		err := helpers.CordonDrainAndDeleteNode(instance.Status.Kubeconfig, vms.VMComputerName)
+		err := helpers.CordonDrainAndDeleteNode(cluster.Spec.CustomerKubeConfig, vms.VMComputerName)
 		if err != nil {
 			log.Info("Error in Cordon and Drain", "Error", err, "VM", vms.VMComputerName)
 		}
 	}
 	log.Info("Deleting NodeSet", "VMSS", vmssName)
-	if err := cloudConfig.DeleteVMSS(ctx, vmssName); err != nil {
+	if err := provider.DeleteVMSS(ctx, vmssName); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (r *NodeSetReconciler) updateNodeSet(instance *enginev1alpha1.NodeSet, cloudConfig azhelpers.CloudConfiguration) error {
+func (r *NodeSetReconciler) updateNodeSet(instance *enginev1alpha1.NodeSet, provider azhelpers.Provider) error {
 	ctx := context.Background()
 	log := r.Log.WithValues("nodeset", instance.Name)
 	vmssName := instance.Name + "-agentvmss"
-	vmssClient, err := cloudConfig.GetVMSSVMsClient()
+	vms, err := provider.ListVMSSVMs(ctx, vmssName)
 	if err != nil {
-		log.Error(err, "Error GetVMSSVMsClient", "VMSS", vmssName)
-		return err
-	}
-
-	result, err := vmssClient.List(ctx, cloudConfig.GroupName, vmssName, "", "", string(compute.InstanceView))
-	if err != nil {
-		log.Error(err, "Error VMSSClient List", "VMSS", vmssName)
+		log.Error(err, "Error ListVMSSVMs", "VMSS", vmssName)
 		return err
 	}
 
 	var vmStatus []enginev1alpha1.VMStatus
-	for _, vmID := range result.Values() {
+	for _, vmID := range vms {
 		log.Info("Appending to VMSS Nodepool list", "VM", *vmID.OsProfile.ComputerName)
 		var status enginev1alpha1.VMStatus
 		status.VMComputerName = *vmID.OsProfile.ComputerName
@@ -268,7 +257,7 @@ func (r *NodeSetReconciler) updateNodeSet(instance *enginev1alpha1.NodeSet, clou
 	return nil
 }
 
-func (r *NodeSetReconciler) scaleNodeSet(ctx context.Context, instance *enginev1alpha1.NodeSet, cluster *enginev1alpha1.Cluster) error {
+func (r *NodeSetReconciler) scaleNodeSet(ctx context.Context, instance *enginev1alpha1.NodeSet, cluster *enginev1alpha1.Cluster, provider azhelpers.Provider) error {
 	log := r.Log.WithValues("nodeset", instance.Name)
 	vmssName := instance.Name + "-agentvmss"
 	expectedCount := int(*instance.Spec.Replicas)
@@ -279,20 +268,14 @@ func (r *NodeSetReconciler) scaleNodeSet(ctx context.Context, instance *enginev1
 			continue
 		}
 
-		err := helpers.CordonDrainAndDeleteNode(instance.Status.Kubeconfig, nodeStatus.VMComputerName)
-		if err != nil {
-			return err
-		}
-
-		vmssClient, err := cluster.Spec.CloudConfiguration.GetVMSSVMsClient()
+		err := helpers.CordonDrainAndDeleteNode(cluster.Spec.CustomerKubeConfig, nodeStatus.VMComputerName)
 		if err != nil {
 			return err
 		}
 
 		log.Info("Scaling down", "VMSS", nodeStatus.VMInstanceID)
 
-		_, err = vmssClient.Delete(ctx, cluster.Spec.CloudConfiguration.GroupName, vmssName, nodeStatus.VMInstanceID)
-		if err != nil {
+		if err := provider.DeleteVMSSVM(ctx, vmssName, nodeStatus.VMInstanceID); err != nil {
 			return err
 		}
 	}
@@ -300,7 +283,7 @@ func (r *NodeSetReconciler) scaleNodeSet(ctx context.Context, instance *enginev1
 	if err != nil {
 		return err
 	}
-	return cluster.Spec.CloudConfiguration.ScaleVMSS(ctx, vmssName, customDataStr, expectedCount)
+	return provider.ScaleVMSS(ctx, vmssName, customDataStr, expectedCount)
 }
 
 func getCustomData(instance *enginev1alpha1.NodeSet, cluster *enginev1alpha1.Cluster) (string, error) {
@@ -308,26 +291,46 @@ func getCustomData(instance *enginev1alpha1.NodeSet, cluster *enginev1alpha1.Clu
 	if err != nil {
 		return "", err
 	}
-
-	startupScript := getNodeSetStartupScript(
-		instance.Spec.KubernetesVersion,
-		cluster.Spec.InternalDNSName,
-		bootstrapToken,
-		cluster.Spec.DiscoveryHashes[0])
-
-	customData := map[string]string{
-		"/etc/kubernetes/azure.json": cluster.Spec.AzureCloudProviderConfig,
-	}
-	customRunData := map[string]string{
-		"/etc/kubernetes/init-azure-bootstrap.sh": startupScript,
+	cloudConfig, err := getNodeSetCloudConfig(instance, cluster, bootstrapToken)
+	if err != nil {
+		return "", err
 	}
+	return cloudConfig.CustomData()
+}
 
-	return base64.StdEncoding.EncodeToString([]byte(azhelpers.GetCustomData(customData, customRunData))), nil
+// getNodeSetCloudConfig joins nodes of the node set with the bootstrap token
+func getNodeSetCloudConfig(instance *enginev1alpha1.NodeSet, cluster *enginev1alpha1.Cluster, bootstrapToken string) (*cloudinit.Config, error) {
+	startupScript, err := getNodeSetStartupScript(&cluster.Spec.Spec, instance.Spec.KubernetesVersion, bootstrapToken)
+	if err != nil {
+		return nil, err
+	}
+	return cluster.Spec.NodeCloudConfig(startupScript)
 }
 
 func (r *NodeSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&enginev1alpha1.NodeSet{}).
+		Watches(&source.Kind{Type: &enginev1alpha1.Cluster{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.clusterToNodeSets),
+		}).
 		WithOptions(controller.Options{MaxConcurrentReconciles: 30}).
 		Complete(r)
 }
+
+func (r *NodeSetReconciler) clusterToNodeSets(o handler.MapObject) []reconcile.Request {
+	nodeSetList := enginev1alpha1.NodeSetList{}
+	if err := r.List(context.TODO(), &nodeSetList, client.InNamespace(o.Meta.GetNamespace())); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, nodeSet := range nodeSetList.Items {
+		if !isClusterRef(nodeSet.Spec.ClusterRef, o.Meta.GetName()) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: nodeSet.Namespace, Name: nodeSet.Name},
+		})
+	}
+	return requests
+}
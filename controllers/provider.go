@@ -0,0 +1,13 @@
+package controllers
+
+import (
+	azhelpers "github.com/awesomenix/azk/azure"
+)
+
+// newProvider returns the azure provider for the cloud configuration, defaulting to the azure apis
+func newProvider(factory azhelpers.ProviderFactory, cloudConfig *azhelpers.CloudConfiguration) (azhelpers.Provider, error) {
+	if factory == nil {
+		factory = azhelpers.NewProvider
+	}
+	return factory(cloudConfig)
+}
@@ -0,0 +1,89 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	enginev1alpha1 "github.com/awesomenix/azk/api/v1alpha1"
+)
+
+// updateClusterSecret replaces the data of a Secret referenced by the cluster
+func updateClusterSecret(ctx context.Context, c client.Client, namespace, name string, data map[string][]byte) error {
+	if name == "" {
+		return fmt.Errorf("cluster secret material is not moved to Secrets yet")
+	}
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, secret); err != nil {
+		return err
+	}
+	secret.Data = data
+	return c.Update(ctx, secret)
+}
+
+// reconcileClusterSecrets moves secret material inlined in the cluster spec into
+// referenced Secrets owned by the cluster, returns true if the cluster spec changed.
+// Inline values are only stripped once the Secret holds them, a Secret holding different
+// values is not overwritten, the inline values are kept until either is corrected.
+func reconcileClusterSecrets(ctx context.Context, c client.Client, cluster *enginev1alpha1.Cluster) (bool, error) {
+	spec := cluster.Spec.Spec
+	if spec.ClusterName == "" {
+		spec.ClusterName = cluster.Name
+	}
+	secrets := spec.Secrets(cluster.Namespace)
+	refs := []*corev1.LocalObjectReference{
+		&cluster.Spec.CredentialsRef,
+		&cluster.Spec.CertificatesRef,
+		&cluster.Spec.KubeconfigRef,
+	}
+
+	changed := false
+	for i, ref := range refs {
+		if ref.Name == "" && len(secrets[i].Data) == 0 {
+			continue
+		}
+		name := ref.Name
+		if name == "" {
+			name = secrets[i].Name
+		}
+
+		secret := &corev1.Secret{}
+		err := c.Get(ctx, types.NamespacedName{Namespace: cluster.Namespace, Name: name}, secret)
+		switch {
+		case errors.IsNotFound(err) && len(secrets[i].Data) > 0:
+			secrets[i].Name = name
+			setClusterOwnerReference(cluster, secrets[i])
+			if err := c.Create(ctx, secrets[i]); err != nil {
+				return false, err
+			}
+			secret = secrets[i]
+		case err != nil:
+			return false, err
+		default:
+			for key, value := range secrets[i].Data {
+				if existing, ok := secret.Data[key]; !ok || string(existing) != string(value) {
+					return false, fmt.Errorf("secret %s holds a different %s than the cluster spec, update the Secret or drop the inline value", name, key)
+				}
+			}
+		}
+		if ref.Name == "" {
+			ref.Name = name
+			changed = true
+		}
+		if setClusterOwnerReference(cluster, secret) {
+			if err := c.Update(ctx, secret); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	if cluster.Spec.HasSecrets() {
+		cluster.Spec.Spec = cluster.Spec.WithoutSecrets()
+		changed = true
+	}
+	return changed, nil
+}
@@ -0,0 +1,68 @@
+package controllers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	enginev1alpha1 "github.com/awesomenix/azk/api/v1alpha1"
+	"github.com/awesomenix/azk/bootstrap"
+)
+
+func TestReconcileClusterSecrets(t *testing.T) {
+	newCluster := func() *enginev1alpha1.Cluster {
+		cluster := &enginev1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "azk", UID: "uid"}}
+		cluster.Spec.ClusterName = "azk"
+		cluster.Spec.ClientSecret = "fresh"
+		return cluster
+	}
+	credentials := func(clientSecret string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: bootstrap.CredentialsSecretName("azk")},
+			Data:       map[string][]byte{"clientSecret": []byte(clientSecret)},
+		}
+	}
+
+	ctx := context.TODO()
+	c := fake.NewFakeClientWithScheme(scheme.Scheme)
+	cluster := newCluster()
+	changed, err := reconcileClusterSecrets(ctx, c, cluster)
+	if err != nil || !changed || cluster.Spec.CredentialsRef.Name == "" || cluster.Spec.ClientSecret != "" {
+		t.Fatalf("Expected the inline credentials to move to a Secret, got %v %v %+v", changed, err, cluster.Spec.CredentialsRef)
+	}
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: "default", Name: cluster.Spec.CredentialsRef.Name}, secret); err != nil || string(secret.Data["clientSecret"]) != "fresh" {
+		t.Fatalf("Expected the Secret to hold the inline credentials, got %v %v", secret.Data, err)
+	}
+
+	// the Secret already holds the inline values, e.g. the controller was restarted after creating it
+	c = fake.NewFakeClientWithScheme(scheme.Scheme, credentials("fresh"))
+	cluster = newCluster()
+	if changed, err := reconcileClusterSecrets(ctx, c, cluster); err != nil || !changed || cluster.Spec.ClientSecret != "" {
+		t.Fatalf("Expected the matching Secret to be referenced, got %v %v", changed, err)
+	}
+
+	for _, referenced := range []bool{false, true} {
+		c = fake.NewFakeClientWithScheme(scheme.Scheme, credentials("stale"))
+		cluster = newCluster()
+		if referenced {
+			cluster.Spec.CredentialsRef.Name = bootstrap.CredentialsSecretName("azk")
+		}
+		_, err := reconcileClusterSecrets(ctx, c, cluster)
+		if err == nil || !strings.Contains(err.Error(), "different clientSecret") {
+			t.Fatalf("Expected a Secret holding different credentials to be refused, got %v", err)
+		}
+		if cluster.Spec.ClientSecret != "fresh" {
+			t.Fatalf("Expected the inline credentials to be kept")
+		}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: "default", Name: bootstrap.CredentialsSecretName("azk")}, secret); err != nil || string(secret.Data["clientSecret"]) != "stale" {
+			t.Fatalf("Expected the existing Secret not to be overwritten, got %v %v", secret.Data, err)
+		}
+	}
+}
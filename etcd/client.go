@@ -0,0 +1,312 @@
+package etcd
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	certutil "k8s.io/client-go/util/cert"
+	"k8s.io/client-go/util/keyutil"
+	"k8s.io/kubernetes/cmd/kubeadm/app/util/pkiutil"
+)
+
+// gatewayPrefix is the grpc json gateway prefix served by etcd 3.3 and later
+const gatewayPrefix = "/v3beta"
+
+// Member is a member of the etcd cluster, members added but not started yet have no name
+type Member struct {
+	ID         uint64
+	Name       string
+	PeerURLs   []string
+	ClientURLs []string
+}
+
+// Status is the status reported by a single etcd endpoint
+type Status struct {
+	MemberID uint64
+	Leader   uint64
+	Version  string
+	DBSize   int64
+}
+
+// Cluster manages the membership of an etcd cluster
+type Cluster interface {
+	MemberList(ctx context.Context) ([]Member, error)
+	RemoveMember(ctx context.Context, id uint64) error
+	Status(ctx context.Context, endpoint string) (Status, error)
+	Snapshot(ctx context.Context, endpoint string, w io.Writer) (int64, error)
+}
+
+// ClusterFactory returns a Cluster reachable through the client endpoints, authenticating
+// with a client certificate signed by the etcd certificate authority
+type ClusterFactory func(endpoints []string, caCert, caKey string) (Cluster, error)
+
+// Client talks to the etcd json gateway
+type Client struct {
+	Endpoints  []string
+	httpClient *http.Client
+}
+
+var _ Cluster = &Client{}
+
+// NewCluster returns a Client for the endpoints
+func NewCluster(endpoints []string, caCert, caKey string) (Cluster, error) {
+	return NewClient(endpoints, caCert, caKey)
+}
+
+// NewClient returns a Client for the endpoints, trusting only the etcd certificate authority
+func NewClient(endpoints []string, caCert, caKey string) (*Client, error) {
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("no etcd endpoints")
+	}
+	tlsConfig, err := clientTLSConfig(caCert, caKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		Endpoints: endpoints,
+		httpClient: &http.Client{
+			Timeout:   10 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+	}, nil
+}
+
+// clientTLSConfig signs a new client certificate with the etcd certificate authority
+func clientTLSConfig(caCert, caKey string) (*tls.Config, error) {
+	caCerts, err := certutil.ParseCertsPEM([]byte(caCert))
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse etcd ca certificate: %v", err)
+	}
+	key, err := keyutil.ParsePrivateKeyPEM([]byte(caKey))
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse etcd ca key: %v", err)
+	}
+	caSigner, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("etcd ca key can not sign certificates")
+	}
+
+	cert, certKey, err := pkiutil.NewCertAndKey(caCerts[0], caSigner, &certutil.Config{
+		CommonName: "azk-etcd-client",
+		Usages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot create etcd client certificate: %v", err)
+	}
+	keyPEM, err := keyutil.MarshalPrivateKeyToPEM(certKey)
+	if err != nil {
+		return nil, err
+	}
+	clientCert, err := tls.X509KeyPair(pkiutil.EncodeCertPEM(cert), keyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	for _, c := range caCerts {
+		pool.AddCert(c)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{clientCert},
+		RootCAs:      pool,
+	}, nil
+}
+
+// uint64String decodes the 64 bit integers the gateway encodes as strings
+type uint64String uint64
+
+func (u *uint64String) UnmarshalJSON(b []byte) error {
+	v, err := strconv.ParseUint(strings.Trim(string(b), `"`), 10, 64)
+	if err != nil {
+		return err
+	}
+	*u = uint64String(v)
+	return nil
+}
+
+type memberJSON struct {
+	ID         uint64String `json:"ID"`
+	Name       string       `json:"name"`
+	PeerURLs   []string     `json:"peerURLs"`
+	ClientURLs []string     `json:"clientURLs"`
+}
+
+// MemberList lists the members of the cluster
+func (c *Client) MemberList(ctx context.Context) ([]Member, error) {
+	var resp struct {
+		Members []memberJSON `json:"members"`
+	}
+	if err := c.do(ctx, "/cluster/member/list", struct{}{}, &resp); err != nil {
+		return nil, err
+	}
+
+	members := make([]Member, 0, len(resp.Members))
+	for _, m := range resp.Members {
+		members = append(members, Member{
+			ID:         uint64(m.ID),
+			Name:       m.Name,
+			PeerURLs:   m.PeerURLs,
+			ClientURLs: m.ClientURLs,
+		})
+	}
+	return members, nil
+}
+
+// RemoveMember removes the member from the cluster
+func (c *Client) RemoveMember(ctx context.Context, id uint64) error {
+	req := map[string]string{"ID": strconv.FormatUint(id, 10)}
+	return c.do(ctx, "/cluster/member/remove", req, nil)
+}
+
+// Status returns the status of a single endpoint, an error means the endpoint is unhealthy
+func (c *Client) Status(ctx context.Context, endpoint string) (Status, error) {
+	var resp struct {
+		Header struct {
+			MemberID uint64String `json:"member_id"`
+		} `json:"header"`
+		Version string       `json:"version"`
+		DBSize  uint64String `json:"dbSize"`
+		Leader  uint64String `json:"leader"`
+	}
+	if err := c.call(ctx, endpoint, "/maintenance/status", struct{}{}, &resp); err != nil {
+		return Status{}, err
+	}
+	return Status{
+		MemberID: uint64(resp.Header.MemberID),
+		Leader:   uint64(resp.Leader),
+		Version:  resp.Version,
+		DBSize:   int64(resp.DBSize),
+	}, nil
+}
+
+// Snapshot streams a snapshot of the database of a single endpoint to w and returns its size,
+// the snapshot is only bounded by ctx since large databases take longer than a regular call
+func (c *Client) Snapshot(ctx context.Context, endpoint string, w io.Writer) (int64, error) {
+	snapshotClient := &http.Client{Transport: c.httpClient.Transport}
+	resp, err := post(ctx, snapshotClient, endpoint, "/maintenance/snapshot", struct{}{})
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	var size int64
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		var chunk struct {
+			Result *struct {
+				RemainingBytes uint64String `json:"remaining_bytes"`
+				Blob           string       `json:"blob"`
+			} `json:"result"`
+			Error *gatewayError `json:"error"`
+		}
+		if err := decoder.Decode(&chunk); err == io.EOF {
+			return 0, fmt.Errorf("%s snapshot ended early after %d bytes", endpoint, size)
+		} else if err != nil {
+			return 0, err
+		}
+		if chunk.Error != nil {
+			return 0, chunk.Error
+		}
+		if chunk.Result == nil {
+			continue
+		}
+		blob, err := base64.StdEncoding.DecodeString(chunk.Result.Blob)
+		if err != nil {
+			return 0, err
+		}
+		n, err := w.Write(blob)
+		size += int64(n)
+		if err != nil {
+			return size, err
+		}
+		if chunk.Result.RemainingBytes == 0 {
+			return size, nil
+		}
+	}
+}
+
+// do calls the endpoints in order until one answers
+func (c *Client) do(ctx context.Context, path string, in, out interface{}) error {
+	var errs []string
+	for _, endpoint := range c.Endpoints {
+		err := c.call(ctx, endpoint, path, in, out)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, fmt.Sprintf("%s: %v", endpoint, err))
+	}
+	return fmt.Errorf("no etcd endpoint succeeded: %s", strings.Join(errs, "; "))
+}
+
+// gatewayError is an error returned by etcd itself
+type gatewayError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Err     string `json:"error"`
+}
+
+func (e *gatewayError) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	return e.Err
+}
+
+func (c *Client) call(ctx context.Context, endpoint, path string, in, out interface{}) error {
+	resp, err := post(ctx, c.httpClient, endpoint, path, in)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(respBody, out)
+}
+
+// post sends the request to the endpoint, the response body is only returned with a 200 status
+func post(ctx context.Context, httpClient *http.Client, endpoint, path string, in interface{}) (*http.Response, error) {
+	body, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(endpoint, "/")+gatewayPrefix+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusOK {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	gwErr := &gatewayError{}
+	if err := json.Unmarshal(respBody, gwErr); err != nil || gwErr.Error() == "" {
+		return nil, fmt.Errorf("%s%s returned %s", endpoint, path, resp.Status)
+	}
+	return nil, gwErr
+}
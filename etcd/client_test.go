@@ -0,0 +1,99 @@
+package etcd
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	certutil "k8s.io/client-go/util/cert"
+	"k8s.io/client-go/util/keyutil"
+	"k8s.io/kubernetes/cmd/kubeadm/app/util/pkiutil"
+)
+
+func TestClient(t *testing.T) {
+	caCert, caKey, err := pkiutil.NewCertificateAuthority(&certutil.Config{CommonName: "etcd-ca"})
+	if err != nil {
+		t.Fatalf("Failed to create ca: %v", err)
+	}
+	serverCert, serverKey, err := pkiutil.NewCertAndKey(caCert, caKey, &certutil.Config{
+		CommonName: "etcd-server",
+		AltNames:   certutil.AltNames{IPs: []net.IP{net.ParseIP("127.0.0.1")}},
+		Usages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create server certificate: %v", err)
+	}
+	serverKeyPEM, _ := keyutil.MarshalPrivateKeyToPEM(serverKey)
+	serverPair, err := tls.X509KeyPair(pkiutil.EncodeCertPEM(serverCert), serverKeyPEM)
+	if err != nil {
+		t.Fatalf("Failed to load server certificate: %v", err)
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(caCert)
+
+	removed := ""
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v3beta/cluster/member/list":
+			w.Write([]byte(`{"header":{"cluster_id":"1"},"members":[{"ID":"10276657743932975437","name":"master000000","peerURLs":["https://10.0.0.4:2380"],"clientURLs":["https://10.0.0.4:2379"]},{"ID":"42","peerURLs":["https://10.0.0.5:2380"]}]}`))
+		case "/v3beta/cluster/member/remove":
+			req := map[string]string{}
+			json.NewDecoder(r.Body).Decode(&req)
+			removed = req["ID"]
+			w.Write([]byte(`{"header":{}}`))
+		case "/v3beta/maintenance/status":
+			w.Write([]byte(`{"header":{"member_id":"10276657743932975437"},"version":"3.3.10","dbSize":"24576","leader":"10276657743932975437"}`))
+		case "/v3beta/maintenance/snapshot":
+			w.Write([]byte(`{"result":{"header":{},"remaining_bytes":"4","blob":"c25hcA=="}}` + "\n"))
+			w.Write([]byte(`{"result":{"header":{},"remaining_bytes":"0","blob":"c2hvdA=="}}` + "\n"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":"not found","code":5}`))
+		}
+	}))
+	ts.TLS = &tls.Config{
+		Certificates: []tls.Certificate{serverPair},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}
+	ts.StartTLS()
+	defer ts.Close()
+
+	caKeyPEM, _ := keyutil.MarshalPrivateKeyToPEM(caKey)
+	client, err := NewClient([]string{"https://127.0.0.1:1", ts.URL}, string(pkiutil.EncodeCertPEM(caCert)), string(caKeyPEM))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	ctx := context.Background()
+	members, err := client.MemberList(ctx)
+	if err != nil {
+		t.Fatalf("Failed to list members: %v", err)
+	}
+	if len(members) != 2 || members[0].ID != 10276657743932975437 || members[0].Name != "master000000" || members[1].Name != "" {
+		t.Fatalf("Unexpected members %+v", members)
+	}
+	if err := client.RemoveMember(ctx, 42); err != nil || removed != "42" {
+		t.Fatalf("Expected member 42 to be removed, got %q %v", removed, err)
+	}
+	status, err := client.Status(ctx, ts.URL)
+	if err != nil {
+		t.Fatalf("Failed to get status: %v", err)
+	}
+	if status.MemberID != status.Leader || status.Version != "3.3.10" || status.DBSize != 24576 {
+		t.Fatalf("Unexpected status %+v", status)
+	}
+	if _, err := client.Status(ctx, "https://127.0.0.1:1"); err == nil {
+		t.Fatalf("Expected unreachable endpoint to be unhealthy")
+	}
+	snapshot := &bytes.Buffer{}
+	if size, err := client.Snapshot(ctx, ts.URL, snapshot); err != nil || size != 8 || snapshot.String() != "snapshot" {
+		t.Fatalf("Unexpected snapshot %q of %d bytes, %v", snapshot.String(), size, err)
+	}
+}
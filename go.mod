@@ -7,6 +7,7 @@ require (
 	github.com/Azure/go-autorest v13.0.1+incompatible // indirect
 	github.com/Azure/go-autorest/autorest v0.9.1
 	github.com/Azure/go-autorest/autorest/adal v0.6.0
+	github.com/Azure/go-autorest/autorest/date v0.2.0
 	github.com/Azure/go-autorest/autorest/to v0.3.0
 	github.com/Azure/go-autorest/autorest/validation v0.2.0 // indirect
 	github.com/Masterminds/semver v1.5.0
@@ -31,6 +32,7 @@ require (
 	k8s.io/cluster-bootstrap v0.0.0
 	k8s.io/kubernetes v1.15.3
 	sigs.k8s.io/controller-runtime v0.2.0
+	sigs.k8s.io/yaml v1.1.0
 )
 
 replace (
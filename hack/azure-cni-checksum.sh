@@ -0,0 +1,6 @@
+#!/bin/sh
+# Prints the sha256 of the azure-cni release archive of a version, pin it in azureCNIChecksums of
+# bootstrap/networkplugin.go
+set -e
+version=${1:?usage: $0 <azure-cni version>}
+curl -fsSL https://github.com/Azure/azure-container-networking/releases/download/$version/azure-vnet-cni-linux-amd64-$version.tgz | sha256sum | cut -d' ' -f1
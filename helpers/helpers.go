@@ -13,6 +13,7 @@ import (
 
 	"github.com/Masterminds/semver"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
@@ -204,6 +205,24 @@ func IsNodeUpdated(kclient client.Client, nodeName string, kubernetesVersion str
 	return false, nil
 }
 
+// IsNodeReady returns whether the node is registered and whether it is Ready
+func IsNodeReady(kclient client.Client, nodeName string) (bool, bool, error) {
+	node := &corev1.Node{}
+	if err := kclient.Get(context.TODO(), client.ObjectKey{Name: nodeName, Namespace: ""}, node); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, false, nil
+		}
+		return false, false, err
+	}
+
+	for _, c := range node.Status.Conditions {
+		if c.Type == corev1.NodeReady {
+			return true, c.Status == corev1.ConditionTrue, nil
+		}
+	}
+	return true, false, nil
+}
+
 func Recover() {
 	// recover from panic if one occured. Set err to nil otherwise.
 	if r := recover(); r != nil {
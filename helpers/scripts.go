@@ -22,39 +22,32 @@ sudo sysctl net.bridge.bridge-nf-call-iptables=1
 `, kubernetesVersion)
 }
 
-func FlannelCNI() string {
-	return fmt.Sprintf(`
-#flannel use 10.244.0.0/16 as podsubnet
-sudo kubectl --kubeconfig /etc/kubernetes/admin.conf apply -f https://raw.githubusercontent.com/coreos/flannel/master/Documentation/kube-flannel.yml
-`)
-}
-
-func CanalCNI() string {
-	return fmt.Sprintf(`
-#cancal use 10.244.0.0/16 as podsubnet
-sudo kubectl --kubeconfig /etc/kubernetes/admin.conf apply -f https://docs.projectcalico.org/v3.5/getting-started/kubernetes/installation/hosted/canal/canal.yaml
-`)
-}
+// APIServerHairpinUnit is the systemd unit installed by APIServerHairpinScript
+const APIServerHairpinUnit = "azk-apiserver-hairpin.service"
 
-func CalicoCNI() string {
+// APIServerHairpinScript makes a master reach the internal api server endpoint through its own api
+// server. Azure internal load balancers drop traffic a backend sends to its own frontend, so a
+// systemd unit redirects connections of the master to the frontend address and port to the local
+// api server, and restores the rule on every boot. The endpoint keeps resolving to the frontend and
+// the api server certificate keeps matching it.
+func APIServerHairpinScript(internalLoadBalancerIP string) string {
 	return fmt.Sprintf(`
-sudo kubectl --kubeconfig /etc/kubernetes/admin.conf apply -f https://docs.projectcalico.org/v3.5/getting-started/kubernetes/installation/hosted/kubernetes-datastore/calico-networking/1.7/calico.yaml
-`)
-}
+cat <<EOF | sudo tee /etc/systemd/system/%[1]s > /dev/null
+[Unit]
+Description=Redirect the internal api server endpoint to the local api server
+After=network-online.target
+Before=kubelet.service
 
-func RomanaCNI() string {
-	return fmt.Sprintf(`
-sudo kubectl --kubeconfig /etc/kubernetes/admin.conf apply -f https://raw.githubusercontent.com/romana/romana/master/docs/kubernetes/romana-kubeadm.yml
-`)
-}
+[Service]
+Type=oneshot
+RemainAfterExit=yes
+ExecStart=/sbin/iptables -t nat -A OUTPUT -p tcp -d %[2]s --dport 6443 -j REDIRECT --to-ports 6443
+ExecStop=/sbin/iptables -t nat -D OUTPUT -p tcp -d %[2]s --dport 6443 -j REDIRECT --to-ports 6443
 
-func KuberouterCNI() string {
-	return fmt.Sprintf(`
-sudo kubectl --kubeconfig /etc/kubernetes/admin.conf apply -f https://raw.githubusercontent.com/cloudnativelabs/kube-router/master/daemonset/kubeadm-kuberouter.yaml
-#below uses kuberouter for service communication as well
-#testing required since kubeadm has KubproxyConfig
-#sudo kubectl --kubeconfig /etc/kubernetes/admin.conf apply -f https://raw.githubusercontent.com/cloudnativelabs/kube-router/master/daemonset/kubeadm-kuberouter-all-features.yaml
-#sudo kubectl --kubeconfig /etc/kubernetes/admin.conf -n kube-system delete ds kube-proxy
-#sudo docker run --privileged -v /lib/modules:/lib/modules --net=host k8s.gcr.io/kube-proxy-amd64:v1.10.2 kube-proxy --cleanup
-`)
+[Install]
+WantedBy=multi-user.target
+EOF
+sudo systemctl daemon-reload
+sudo systemctl enable --now %[1]s
+`, APIServerHairpinUnit, internalLoadBalancerIP)
 }
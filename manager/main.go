@@ -5,6 +5,7 @@ import (
 	"os"
 
 	enginev1alpha1 "github.com/awesomenix/azk/api/v1alpha1"
+	azhelpers "github.com/awesomenix/azk/azure"
 	"github.com/awesomenix/azk/controllers"
 	"k8s.io/apimachinery/pkg/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -50,6 +51,7 @@ func main() {
 		Client:        mgr.GetClient(),
 		Log:           ctrl.Log.WithName("controllers").WithName("Cluster"),
 		EventRecorder: mgr.GetEventRecorderFor("cluster-controller"),
+		AzureProvider: azhelpers.NewProvider,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Cluster")
 		os.Exit(1)
@@ -58,6 +60,7 @@ func main() {
 		Client:        mgr.GetClient(),
 		Log:           ctrl.Log.WithName("controllers").WithName("ControlPlane"),
 		EventRecorder: mgr.GetEventRecorderFor("controlplane-controller"),
+		AzureProvider: azhelpers.NewProvider,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ControlPlane")
 		os.Exit(1)
@@ -75,10 +78,28 @@ func main() {
 		Client:        mgr.GetClient(),
 		Log:           ctrl.Log.WithName("controllers").WithName("NodeSet"),
 		EventRecorder: mgr.GetEventRecorderFor("nodeset-controller"),
+		AzureProvider: azhelpers.NewProvider,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "NodeSet")
 		os.Exit(1)
 	}
+	if err = (&controllers.EtcdBackupReconciler{
+		Client:        mgr.GetClient(),
+		Log:           ctrl.Log.WithName("controllers").WithName("EtcdBackup"),
+		EventRecorder: mgr.GetEventRecorderFor("etcdbackup-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "EtcdBackup")
+		os.Exit(1)
+	}
+	if err = (&controllers.EtcdBackupScheduleReconciler{
+		Client:        mgr.GetClient(),
+		Log:           ctrl.Log.WithName("controllers").WithName("EtcdBackupSchedule"),
+		EventRecorder: mgr.GetEventRecorderFor("etcdbackupschedule-controller"),
+		Scheme:        scheme,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "EtcdBackupSchedule")
+		os.Exit(1)
+	}
 	// +kubebuilder:scaffold:builder
 
 	setupLog.Info("starting manager")